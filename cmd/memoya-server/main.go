@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -15,11 +16,20 @@ import (
 	"github.com/go-chi/cors"
 	"github.com/pankona/memoya/internal/auth"
 	"github.com/pankona/memoya/internal/config"
+	"github.com/pankona/memoya/internal/fakeidp"
 	generatedServer "github.com/pankona/memoya/internal/generated/server"
 	"github.com/pankona/memoya/internal/server"
 	"github.com/pankona/memoya/internal/storage"
+	"github.com/pankona/memoya/internal/validation"
 )
 
+// keyReloadInterval is how often the JWT signing keys are reloaded
+const keyReloadInterval = 10 * time.Minute
+
+// sessionCleanupInterval is how often expired device auth sessions are
+// deleted
+const sessionCleanupInterval = 15 * time.Minute
+
 func main() {
 	// Initialize context
 	ctx := context.Background()
@@ -36,18 +46,70 @@ func main() {
 	}
 	defer storage.Close()
 
-	// Get OAuth credentials from environment variables or Secret Manager
-	credentials, err := config.GetOAuthCredentials(ctx, projectID)
+	// Start the fake identity provider before the providers discover it
+	fakeIdP, err := config.GetFakeIdP()
+	if err != nil {
+		log.Fatalf("Invalid fake identity provider configuration: %v", err)
+	}
+	if fakeIdP != nil {
+		if err := startFakeIdP(fakeIdP); err != nil {
+			log.Fatalf("Failed to start fake identity provider: %v", err)
+		}
+		log.Printf("Warning: fake identity provider for development listening on %s (identities: %s)", fakeIdP.Issuer(), strings.Join(fakeIdP.Identities, ", "))
+	}
+
+	// Get identity provider settings from environment variables or Secret Manager
+	providerConfigs, err := config.GetIdentityProviders(ctx, projectID)
+	if err != nil {
+		log.Fatalf("Identity provider configuration required: %v", err)
+	}
+	providers, err := auth.NewIdentityProviders(ctx, providerConfigs)
 	if err != nil {
-		log.Fatalf("OAuth configuration required: %v", err)
+		log.Fatalf("Failed to set up identity providers: %v", err)
+	}
+
+	// Load the JWT signing keys and reload them to pick up rotations
+	keys, err := loadSigningKeys(ctx, projectID)
+	switch {
+	case err == nil:
+		keySet, err := auth.NewKeySet(keys)
+		if err != nil {
+			log.Fatalf("Invalid JWT signing keys: %v", err)
+		}
+		auth.UseKeySet(keySet)
+		go keySet.Watch(ctx, keyReloadInterval, func(ctx context.Context) ([]*auth.SigningKey, error) {
+			return loadSigningKeys(ctx, projectID)
+		})
+		log.Printf("Loaded %d JWT signing keys", len(keys))
+	case config.IsProduction():
+		log.Fatalf("JWT signing keys are required in production: %v", err)
+	default:
+		log.Printf("Warning: %v. Signing tokens with a temporary key; they stop working when the server restarts", err)
 	}
 
 	// Initialize device flow service
-	deviceFlowService := auth.NewDeviceFlowService(storage, credentials.ClientID, credentials.ClientSecret)
+	deviceFlowService := auth.NewDeviceFlowService(storage, providers...)
+	log.Printf("Identity providers: %s", strings.Join(deviceFlowService.Providers(), ", "))
+	go deviceFlowService.RunJanitor(ctx, sessionCleanupInterval)
 
 	// Create server implementation
 	serverImpl := server.NewServerWithAuth(ctx, storage, deviceFlowService)
 
+	// Apply the configured rate limits and quotas
+	limits, err := config.GetLimits()
+	if err != nil {
+		log.Fatalf("Invalid limits configuration: %v", err)
+	}
+	serverImpl.SetLimits(limits)
+	log.Printf("Rate limit: %d requests per minute per user (burst %d)", limits.RatePerMinute, limits.RateBurst)
+
+	// Normalize tags as configured
+	lowercaseTags, err := config.GetLowercaseTags()
+	if err != nil {
+		log.Fatalf("Invalid validation configuration: %v", err)
+	}
+	serverImpl.SetValidation(validation.Options{LowercaseTags: lowercaseTags})
+
 	// Create router
 	r := chi.NewRouter()
 
@@ -55,7 +117,6 @@ func main() {
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.RequestID)
-	r.Use(middleware.Timeout(60 * time.Second))
 
 	// CORS configuration
 	allowedOrigins := []string{"*"} // Default to allow all for development
@@ -69,21 +130,33 @@ func main() {
 
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   allowedOrigins,
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
-		ExposedHeaders:   []string{"Link"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "Mcp-Session-Id", "If-Match", "If-None-Match"},
+		ExposedHeaders:   []string{"Link", "Mcp-Session-Id", "ETag", "Location", "X-Total-Count"},
 		AllowCredentials: true,
 		MaxAge:           300,
 	}))
 
-	// Add OpenAPI routes
-	generatedServer.HandlerFromMux(serverImpl, r)
+	// MCP endpoints keep streams open for the whole session, so they are
+	// mounted outside the request timeout
+	r.Handle("/mcp", serverImpl.MCPHandler())
+	r.Handle("/sse", serverImpl.MCPSSEHandler())
+
+	r.Group(func(r chi.Router) {
+		r.Use(middleware.Timeout(60 * time.Second))
+
+		// Add OpenAPI routes (the /mcp RPC endpoints and the /v2 REST API)
+		generatedServer.HandlerFromMux(serverImpl, r)
 
-	// Health check endpoint (if not already included)
-	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(`{"status":"ok","timestamp":"` + time.Now().UTC().Format(time.RFC3339) + `"}`))
+		// Registry tools without a dedicated OpenAPI endpoint
+		r.Post("/mcp/{tool}", serverImpl.CallTool)
+
+		// Health check endpoint (if not already included)
+		r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"status":"ok","timestamp":"` + time.Now().UTC().Format(time.RFC3339) + `"}`))
+		})
 	})
 
 	// Get port from environment
@@ -123,3 +196,31 @@ func main() {
 
 	log.Println("Server exited")
 }
+
+// startFakeIdP serves the fake identity provider in the background
+func startFakeIdP(cfg *config.FakeIdPConfig) error {
+	listener, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		return err
+	}
+
+	idp := fakeidp.New(fakeidp.Options{
+		Identities:  cfg.Identities,
+		AutoApprove: cfg.AutoApprove,
+	})
+	go func() {
+		if err := http.Serve(listener, idp); err != nil {
+			log.Fatalf("Fake identity provider stopped: %v", err)
+		}
+	}()
+	return nil
+}
+
+// loadSigningKeys reads the JWT signing keys from their configured source
+func loadSigningKeys(ctx context.Context, projectID string) ([]*auth.SigningKey, error) {
+	data, err := config.GetJWTSigningKeys(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return auth.ParseSigningKeys(data)
+}
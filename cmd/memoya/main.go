@@ -46,6 +46,10 @@ func main() {
 		httpClient.SetAuthToken(authToken)
 		log.Println("Auth token configured")
 	}
+	if os.Getenv("MEMOYA_AUTH_TOKEN") == "" {
+		// Pick up new sign-ins and renew expired tokens from the saved config
+		httpClient.SetCredentialStore(handlers.NewConfigManager())
+	}
 
 	// Test connectivity
 	if err := httpClient.Ping(ctx); err != nil {
@@ -59,146 +63,77 @@ func main() {
 	bridge := client.NewMCPBridge(httpClient)
 
 	// Create MCP server
-	server := mcp.NewServer("memoya", "0.1.0", nil)
-
-	// Create auth handler using HTTP client
-	authHandler := handlers.NewAuthHandler(httpClient)
-
-	// Register memo tools (HTTP-backed)
-	server.AddTools(
-		mcp.NewServerTool(
-			"memo_create",
-			"Create a new memo",
-			bridge.MemoCreate,
-			mcp.Input(
-				mcp.Property("title", mcp.Description("Memo title"), mcp.Required(true)),
-				mcp.Property("description", mcp.Description("Memo description")),
-				mcp.Property("tags", mcp.Description("Tags for the memo")),
-				mcp.Property("linked_todos", mcp.Description("IDs of linked todos")),
-			),
-		),
-		mcp.NewServerTool(
-			"memo_list",
-			"List memos with optional filters",
-			bridge.MemoList,
-			mcp.Input(
-				mcp.Property("tags", mcp.Description("Filter by tags")),
-			),
-		),
-		mcp.NewServerTool(
-			"memo_update",
-			"Update an existing memo",
-			bridge.MemoUpdate,
-			mcp.Input(
-				mcp.Property("id", mcp.Description("Memo ID to update"), mcp.Required(true)),
-				mcp.Property("title", mcp.Description("New title")),
-				mcp.Property("description", mcp.Description("New description")),
-				mcp.Property("tags", mcp.Description("New tags")),
-				mcp.Property("linked_todos", mcp.Description("New linked todo IDs")),
-			),
-		),
-		mcp.NewServerTool(
-			"memo_delete",
-			"Delete a memo",
-			bridge.MemoDelete,
-			mcp.Input(
-				mcp.Property("id", mcp.Description("Memo ID to delete"), mcp.Required(true)),
-			),
-		),
-	)
+	server := mcp.NewServer("memoya", "0.1.0", &mcp.ServerOptions{
+		CompletionHandler: bridge.Complete,
+	})
+
+	// Register tools: auth tools run locally, the others are forwarded over HTTP
+	local := &handlers.Handlers{Auth: handlers.NewAuthHandler(httpClient)}
+	for _, tool := range handlers.Tools() {
+		if tool.Local {
+			server.AddTools(tool.Bind(local))
+		} else {
+			server.AddTools(tool.Forward(bridge.Forward))
+		}
+	}
 
-	// Register todo tools (HTTP-backed)
-	server.AddTools(
-		mcp.NewServerTool(
-			"todo_create",
-			"Create a new todo item",
-			bridge.TodoCreate,
-			mcp.Input(
-				mcp.Property("title", mcp.Description("Todo title"), mcp.Required(true)),
-				mcp.Property("description", mcp.Description("Todo description")),
-				mcp.Property("status", mcp.Description("Todo status (backlog, todo, in_progress, done)")),
-				mcp.Property("priority", mcp.Description("Todo priority (high, normal)")),
-				mcp.Property("tags", mcp.Description("Tags for the todo")),
-				mcp.Property("parent_id", mcp.Description("Parent todo ID for hierarchical structure")),
-			),
-		),
-		mcp.NewServerTool(
-			"todo_list",
-			"List todo items with optional filters",
-			bridge.TodoList,
-			mcp.Input(
-				mcp.Property("status", mcp.Description("Filter by status")),
-				mcp.Property("tags", mcp.Description("Filter by tags")),
-				mcp.Property("priority", mcp.Description("Filter by priority")),
-			),
-		),
-		mcp.NewServerTool(
-			"todo_update",
-			"Update an existing todo item",
-			bridge.TodoUpdate,
-			mcp.Input(
-				mcp.Property("id", mcp.Description("Todo ID to update"), mcp.Required(true)),
-				mcp.Property("title", mcp.Description("New title")),
-				mcp.Property("description", mcp.Description("New description")),
-				mcp.Property("status", mcp.Description("New status")),
-				mcp.Property("priority", mcp.Description("New priority")),
-				mcp.Property("tags", mcp.Description("New tags")),
-			),
-		),
-		mcp.NewServerTool(
-			"todo_delete",
-			"Delete a todo item",
-			bridge.TodoDelete,
-			mcp.Input(
-				mcp.Property("id", mcp.Description("Todo ID to delete"), mcp.Required(true)),
-			),
-		),
+	// Register resources (HTTP-backed)
+	todayResource := &mcp.ServerResource{
+		Resource: &mcp.Resource{
+			URI:         client.TodayResourceURI,
+			Name:        "today",
+			Description: "Todos in progress, high priority todos, and todos and memos touched today",
+			MIMEType:    "application/json",
+		},
+		Handler: bridge.ReadToday,
+	}
+	server.AddResources(todayResource)
+	server.AddResourceTemplates(
+		&mcp.ServerResourceTemplate{
+			ResourceTemplate: &mcp.ResourceTemplate{
+				URITemplate: client.TodoResourceTemplate,
+				Name:        "todo",
+				Description: "A single todo item",
+				MIMEType:    "application/json",
+			},
+			Handler: bridge.ReadTodo,
+		},
+		&mcp.ServerResourceTemplate{
+			ResourceTemplate: &mcp.ResourceTemplate{
+				URITemplate: client.MemoResourceTemplate,
+				Name:        "memo",
+				Description: "A single memo",
+				MIMEType:    "application/json",
+			},
+			Handler: bridge.ReadMemo,
+		},
+		&mcp.ServerResourceTemplate{
+			ResourceTemplate: &mcp.ResourceTemplate{
+				URITemplate: client.TagResourceTemplate,
+				Name:        "tag",
+				Description: "All todos and memos with the given tag",
+				MIMEType:    "application/json",
+			},
+			Handler: bridge.ReadTag,
+		},
 	)
 
-	// Register search tool (HTTP-backed)
-	server.AddTools(
-		mcp.NewServerTool(
-			"search",
-			"Search todos and memos by keyword or tags",
-			bridge.Search,
-			mcp.Input(
-				mcp.Property("query", mcp.Description("Search query")),
-				mcp.Property("tags", mcp.Description("Filter by tags")),
-				mcp.Property("type", mcp.Description("Filter by type (todo, memo, all)")),
-			),
-		),
-	)
+	// Register prompts (HTTP-backed)
+	for _, prompt := range handlers.Prompts() {
+		server.AddPrompts(&mcp.ServerPrompt{Prompt: prompt, Handler: bridge.GetPrompt})
+	}
 
-	// Register tag tools (HTTP-backed)
-	server.AddTools(
-		mcp.NewServerTool(
-			"tag_list",
-			"List all unique tags from todos and memos",
-			bridge.TagList,
-			mcp.Input(),
-		),
-	)
+	// List each todo and memo as a concrete resource
+	server.AddReceivingMiddleware(bridge.ResourceListMiddleware)
 
-	// Register auth tools (HTTP-backed)
-	server.AddTools(
-		mcp.NewServerTool(
-			"auth_start",
-			"Start authentication process for memoya",
-			authHandler.Start,
-			mcp.Input(),
-		),
-		mcp.NewServerTool(
-			"auth_status",
-			"Check authentication status and retrieve auth token",
-			authHandler.Status,
-			mcp.Input(),
-		),
-	)
+	// Relay changes made by other sessions as MCP notifications
+	subs := client.NewSubscriptions()
+	go bridge.WatchChanges(ctx, client.NotifyChanges(server, subs, todayResource))
 
 	log.Println("Starting MCP client with HTTP transport to Cloud Run...")
 
 	// Run server with stdio transport
-	transport := mcp.NewStdioTransport()
+	transport := subs.Transport(mcp.NewStdioTransport())
 	if err := server.Run(ctx, transport); err != nil {
 		log.Fatal(err)
 	}
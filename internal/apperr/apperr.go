@@ -0,0 +1,120 @@
+// Package apperr defines the error kinds shared by storage, handlers, the
+// HTTP server and the bridge. Each kind has an HTTP status and a stable code
+// used in error responses, so clients can react without matching messages.
+package apperr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Kind classifies an error
+type Kind string
+
+const (
+	Internal     Kind = "INTERNAL_ERROR"
+	NotFound     Kind = "NOT_FOUND"
+	Forbidden    Kind = "FORBIDDEN"
+	Unauthorized Kind = "UNAUTHORIZED"
+	Validation   Kind = "VALIDATION_ERROR"
+	Conflict     Kind = "CONFLICT"
+	AuthPending  Kind = "AUTHORIZATION_PENDING"
+	RateLimited  Kind = "RATE_LIMITED"
+	// QuotaExceeded is a user storing more than their quota allows
+	QuotaExceeded Kind = "QUOTA_EXCEEDED"
+	// PreconditionFailed is an If-Match that no longer matches
+	PreconditionFailed Kind = "PRECONDITION_FAILED"
+
+	// Outcomes of polling a device flow sign-in (RFC 8628 section 3.5)
+	SlowDown     Kind = "SLOW_DOWN"     // Polled before the interval passed
+	AccessDenied Kind = "ACCESS_DENIED" // The user declined the sign-in
+	ExpiredToken Kind = "EXPIRED_TOKEN" // The device code expired unapproved
+)
+
+// statuses maps kinds to HTTP status codes
+var statuses = map[Kind]int{
+	Internal:     http.StatusInternalServerError,
+	NotFound:     http.StatusNotFound,
+	Forbidden:    http.StatusForbidden,
+	Unauthorized: http.StatusUnauthorized,
+	Validation:   http.StatusBadRequest,
+	Conflict:     http.StatusConflict,
+	// Like the OAuth device flow token endpoint (RFC 8628 section 3.5)
+	AuthPending:        http.StatusBadRequest,
+	RateLimited:        http.StatusTooManyRequests,
+	QuotaExceeded:      http.StatusTooManyRequests,
+	PreconditionFailed: http.StatusPreconditionFailed,
+	SlowDown:           http.StatusTooManyRequests,
+	AccessDenied:       http.StatusForbidden,
+	ExpiredToken:       http.StatusGone,
+}
+
+// Error is an error of a known kind
+type Error struct {
+	Kind       Kind
+	RetryAfter time.Duration // How long to wait before retrying, if known
+	err        error
+}
+
+// Errorf formats an error of the given kind. Like fmt.Errorf, %w wraps its
+// operand.
+func Errorf(kind Kind, format string, args ...any) *Error {
+	return &Error{Kind: kind, err: fmt.Errorf(format, args...)}
+}
+
+// WithRetryAfter sets how long the client should wait before retrying
+func (e *Error) WithRetryAfter(d time.Duration) *Error {
+	e.RetryAfter = d
+	return e
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// KindOf returns the kind of the outermost *Error in err's chain, or
+// Internal if there is none
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return Internal
+}
+
+// RetryAfterOf returns the retry delay of the outermost *Error in err's
+// chain, or 0 if there is none
+func RetryAfterOf(err error) time.Duration {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.RetryAfter
+	}
+	return 0
+}
+
+// Is reports whether err is of the given kind
+func Is(err error, kind Kind) bool {
+	return err != nil && KindOf(err) == kind
+}
+
+// HTTPStatus returns the HTTP status code for errors of the given kind
+func HTTPStatus(kind Kind) int {
+	if status, ok := statuses[kind]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// FromCode returns the kind of an error response code, or Internal if unknown
+func FromCode(code string) Kind {
+	if _, ok := statuses[Kind(code)]; ok {
+		return Kind(code)
+	}
+	return Internal
+}
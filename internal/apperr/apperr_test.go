@@ -0,0 +1,88 @@
+package apperr
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestKindOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want Kind
+	}{
+		{"typed", Errorf(NotFound, "todo not found"), NotFound},
+		{"wrapped", fmt.Errorf("get todo: %w", Errorf(Forbidden, "access denied")), Forbidden},
+		{"untyped", errors.New("boom"), Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := KindOf(tt.err); got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+
+	if Is(nil, Internal) {
+		t.Error("Expected nil not to be an Internal error")
+	}
+}
+
+func TestErrorf_Wraps(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	err := Errorf(Validation, "%w: bad", sentinel)
+
+	if !errors.Is(err, sentinel) {
+		t.Error("Expected wrapped error to be found")
+	}
+	if err.Error() != "sentinel: bad" {
+		t.Errorf("Unexpected message %q", err.Error())
+	}
+}
+
+func TestRetryAfterOf(t *testing.T) {
+	err := fmt.Errorf("poll: %w", Errorf(SlowDown, "polling too fast").WithRetryAfter(10*time.Second))
+	if got := RetryAfterOf(err); got != 10*time.Second {
+		t.Errorf("Expected 10s, got %v", got)
+	}
+	if got := RetryAfterOf(errors.New("boom")); got != 0 {
+		t.Errorf("Expected no retry delay, got %v", got)
+	}
+}
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		kind Kind
+		want int
+	}{
+		{NotFound, http.StatusNotFound},
+		{Forbidden, http.StatusForbidden},
+		{Validation, http.StatusBadRequest},
+		{AuthPending, http.StatusBadRequest},
+		{RateLimited, http.StatusTooManyRequests},
+		{QuotaExceeded, http.StatusTooManyRequests},
+		{SlowDown, http.StatusTooManyRequests},
+		{AccessDenied, http.StatusForbidden},
+		{ExpiredToken, http.StatusGone},
+		{Kind("UNKNOWN"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		if got := HTTPStatus(tt.kind); got != tt.want {
+			t.Errorf("HTTPStatus(%s) = %d, want %d", tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestFromCode(t *testing.T) {
+	if got := FromCode("NOT_FOUND"); got != NotFound {
+		t.Errorf("Expected NOT_FOUND, got %s", got)
+	}
+	if got := FromCode("BAD_REQUEST"); got != Internal {
+		t.Errorf("Expected unknown code to be INTERNAL_ERROR, got %s", got)
+	}
+}
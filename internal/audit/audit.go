@@ -0,0 +1,120 @@
+// Package audit records an append-only log of the changes made to each
+// user's data and account: what changed, when, and which token and client
+// made the change.
+package audit
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+// maxValueLength is the number of characters field change values are
+// truncated to
+const maxValueLength = 100
+
+type requestKey struct{}
+
+type toolKey struct{}
+
+// Request identifies who sent a request and with what
+type Request struct {
+	TokenID   string // Personal access token the request was authenticated with
+	SessionID string // Sign-in session the request was authenticated with
+	Client    string // User-Agent
+	RequestID string // Set by chi's RequestID middleware
+}
+
+// WithRequest records the sender of the request in ctx
+func WithRequest(ctx context.Context, req Request) context.Context {
+	return context.WithValue(ctx, requestKey{}, req)
+}
+
+// RequestFromContext returns the sender of the request in ctx
+func RequestFromContext(ctx context.Context) Request {
+	req, _ := ctx.Value(requestKey{}).(Request)
+	return req
+}
+
+// WithTool records the tool being called in ctx
+func WithTool(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, toolKey{}, name)
+}
+
+// ToolFromContext returns the tool being called in ctx, or "" outside of
+// tool calls
+func ToolFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(toolKey{}).(string)
+	return name
+}
+
+// Recorder appends audit events to storage. A nil Recorder records nothing.
+type Recorder struct {
+	storage storage.Storage
+	now     func() time.Time
+}
+
+func NewRecorder(storage storage.Storage) *Recorder {
+	return &Recorder{
+		storage: storage,
+		now:     time.Now,
+	}
+}
+
+// Record appends event, filling in its ID, time, and the request and tool
+// from ctx. Failures are logged rather than returned, since the change
+// itself has already been made.
+func (r *Recorder) Record(ctx context.Context, event *models.AuditEvent) {
+	if r == nil {
+		return
+	}
+
+	req := RequestFromContext(ctx)
+	event.ID = uuid.New().String()
+	event.CreatedAt = r.now()
+	event.TokenID = req.TokenID
+	if event.SessionID == "" {
+		event.SessionID = req.SessionID
+	}
+	event.Client = req.Client
+	event.RequestID = req.RequestID
+	event.Tool = ToolFromContext(ctx)
+	if event.Changes == nil {
+		event.Changes = []models.FieldChange{}
+	}
+
+	if err := r.storage.AppendAuditEvent(ctx, event); err != nil {
+		log.Printf("Failed to record audit event %s on %s %s of user %s: %v", event.Action, event.TargetType, event.TargetID, event.UserID, err)
+	}
+}
+
+// Changes collects the field changes of an event
+type Changes []models.FieldChange
+
+// Add records field changing from one value to another, unless they are
+// equal. An empty from means the field was set, an empty to that it was
+// cleared.
+func (c *Changes) Add(field, from, to string) {
+	if from != to {
+		*c = append(*c, models.FieldChange{Field: field, From: truncate(from), To: truncate(to)})
+	}
+}
+
+// List formats a list field like tags as a change value
+func List(values []string) string {
+	return strings.Join(values, ", ")
+}
+
+func truncate(value string) string {
+	if utf8.RuneCountInString(value) <= maxValueLength {
+		return value
+	}
+	runes := []rune(value)
+	return string(runes[:maxValueLength]) + "…"
+}
@@ -4,87 +4,103 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
-	"net/http"
-	"net/url"
+	"log"
 	"strings"
 	"time"
 
-	"github.com/google/uuid"
+	"github.com/pankona/memoya/internal/apperr"
 	"github.com/pankona/memoya/internal/models"
 	"github.com/pankona/memoya/internal/storage"
 )
 
+// Polling intervals of the device flow (RFC 8628 section 3.5), used by the
+// server polling providers and by clients polling the server
 const (
-	GoogleDeviceAuthURL = "https://oauth2.googleapis.com/device/code"
-	GoogleTokenURL      = "https://oauth2.googleapis.com/token"
-	GoogleUserInfoURL   = "https://www.googleapis.com/oauth2/v2/userinfo"
+	DefaultPollInterval = 5 // Seconds, when the provider or server does not say
+	SlowDownStep        = 5 // Seconds added to the interval on slow_down
 )
 
+// expiredSessionRetention is how long expired sessions are kept, so clients
+// polling them learn that they expired rather than that they do not exist
+const expiredSessionRetention = time.Hour
+
+// DeviceFlowService signs users in with the device flow of one of the
+// configured identity providers
 type DeviceFlowService struct {
-	storage      storage.Storage
-	clientID     string
-	clientSecret string
-	httpClient   *http.Client
+	storage    storage.Storage
+	identities *IdentityService
+	providers  map[string]IdentityProvider
+	names      []string // Provider names, the default first
+	now        func() time.Time
 }
 
-func NewDeviceFlowService(storage storage.Storage, clientID, clientSecret string) *DeviceFlowService {
-	return &DeviceFlowService{
-		storage:      storage,
-		clientID:     clientID,
-		clientSecret: clientSecret,
-		httpClient:   &http.Client{Timeout: 30 * time.Second},
+// NewDeviceFlowService creates a device flow service for providers. The
+// first provider is the default.
+func NewDeviceFlowService(storage storage.Storage, providers ...IdentityProvider) *DeviceFlowService {
+	s := &DeviceFlowService{
+		storage:    storage,
+		identities: NewIdentityService(storage),
+		providers:  make(map[string]IdentityProvider, len(providers)),
+		now:        time.Now,
+	}
+	for _, provider := range providers {
+		s.providers[provider.Name()] = provider
+		s.names = append(s.names, provider.Name())
 	}
+	return s
 }
 
-// DeviceAuthResponse represents the response from Google's device auth endpoint
-type DeviceAuthResponse struct {
-	DeviceCode      string `json:"device_code"`
-	UserCode        string `json:"user_code"`
-	VerificationURL string `json:"verification_url"`
-	ExpiresIn       int    `json:"expires_in"`
-	Interval        int    `json:"interval"`
+// Providers returns the names of the identity providers, the default first
+func (s *DeviceFlowService) Providers() []string {
+	return s.names
 }
 
-// StartDeviceFlow initiates the OAuth device flow
-func (s *DeviceFlowService) StartDeviceFlow(ctx context.Context) (*models.DeviceAuthSession, error) {
-	// Request device code from Google
-	data := url.Values{}
-	data.Set("client_id", s.clientID)
-	data.Set("scope", "openid email profile")
-
-	req, err := http.NewRequestWithContext(ctx, "POST", GoogleDeviceAuthURL, strings.NewReader(data.Encode()))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create device auth request: %w", err)
+// provider returns the named identity provider, or the default for ""
+func (s *DeviceFlowService) provider(name string) (IdentityProvider, error) {
+	if name == "" && len(s.names) > 0 {
+		name = s.names[0]
 	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	req.Header.Set("User-Agent", "memoya-server/1.0")
+	provider, ok := s.providers[name]
+	if !ok {
+		return nil, apperr.Errorf(apperr.Validation, "unknown identity provider %q (available: %s)", name, strings.Join(s.names, ", "))
+	}
+	return provider, nil
+}
 
-	resp, err := s.httpClient.Do(req)
+// StartDeviceFlow initiates the OAuth device flow with the named identity
+// provider ("" for the default). When linkUserID is set, the identity the
+// user signs in with is linked to that user.
+func (s *DeviceFlowService) StartDeviceFlow(ctx context.Context, providerName, linkUserID string) (*models.DeviceAuthSession, error) {
+	provider, err := s.provider(providerName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to request device code: %w", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("device auth request failed with status: %d", resp.StatusCode)
+	authResp, err := provider.StartDevice(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	var authResp DeviceAuthResponse
-	if err := json.NewDecoder(resp.Body).Decode(&authResp); err != nil {
-		return nil, fmt.Errorf("failed to decode device auth response: %w", err)
+	interval := authResp.Interval
+	if interval <= 0 {
+		interval = DefaultPollInterval
 	}
 
 	// Create session
+	now := s.now()
 	session := &models.DeviceAuthSession{
 		DeviceCode:      authResp.DeviceCode,
 		UserCode:        authResp.UserCode,
-		VerificationURI: authResp.VerificationURL,
-		ExpiresAt:       time.Now().Add(time.Duration(authResp.ExpiresIn) * time.Second),
+		VerificationURI: authResp.VerificationURI,
+		Interval:        interval,
+		Provider:        provider.Name(),
+		LinkUserID:      linkUserID,
+		ExpiresAt:       now.Add(time.Duration(authResp.ExpiresIn) * time.Second),
 		Status:          models.DeviceAuthStatusPending,
-		CreatedAt:       time.Now(),
+		CreatedAt:       now,
 	}
+	session.DeleteAt = session.ExpiresAt.Add(expiredSessionRetention)
 
 	// Store session
 	if err := s.storage.CreateDeviceAuthSession(ctx, session); err != nil {
@@ -94,171 +110,142 @@ func (s *DeviceFlowService) StartDeviceFlow(ctx context.Context) (*models.Device
 	return session, nil
 }
 
-// TokenResponse represents the response from Google's token endpoint
-type TokenResponse struct {
-	AccessToken  string `json:"access_token"`
-	TokenType    string `json:"token_type"`
-	ExpiresIn    int    `json:"expires_in"`
-	RefreshToken string `json:"refresh_token,omitempty"`
-	IDToken      string `json:"id_token,omitempty"`
-	Error        string `json:"error,omitempty"`
-}
-
-// GoogleUserInfo represents user information from Google
-type GoogleUserInfo struct {
-	ID            string `json:"id"`
-	Email         string `json:"email"`
-	VerifiedEmail bool   `json:"verified_email"`
-	Name          string `json:"name"`
-	Picture       string `json:"picture"`
-}
-
-// PollToken polls for authorization completion
-func (s *DeviceFlowService) PollToken(ctx context.Context, deviceCode string) (*models.User, string, error) {
+// PollToken polls for authorization completion and returns the signed-in
+// user. The caller starts a session to issue the user's tokens. Like a token
+// endpoint (RFC 8628 section 3.5) it fails with AuthPending until the user
+// decides, SlowDown when polled faster than the session's interval, which
+// grows by 5 seconds each time, AccessDenied when the user declined, and
+// ExpiredToken once the session expired. The session is consumed by the
+// poll that signs the user in, so a device code yields tokens only once, even
+// to concurrent polls.
+func (s *DeviceFlowService) PollToken(ctx context.Context, deviceCode string) (*models.User, error) {
 	// Get session
 	session, err := s.storage.GetDeviceAuthSession(ctx, deviceCode)
 	if err != nil {
-		return nil, "", fmt.Errorf("failed to get device auth session: %w", err)
+		return nil, fmt.Errorf("failed to get device auth session: %w", err)
 	}
 
-	// Check if expired
-	if time.Now().After(session.ExpiresAt) {
+	// Check if denied or expired
+	now := s.now()
+	switch {
+	case session.Status == models.DeviceAuthStatusDenied:
+		return nil, apperr.Errorf(apperr.AccessDenied, "authorization denied by user")
+	case session.Status == models.DeviceAuthStatusExpired:
+		return nil, apperr.Errorf(apperr.ExpiredToken, "device auth session expired")
+	case now.After(session.ExpiresAt):
 		session.Status = models.DeviceAuthStatusExpired
 		s.storage.UpdateDeviceAuthSession(ctx, session)
-		return nil, "", fmt.Errorf("device auth session expired")
+		return nil, apperr.Errorf(apperr.ExpiredToken, "device auth session expired")
 	}
 
-	// Check if already authorized
-	if session.Status == models.DeviceAuthStatusAuthorized && session.UserID != "" {
-		user, err := s.storage.GetUser(ctx, session.UserID)
-		if err != nil {
-			return nil, "", fmt.Errorf("failed to get user: %w", err)
-		}
-		// Generate JWT token
-		token, err := GenerateJWT(user.ID)
-		if err != nil {
-			return nil, "", fmt.Errorf("failed to generate JWT: %w", err)
-		}
-		return user, token, nil
+	// Enforce the polling interval
+	if session.Interval <= 0 {
+		session.Interval = DefaultPollInterval // Sessions started before intervals were stored
 	}
-
-	// Poll Google for token
-	data := url.Values{}
-	data.Set("client_id", s.clientID)
-	data.Set("client_secret", s.clientSecret)
-	data.Set("device_code", session.DeviceCode)
-	data.Set("grant_type", "urn:ietf:params:oauth:grant-type:device_code")
-
-	req, err := http.NewRequestWithContext(ctx, "POST", GoogleTokenURL, strings.NewReader(data.Encode()))
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to create token request: %w", err)
-	}
-	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to poll token: %w", err)
+	lastPolledAt := session.LastPolledAt
+	session.LastPolledAt = &now
+	if lastPolledAt != nil && now.Before(lastPolledAt.Add(time.Duration(session.Interval)*time.Second)) {
+		return nil, s.slowDown(ctx, session)
 	}
-	defer resp.Body.Close()
-
-	var tokenResp TokenResponse
-	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
-		return nil, "", fmt.Errorf("failed to decode token response: %w", err)
-	}
-
-	// Handle errors
-	if tokenResp.Error != "" {
-		if tokenResp.Error == "authorization_pending" {
-			return nil, "", fmt.Errorf("authorization pending")
+	if err := s.storage.UpdateDeviceAuthSession(ctx, session); err != nil {
+		if apperr.Is(err, apperr.NotFound) {
+			return nil, apperr.Errorf(apperr.ExpiredToken, "device code was already used")
 		}
-		return nil, "", fmt.Errorf("token error: %s", tokenResp.Error)
-	}
-
-	// Get user info from Google
-	userInfo, err := s.getUserInfo(ctx, tokenResp.AccessToken)
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to get user info: %w", err)
+		return nil, fmt.Errorf("failed to update session: %w", err)
 	}
 
-	// Find or create user
-	user, err := s.findOrCreateUser(ctx, userInfo)
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to find or create user: %w", err)
+	// Sessions authorized before they were consumed on use were used already
+	if session.Status == models.DeviceAuthStatusAuthorized {
+		s.storage.DeleteDeviceAuthSession(ctx, deviceCode)
+		return nil, apperr.Errorf(apperr.ExpiredToken, "device code was already used")
 	}
 
-	// Update session
-	session.Status = models.DeviceAuthStatusAuthorized
-	session.UserID = user.ID
-	if err := s.storage.UpdateDeviceAuthSession(ctx, session); err != nil {
-		return nil, "", fmt.Errorf("failed to update session: %w", err)
+	// Sessions started before providers were configurable used Google
+	providerName := session.Provider
+	if providerName == "" {
+		providerName = ProviderGoogle
 	}
-
-	// Generate JWT token
-	token, err := GenerateJWT(user.ID)
-	if err != nil {
-		return nil, "", fmt.Errorf("failed to generate JWT: %w", err)
-	}
-
-	return user, token, nil
-}
-
-func (s *DeviceFlowService) getUserInfo(ctx context.Context, accessToken string) (*GoogleUserInfo, error) {
-	req, err := http.NewRequestWithContext(ctx, "GET", GoogleUserInfoURL, nil)
+	provider, err := s.provider(providerName)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
 
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
+	// Poll the provider for the user's identity
+	identity, err := provider.PollDevice(ctx, session.DeviceCode)
+	switch {
+	case err == nil:
+	case apperr.Is(err, apperr.SlowDown):
+		return nil, s.slowDown(ctx, session)
+	case apperr.Is(err, apperr.AccessDenied):
+		session.Status = models.DeviceAuthStatusDenied
+		s.storage.UpdateDeviceAuthSession(ctx, session)
 		return nil, err
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("userinfo request failed with status: %d", resp.StatusCode)
-	}
-
-	var userInfo GoogleUserInfo
-	if err := json.NewDecoder(resp.Body).Decode(&userInfo); err != nil {
+	case apperr.Is(err, apperr.ExpiredToken):
+		session.Status = models.DeviceAuthStatusExpired
+		s.storage.UpdateDeviceAuthSession(ctx, session)
+		return nil, err
+	default:
 		return nil, err
 	}
 
-	return &userInfo, nil
-}
-
-func (s *DeviceFlowService) findOrCreateUser(ctx context.Context, userInfo *GoogleUserInfo) (*models.User, error) {
-	// Try to find existing user by Google ID
-	user, err := s.storage.GetUserByGoogleID(ctx, userInfo.ID)
-	if err == nil {
-		// User exists, update last login and return
-		user.IsActive = true
-		if err := s.storage.UpdateUser(ctx, user); err != nil {
-			return nil, fmt.Errorf("failed to update user: %w", err)
+	// Consume the session before signing in. Deleting fails if another poll
+	// consumed it meanwhile, so only one of concurrent polls signs in.
+	if err := s.storage.DeleteDeviceAuthSession(ctx, deviceCode); err != nil {
+		if apperr.Is(err, apperr.NotFound) {
+			return nil, apperr.Errorf(apperr.ExpiredToken, "device code was already used")
 		}
-		return user, nil
+		return nil, fmt.Errorf("failed to consume session: %w", err)
 	}
 
-	// User doesn't exist, create new one
-	user = &models.User{
-		ID:        uuid.New().String(), // Privacy-focused: random UUID, not tied to Google ID
-		GoogleID:  userInfo.ID,         // Only store Google ID for authentication
-		CreatedAt: time.Now(),
-		IsActive:  true,
+	// Find or create the user, or link the identity to the signed-in user.
+	// The provider does not hand out the identity again, so on failure the
+	// device flow has to be started over.
+	if session.LinkUserID != "" {
+		return s.identities.Link(ctx, session.LinkUserID, identity)
 	}
+	return s.identities.SignIn(ctx, identity)
+}
 
-	if err := s.storage.CreateUser(ctx, user); err != nil {
-		return nil, fmt.Errorf("failed to create user: %w", err)
+// slowDown increases the polling interval of session and returns the
+// SlowDown error telling the client how long to wait
+func (s *DeviceFlowService) slowDown(ctx context.Context, session *models.DeviceAuthSession) error {
+	session.Interval += SlowDownStep
+	if err := s.storage.UpdateDeviceAuthSession(ctx, session); err != nil {
+		return fmt.Errorf("failed to update session: %w", err)
 	}
+	return apperr.Errorf(apperr.SlowDown, "polling too fast: wait %d seconds between polls", session.Interval).
+		WithRetryAfter(time.Duration(session.Interval) * time.Second)
+}
 
-	return user, nil
+// CleanupExpiredSessions removes device auth sessions that expired more than
+// an hour ago and returns how many it removed
+func (s *DeviceFlowService) CleanupExpiredSessions(ctx context.Context) (int, error) {
+	deleted, err := s.storage.DeleteExpiredDeviceAuthSessions(ctx, s.now().Add(-expiredSessionRetention))
+	if err != nil {
+		return deleted, fmt.Errorf("failed to delete expired device auth sessions: %w", err)
+	}
+	return deleted, nil
 }
 
-// CleanupExpiredSessions removes expired device auth sessions
-func (s *DeviceFlowService) CleanupExpiredSessions(ctx context.Context) error {
-	// This would need to be implemented based on storage capabilities
-	// For now, we'll leave it as a placeholder since Firestore doesn't have direct collection-wide operations
-	return nil
+// RunJanitor cleans up expired device auth sessions every interval until ctx
+// is done
+func (s *DeviceFlowService) RunJanitor(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.CleanupExpiredSessions(ctx)
+			if err != nil {
+				log.Printf("Failed to clean up device auth sessions: %v", err)
+			} else if deleted > 0 {
+				log.Printf("Deleted %d expired device auth sessions", deleted)
+			}
+		}
+	}
 }
 
 // generateSecureCode generates a cryptographically secure random code
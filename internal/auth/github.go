@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/pankona/memoya/internal/models"
+)
+
+const (
+	GitHubDeviceAuthURL = "https://github.com/login/device/code"
+	GitHubTokenURL      = "https://github.com/login/oauth/access_token"
+	GitHubUserURL       = "https://api.github.com/user"
+)
+
+// ProviderGitHub is the name of the GitHub identity provider
+const ProviderGitHub = "github"
+
+// GitHubProvider signs users in with their GitHub account. The device flow
+// has to be enabled in the settings of the GitHub OAuth app.
+type GitHubProvider struct {
+	grant   deviceGrant
+	userURL string
+}
+
+func NewGitHubProvider(clientID, clientSecret string) *GitHubProvider {
+	return &GitHubProvider{
+		grant: deviceGrant{
+			clientID:      clientID,
+			clientSecret:  clientSecret,
+			deviceAuthURL: GitHubDeviceAuthURL,
+			tokenURL:      GitHubTokenURL,
+			scope:         "read:user",
+			httpClient:    newProviderHTTPClient(),
+		},
+		userURL: GitHubUserURL,
+	}
+}
+
+func (p *GitHubProvider) Name() string {
+	return ProviderGitHub
+}
+
+func (p *GitHubProvider) StartDevice(ctx context.Context) (*DeviceAuthorization, error) {
+	return p.grant.start(ctx)
+}
+
+func (p *GitHubProvider) PollDevice(ctx context.Context, deviceCode string) (*models.Identity, error) {
+	token, err := p.grant.poll(ctx, deviceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// The numeric ID stays the same when the user renames their account
+	var user struct {
+		ID    int64  `json:"id"`
+		Login string `json:"login"`
+	}
+	if err := getJSON(ctx, p.grant.httpClient, p.userURL, token.AccessToken, &user); err != nil {
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+	if user.ID == 0 {
+		return nil, fmt.Errorf("failed to get user info: no user ID")
+	}
+
+	return &models.Identity{Provider: ProviderGitHub, Subject: strconv.FormatInt(user.ID, 10)}, nil
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pankona/memoya/internal/models"
+)
+
+const (
+	GoogleDeviceAuthURL = "https://oauth2.googleapis.com/device/code"
+	GoogleTokenURL      = "https://oauth2.googleapis.com/token"
+	GoogleUserInfoURL   = "https://www.googleapis.com/oauth2/v2/userinfo"
+)
+
+// ProviderGoogle is the name of the Google identity provider
+const ProviderGoogle = "google"
+
+// GoogleProvider signs users in with their Google account
+type GoogleProvider struct {
+	grant       deviceGrant
+	userInfoURL string
+}
+
+func NewGoogleProvider(clientID, clientSecret string) *GoogleProvider {
+	return &GoogleProvider{
+		grant: deviceGrant{
+			clientID:      clientID,
+			clientSecret:  clientSecret,
+			deviceAuthURL: GoogleDeviceAuthURL,
+			tokenURL:      GoogleTokenURL,
+			scope:         "openid email profile",
+			httpClient:    newProviderHTTPClient(),
+		},
+		userInfoURL: GoogleUserInfoURL,
+	}
+}
+
+// GoogleUserInfo represents user information from Google
+type GoogleUserInfo struct {
+	ID            string `json:"id"`
+	Email         string `json:"email"`
+	VerifiedEmail bool   `json:"verified_email"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+}
+
+func (p *GoogleProvider) Name() string {
+	return ProviderGoogle
+}
+
+func (p *GoogleProvider) StartDevice(ctx context.Context) (*DeviceAuthorization, error) {
+	return p.grant.start(ctx)
+}
+
+func (p *GoogleProvider) PollDevice(ctx context.Context, deviceCode string) (*models.Identity, error) {
+	token, err := p.grant.poll(ctx, deviceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	var userInfo GoogleUserInfo
+	if err := getJSON(ctx, p.grant.httpClient, p.userInfoURL, token.AccessToken, &userInfo); err != nil {
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+	if userInfo.ID == "" {
+		return nil, fmt.Errorf("failed to get user info: no user ID")
+	}
+
+	return &models.Identity{Provider: ProviderGoogle, Subject: userInfo.ID}, nil
+}
@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+// IdentityService maps provider accounts to memoya users
+type IdentityService struct {
+	storage storage.Storage
+	now     func() time.Time
+}
+
+func NewIdentityService(storage storage.Storage) *IdentityService {
+	return &IdentityService{
+		storage: storage,
+		now:     time.Now,
+	}
+}
+
+// SignIn returns the user identity is linked to, creating a user on the
+// first sign-in
+func (s *IdentityService) SignIn(ctx context.Context, identity *models.Identity) (*models.User, error) {
+	user, err := s.owner(ctx, identity)
+	if err != nil && !apperr.Is(err, apperr.NotFound) {
+		return nil, err
+	}
+	if err == nil {
+		// User exists, update last login and return
+		user.IsActive = true
+		if err := s.storage.UpdateUser(ctx, user); err != nil {
+			return nil, fmt.Errorf("failed to update user: %w", err)
+		}
+		return user, nil
+	}
+
+	// User doesn't exist, create new one
+	user = &models.User{
+		ID:        uuid.New().String(), // Privacy-focused: random UUID, not tied to the provider account
+		CreatedAt: s.now(),
+		IsActive:  true,
+	}
+	if err := s.storage.CreateUser(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	if err := s.create(ctx, user.ID, identity); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// Link links identity to the user so they can sign in with it too. It fails
+// with Conflict when the identity belongs to another user.
+func (s *IdentityService) Link(ctx context.Context, userID string, identity *models.Identity) (*models.User, error) {
+	user, err := s.storage.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := s.owner(ctx, identity)
+	if err != nil && !apperr.Is(err, apperr.NotFound) {
+		return nil, err
+	}
+	if err == nil {
+		if owner.ID != userID {
+			return nil, apperr.Errorf(apperr.Conflict, "this %s account is already linked to another memoya user", identity.Provider)
+		}
+		return user, nil
+	}
+
+	if err := s.create(ctx, userID, identity); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// List returns the identities linked to the user, oldest first
+func (s *IdentityService) List(ctx context.Context, userID string) ([]*models.Identity, error) {
+	user, err := s.storage.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.adoptGoogleID(ctx, user); err != nil {
+		return nil, err
+	}
+
+	identities, err := s.storage.ListIdentities(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list identities: %w", err)
+	}
+	sort.Slice(identities, func(i, j int) bool {
+		return identities[i].CreatedAt.Before(identities[j].CreatedAt)
+	})
+	return identities, nil
+}
+
+// Unlink removes an identity of the user. The last identity cannot be
+// removed, since the user could not sign in anymore.
+func (s *IdentityService) Unlink(ctx context.Context, userID, id string) error {
+	identities, err := s.List(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, identity := range identities {
+		if identity.ID != id {
+			continue
+		}
+		if len(identities) == 1 {
+			return apperr.Errorf(apperr.Validation, "cannot unlink the only identity of the account")
+		}
+		return s.storage.DeleteIdentity(ctx, id)
+	}
+	return apperr.Errorf(apperr.NotFound, "identity not found")
+}
+
+// owner returns the user identity is linked to
+func (s *IdentityService) owner(ctx context.Context, identity *models.Identity) (*models.User, error) {
+	link, err := s.storage.GetIdentity(ctx, models.IdentityID(identity.Provider, identity.Subject))
+	if err == nil {
+		return s.storage.GetUser(ctx, link.UserID)
+	}
+	if !apperr.Is(err, apperr.NotFound) || identity.Provider != ProviderGoogle {
+		return nil, err
+	}
+
+	// Users created before identities were introduced store their Google
+	// account in the user record
+	user, err := s.storage.GetUserByGoogleID(ctx, identity.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.adoptGoogleID(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// adoptGoogleID moves the legacy Google account of user to an identity
+func (s *IdentityService) adoptGoogleID(ctx context.Context, user *models.User) error {
+	if user.GoogleID == "" {
+		return nil
+	}
+
+	identity := &models.Identity{Provider: ProviderGoogle, Subject: user.GoogleID}
+	if err := s.create(ctx, user.ID, identity); err != nil && !apperr.Is(err, apperr.Conflict) {
+		return err
+	}
+	user.GoogleID = ""
+	if err := s.storage.UpdateUser(ctx, user); err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+	return nil
+}
+
+func (s *IdentityService) create(ctx context.Context, userID string, identity *models.Identity) error {
+	link := &models.Identity{
+		ID:        models.IdentityID(identity.Provider, identity.Subject),
+		UserID:    userID,
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		CreatedAt: s.now(),
+	}
+	if err := s.storage.CreateIdentity(ctx, link); err != nil {
+		return fmt.Errorf("failed to link identity: %w", err)
+	}
+	return nil
+}
@@ -1,45 +1,59 @@
 package auth
 
 import (
-	"crypto/rand"
-	"encoding/base64"
 	"fmt"
-	"os"
+	"log"
+	"sync/atomic"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
-var (
-	jwtSecret []byte
-)
+// signingKeys signs and verifies access tokens
+var signingKeys atomic.Pointer[KeySet]
 
 func init() {
-	// Get JWT secret from environment or generate one
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		// Generate a random secret for development
-		randomBytes := make([]byte, 32)
-		rand.Read(randomBytes)
-		secret = base64.URLEncoding.EncodeToString(randomBytes)
+	// Development default, replaced by UseKeySet when keys are configured.
+	// Tokens signed with it stop working when the process exits.
+	key, err := GenerateSigningKey()
+	if err != nil {
+		log.Fatalf("Failed to generate JWT signing key: %v", err)
 	}
-	jwtSecret = []byte(secret)
+	ks, _ := NewKeySet([]*SigningKey{key})
+	signingKeys.Store(ks)
+}
+
+// UseKeySet makes ks sign and verify access tokens
+func UseKeySet(ks *KeySet) {
+	signingKeys.Store(ks)
 }
 
+// SigningKeys returns the key set in use
+func SigningKeys() *KeySet {
+	return signingKeys.Load()
+}
+
+// AccessTokenTTL is how long a JWT stays valid. Clients renew it with their
+// refresh token.
+const AccessTokenTTL = time.Hour
+
 // Claims represents JWT claims with minimal user information
 type Claims struct {
-	UserID string `json:"user_id"`
+	UserID    string `json:"user_id"`
+	SessionID string `json:"sid,omitempty"` // Sign-in the token was issued for
 	jwt.RegisteredClaims
 }
 
-// GenerateJWT creates a JWT token for the given user ID
-func GenerateJWT(userID string) (string, error) {
-	// Set expiration to 7 days (1 week)
-	expirationTime := time.Now().Add(7 * 24 * time.Hour)
+// GenerateJWT creates a JWT token for the given user ID and session
+func GenerateJWT(userID, sessionID string) (string, error) {
+	expirationTime := time.Now().Add(AccessTokenTTL)
 
 	claims := &Claims{
-		UserID: userID,
+		UserID:    userID,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			Issuer:    "memoya",
@@ -47,8 +61,7 @@ func GenerateJWT(userID string) (string, error) {
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString(jwtSecret)
+	tokenString, err := SigningKeys().Sign(claims)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign JWT token: %w", err)
 	}
@@ -56,35 +69,23 @@ func GenerateJWT(userID string) (string, error) {
 	return tokenString, nil
 }
 
-// ValidateJWT validates a JWT token and returns the user ID
+// ValidateJWT validates a JWT token and returns the user ID. It only checks
+// the signature and expiry; SessionService.Authenticate also rejects tokens
+// of signed-out sessions.
 func ValidateJWT(tokenString string) (string, error) {
-	claims := &Claims{}
-
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		// Verify signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return jwtSecret, nil
-	})
-
+	claims, err := ParseJWT(tokenString)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse JWT token: %w", err)
-	}
-
-	if !token.Valid {
-		return "", fmt.Errorf("invalid JWT token")
+		return "", err
 	}
-
 	return claims.UserID, nil
 }
 
-// RefreshJWT generates a new JWT token with extended expiration
-func RefreshJWT(tokenString string) (string, error) {
-	userID, err := ValidateJWT(tokenString)
-	if err != nil {
-		return "", fmt.Errorf("cannot refresh invalid token: %w", err)
+// ParseJWT validates a JWT token and returns its claims
+func ParseJWT(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	if err := SigningKeys().Parse(tokenString, claims); err != nil {
+		return nil, fmt.Errorf("failed to parse JWT token: %w", err)
 	}
 
-	return GenerateJWT(userID)
+	return claims, nil
 }
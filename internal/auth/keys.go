@@ -0,0 +1,353 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Algorithms access tokens can be signed with
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// PEM headers of a private key block
+const (
+	keyIDHeader     = "Key-ID"     // Overrides the thumbprint as kid
+	notBeforeHeader = "Not-Before" // RFC 3339 time the key starts signing
+)
+
+// SigningKey is a private key that signs access tokens
+type SigningKey struct {
+	ID        string // kid header of the tokens it signs
+	Algorithm string
+	NotBefore time.Time // Published, but not used for signing, before this time
+	private   crypto.Signer
+}
+
+// ParseSigningKeys reads RSA and Ed25519 private keys from PEM data. Each
+// key is identified by its RFC 7638 thumbprint unless its block has a
+// Key-ID header; a Not-Before header schedules when it takes over signing.
+func ParseSigningKeys(data []byte) ([]*SigningKey, error) {
+	var keys []*SigningKey
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		data = rest
+
+		var parsed any
+		var err error
+		switch block.Type {
+		case "PRIVATE KEY":
+			parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+		case "RSA PRIVATE KEY":
+			parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key: %w", err)
+		}
+
+		key, err := newSigningKey(parsed)
+		if err != nil {
+			return nil, err
+		}
+		if id := block.Headers[keyIDHeader]; id != "" {
+			key.ID = id
+		}
+		if notBefore := block.Headers[notBeforeHeader]; notBefore != "" {
+			key.NotBefore, err = time.Parse(time.RFC3339, notBefore)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s header of key %s: %w", notBeforeHeader, key.ID, err)
+			}
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no private keys found")
+	}
+	return keys, nil
+}
+
+func newSigningKey(private any) (*SigningKey, error) {
+	key := &SigningKey{}
+	switch k := private.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < 2048 {
+			return nil, fmt.Errorf("RSA keys must be at least 2048 bits")
+		}
+		key.Algorithm = AlgRS256
+		key.private = k
+	case ed25519.PrivateKey:
+		key.Algorithm = AlgEdDSA
+		key.private = k
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", private)
+	}
+
+	key.ID = key.thumbprint()
+	return key, nil
+}
+
+// GenerateSigningKey creates an Ed25519 key, for development servers
+// without configured keys
+func GenerateSigningKey() (*SigningKey, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+	return newSigningKey(private)
+}
+
+func (k *SigningKey) method() jwt.SigningMethod {
+	if k.Algorithm == AlgEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
+
+// JWK is a public key in JSON Web Key format
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+}
+
+// JWKS is the key set served at /.well-known/jwks.json
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWK returns the public half of k
+func (k *SigningKey) JWK() JWK {
+	jwk := JWK{KeyID: k.ID, Use: "sig", Algorithm: k.Algorithm}
+	switch public := k.private.Public().(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+	return jwk
+}
+
+// thumbprint computes the RFC 7638 thumbprint of the public key
+func (k *SigningKey) thumbprint() string {
+	jwk := k.JWK()
+
+	// The required members in lexicographic order
+	var members any
+	if jwk.KeyType == "RSA" {
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.KeyType, jwk.N}
+	} else {
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Curve, jwk.KeyType, jwk.X}
+	}
+
+	data, _ := json.Marshal(members)
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// KeySet holds the keys that sign and verify access tokens. Tokens are
+// signed with the newest key past its Not-Before time and verified with
+// any key of the set, so a new key can be published before it takes over
+// and an old one kept until the tokens it signed have expired.
+type KeySet struct {
+	mu   sync.RWMutex
+	keys []*SigningKey // Oldest first
+	now  func() time.Time
+}
+
+// NewKeySet creates a key set from keys
+func NewKeySet(keys []*SigningKey) (*KeySet, error) {
+	ks := &KeySet{now: time.Now}
+	if err := ks.Replace(keys); err != nil {
+		return nil, err
+	}
+	return ks, nil
+}
+
+// Replace swaps the keys of the set, e.g. after the key source changed
+func (ks *KeySet) Replace(keys []*SigningKey) error {
+	if len(keys) == 0 {
+		return fmt.Errorf("key set needs at least one key")
+	}
+
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if seen[key.ID] {
+			return fmt.Errorf("duplicate key ID %s", key.ID)
+		}
+		seen[key.ID] = true
+	}
+
+	sorted := append([]*SigningKey(nil), keys...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].NotBefore.Before(sorted[j].NotBefore)
+	})
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.keys = sorted
+	return nil
+}
+
+// Watch reloads the keys from load every interval until ctx is done, so
+// keys added to or removed from the source rotate without a restart. A
+// failed reload keeps the current keys.
+func (ks *KeySet) Watch(ctx context.Context, interval time.Duration, load func(ctx context.Context) ([]*SigningKey, error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			keys, err := load(ctx)
+			if err == nil {
+				err = ks.Replace(keys)
+			}
+			if err != nil {
+				log.Printf("Failed to reload JWT signing keys: %v", err)
+			}
+		}
+	}
+}
+
+// signingKey returns the key that signs new tokens
+func (ks *KeySet) signingKey() *SigningKey {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	now := ks.now()
+	active := ks.keys[0]
+	for _, key := range ks.keys {
+		if !key.NotBefore.After(now) {
+			active = key
+		}
+	}
+	return active
+}
+
+// Sign signs claims with the current signing key
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	key := ks.signingKey()
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.private)
+}
+
+// Parse verifies token with the key named by its kid header and decodes it
+// into claims
+func (ks *KeySet) Parse(token string, claims jwt.Claims) error {
+	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key := ks.lookup(kid)
+		if key == nil {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key.private.Public(), nil
+	}, jwt.WithValidMethods([]string{AlgRS256, AlgEdDSA}))
+	return err
+}
+
+func (ks *KeySet) lookup(kid string) *SigningKey {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	for _, key := range ks.keys {
+		if key.ID == kid {
+			return key
+		}
+	}
+	return nil
+}
+
+// MAC authenticates data for purpose with a key derived from the current
+// signing key. Unlike a signature, only the server can check it, e.g. on a
+// token it hands out to be passed back.
+func (ks *KeySet) MAC(purpose string, data []byte) []byte {
+	return ks.signingKey().mac(purpose, data)
+}
+
+// VerifyMAC reports whether mac authenticates data for purpose with any key
+// of the set
+func (ks *KeySet) VerifyMAC(purpose string, data, mac []byte) bool {
+	ks.mu.RLock()
+	keys := ks.keys
+	ks.mu.RUnlock()
+
+	for _, key := range keys {
+		if hmac.Equal(key.mac(purpose, data), mac) {
+			return true
+		}
+	}
+	return false
+}
+
+// mac returns the HMAC-SHA256 of data, keyed for purpose by the private key
+func (k *SigningKey) mac(purpose string, data []byte) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(k.private)
+	if err != nil {
+		panic(err) // RSA and Ed25519 keys always marshal
+	}
+	derive := hmac.New(sha256.New, der)
+	derive.Write([]byte("memoya mac: " + purpose))
+
+	mac := hmac.New(sha256.New, derive.Sum(nil))
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// JWKS returns the public keys of the set
+func (ks *KeySet) JWKS() JWKS {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	jwks := JWKS{Keys: make([]JWK, 0, len(ks.keys))}
+	for _, key := range ks.keys {
+		jwks.Keys = append(jwks.Keys, key.JWK())
+	}
+	return jwks
+}
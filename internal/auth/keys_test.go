@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func encodeKey(t *testing.T, key any, headers map[string]string) []byte {
+	t.Helper()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("Failed to marshal key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Headers: headers, Bytes: der})
+}
+
+func TestParseSigningKeys(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %v", err)
+	}
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	data := append(encodeKey(t, rsaKey, nil), encodeKey(t, edKey, map[string]string{
+		"Key-ID":     "2026-11",
+		"Not-Before": "2026-11-01T00:00:00Z",
+	})...)
+
+	keys, err := ParseSigningKeys(data)
+	if err != nil {
+		t.Fatalf("Failed to parse keys: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("Expected 2 keys, got %d", len(keys))
+	}
+
+	if keys[0].Algorithm != AlgRS256 || len(keys[0].ID) != 43 {
+		t.Errorf("Expected an RS256 key named by its thumbprint, got %s %q", keys[0].Algorithm, keys[0].ID)
+	}
+	if keys[1].Algorithm != AlgEdDSA || keys[1].ID != "2026-11" {
+		t.Errorf("Expected the Key-ID header to name the key, got %s %q", keys[1].Algorithm, keys[1].ID)
+	}
+	if want := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC); !keys[1].NotBefore.Equal(want) {
+		t.Errorf("Expected Not-Before %v, got %v", want, keys[1].NotBefore)
+	}
+
+	if _, err := ParseSigningKeys([]byte("not a key")); err == nil {
+		t.Error("Expected an error without keys")
+	}
+	weak, _ := rsa.GenerateKey(rand.Reader, 1024)
+	if _, err := ParseSigningKeys(encodeKey(t, weak, nil)); err == nil {
+		t.Error("Expected an error for a weak RSA key")
+	}
+}
+
+func TestKeySet_Rotation(t *testing.T) {
+	current, _ := GenerateSigningKey()
+	next, _ := GenerateSigningKey()
+	next.NotBefore = time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	ks, err := NewKeySet([]*SigningKey{next, current})
+	if err != nil {
+		t.Fatalf("Failed to create key set: %v", err)
+	}
+	ks.now = func() time.Time { return time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC) }
+
+	if got := len(ks.JWKS().Keys); got != 2 {
+		t.Errorf("Expected the scheduled key to be published, got %d keys", got)
+	}
+
+	sign := func() string {
+		t.Helper()
+		token, err := ks.Sign(&Claims{UserID: "user-1"})
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+		return token
+	}
+	kidOf := func(token string) string {
+		parsed, _, _ := jwt.NewParser().ParseUnverified(token, &Claims{})
+		return parsed.Header["kid"].(string)
+	}
+
+	before := sign()
+	if kidOf(before) != current.ID {
+		t.Errorf("Expected the current key to sign before the rotation")
+	}
+
+	ks.now = func() time.Time { return time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC) }
+	after := sign()
+	if kidOf(after) != next.ID {
+		t.Errorf("Expected the scheduled key to sign after the rotation")
+	}
+
+	// Tokens of both keys verify until a key is removed
+	for _, token := range []string{before, after} {
+		if err := ks.Parse(token, &Claims{}); err != nil {
+			t.Errorf("Expected token to verify: %v", err)
+		}
+	}
+	if err := ks.Replace([]*SigningKey{next}); err != nil {
+		t.Fatalf("Failed to replace keys: %v", err)
+	}
+	if err := ks.Parse(before, &Claims{}); err == nil {
+		t.Error("Expected tokens of a removed key to be rejected")
+	}
+
+	if err := ks.Replace([]*SigningKey{next, next}); err == nil {
+		t.Error("Expected duplicate key IDs to be rejected")
+	}
+}
+
+func TestKeySet_RejectsHMAC(t *testing.T) {
+	key, _ := GenerateSigningKey()
+	ks, _ := NewKeySet([]*SigningKey{key})
+
+	// An HS256 token keyed with the public key must not verify
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{UserID: "user-1"})
+	token.Header["kid"] = key.ID
+	signed, err := token.SignedString([]byte(key.private.Public().(ed25519.PublicKey)))
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+
+	if err := ks.Parse(signed, &Claims{}); err == nil {
+		t.Error("Expected an HS256 token to be rejected")
+	}
+}
+
+func TestKeySet_MAC(t *testing.T) {
+	current, _ := GenerateSigningKey()
+	next, _ := GenerateSigningKey()
+	next.NotBefore = time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	ks, _ := NewKeySet([]*SigningKey{next, current})
+	ks.now = func() time.Time { return time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC) }
+
+	data := []byte("data")
+	mac := ks.MAC("test", data)
+	if !ks.VerifyMAC("test", data, mac) {
+		t.Error("Expected the MAC to verify")
+	}
+	if ks.VerifyMAC("other", data, mac) || ks.VerifyMAC("test", []byte("other"), mac) {
+		t.Error("Expected the MAC to be bound to its purpose and data")
+	}
+
+	// MACs of the previous key verify until it is removed
+	ks.now = func() time.Time { return time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC) }
+	if !ks.VerifyMAC("test", data, mac) {
+		t.Error("Expected the MAC to verify after the rotation")
+	}
+	ks.Replace([]*SigningKey{next})
+	if ks.VerifyMAC("test", data, mac) {
+		t.Error("Expected MACs of a removed key to be rejected")
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"net/http"
 	"strings"
+
+	"github.com/pankona/memoya/internal/apperr"
 )
 
 // UserContextKey is the key used to store user ID in request context
@@ -55,16 +57,7 @@ func GetUserIDFromContext(ctx context.Context) (string, bool) {
 func RequireAuth(ctx context.Context) (string, error) {
 	userID, ok := GetUserIDFromContext(ctx)
 	if !ok || userID == "" {
-		return "", &AuthError{Message: "authentication required"}
+		return "", apperr.Errorf(apperr.Unauthorized, "authentication required")
 	}
 	return userID, nil
 }
-
-// AuthError represents authentication-related errors
-type AuthError struct {
-	Message string
-}
-
-func (e *AuthError) Error() string {
-	return e.Message
-}
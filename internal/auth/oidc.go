@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pankona/memoya/internal/models"
+)
+
+// oidcDiscoveryPath is where OpenID providers publish their configuration
+const oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+// OIDCProvider signs users in with an OpenID Connect provider that supports
+// the device authorization grant
+type OIDCProvider struct {
+	name        string
+	grant       deviceGrant
+	userInfoURL string
+}
+
+// oidcDiscovery is the part of an OpenID provider configuration memoya uses
+type oidcDiscovery struct {
+	Issuer                      string `json:"issuer"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	UserInfoEndpoint            string `json:"userinfo_endpoint"`
+}
+
+// NewOIDCProvider reads the discovery document of issuer and returns a
+// provider named name
+func NewOIDCProvider(ctx context.Context, name, issuer, clientID, clientSecret string) (*OIDCProvider, error) {
+	httpClient := newProviderHTTPClient()
+	issuer = strings.TrimSuffix(issuer, "/")
+
+	var discovery oidcDiscovery
+	if err := getJSON(ctx, httpClient, issuer+oidcDiscoveryPath, "", &discovery); err != nil {
+		return nil, fmt.Errorf("failed to fetch discovery document: %w", err)
+	}
+
+	// The issuer must be the one the document was fetched from (OpenID
+	// Connect Discovery section 4.3)
+	if strings.TrimSuffix(discovery.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("discovery document is for issuer %q, not %q", discovery.Issuer, issuer)
+	}
+	switch {
+	case discovery.DeviceAuthorizationEndpoint == "":
+		return nil, fmt.Errorf("issuer %s does not support the device authorization grant", issuer)
+	case discovery.TokenEndpoint == "":
+		return nil, fmt.Errorf("issuer %s has no token endpoint", issuer)
+	case discovery.UserInfoEndpoint == "":
+		return nil, fmt.Errorf("issuer %s has no userinfo endpoint", issuer)
+	}
+
+	return &OIDCProvider{
+		name: name,
+		grant: deviceGrant{
+			clientID:      clientID,
+			clientSecret:  clientSecret,
+			deviceAuthURL: discovery.DeviceAuthorizationEndpoint,
+			tokenURL:      discovery.TokenEndpoint,
+			scope:         "openid",
+			httpClient:    httpClient,
+		},
+		userInfoURL: discovery.UserInfoEndpoint,
+	}, nil
+}
+
+func (p *OIDCProvider) Name() string {
+	return p.name
+}
+
+func (p *OIDCProvider) StartDevice(ctx context.Context) (*DeviceAuthorization, error) {
+	return p.grant.start(ctx)
+}
+
+func (p *OIDCProvider) PollDevice(ctx context.Context, deviceCode string) (*models.Identity, error) {
+	token, err := p.grant.poll(ctx, deviceCode)
+	if err != nil {
+		return nil, err
+	}
+
+	var userInfo struct {
+		Subject string `json:"sub"`
+	}
+	if err := getJSON(ctx, p.grant.httpClient, p.userInfoURL, token.AccessToken, &userInfo); err != nil {
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+	if userInfo.Subject == "" {
+		return nil, fmt.Errorf("failed to get user info: no sub claim")
+	}
+
+	return &models.Identity{Provider: p.name, Subject: userInfo.Subject}, nil
+}
@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/config"
+	"github.com/pankona/memoya/internal/models"
+)
+
+// DeviceAuthorization is an identity provider's answer to a device
+// authorization request (RFC 8628 section 3.2)
+type DeviceAuthorization struct {
+	DeviceCode      string
+	UserCode        string
+	VerificationURI string
+	ExpiresIn       int // Seconds
+	Interval        int // Seconds between polls
+}
+
+// IdentityProvider signs users in with the OAuth 2.0 device authorization
+// grant
+type IdentityProvider interface {
+	// Name is the name users pick the provider by
+	Name() string
+	// StartDevice requests a device and user code
+	StartDevice(ctx context.Context) (*DeviceAuthorization, error)
+	// PollDevice returns the identity of the user once they approved the
+	// device code. Until then it fails with AuthPending, SlowDown when
+	// polled too fast, AccessDenied when the user declined, or ExpiredToken
+	// when the code expired. The identity has only Provider and Subject set.
+	PollDevice(ctx context.Context, deviceCode string) (*models.Identity, error)
+}
+
+// NewIdentityProviders sets up the configured identity providers. OIDC
+// providers fetch their discovery document.
+func NewIdentityProviders(ctx context.Context, configs []config.IdentityProviderConfig) ([]IdentityProvider, error) {
+	providers := make([]IdentityProvider, 0, len(configs))
+	for _, cfg := range configs {
+		switch cfg.Type {
+		case config.ProviderTypeGoogle:
+			providers = append(providers, NewGoogleProvider(cfg.ClientID, cfg.ClientSecret))
+		case config.ProviderTypeGitHub:
+			providers = append(providers, NewGitHubProvider(cfg.ClientID, cfg.ClientSecret))
+		case config.ProviderTypeOIDC:
+			provider, err := NewOIDCProvider(ctx, cfg.Name, cfg.Issuer, cfg.ClientID, cfg.ClientSecret)
+			if err != nil {
+				return nil, fmt.Errorf("identity provider %s: %w", cfg.Name, err)
+			}
+			providers = append(providers, provider)
+		default:
+			return nil, fmt.Errorf("identity provider %s: unknown type %q", cfg.Name, cfg.Type)
+		}
+	}
+	return providers, nil
+}
+
+// deviceGrant runs the device authorization grant against one
+// authorization server
+type deviceGrant struct {
+	clientID      string
+	clientSecret  string // Optional for public clients
+	deviceAuthURL string
+	tokenURL      string
+	scope         string
+	httpClient    *http.Client
+}
+
+// tokenResponse is a token endpoint response. Errors are reported in the
+// body, with status 200 by some providers.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int    `json:"expires_in"`
+	IDToken     string `json:"id_token,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+func (g *deviceGrant) start(ctx context.Context) (*DeviceAuthorization, error) {
+	data := url.Values{}
+	data.Set("client_id", g.clientID)
+	data.Set("scope", g.scope)
+
+	resp, err := g.post(ctx, g.deviceAuthURL, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to request device code: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("device auth request failed with status: %d", resp.StatusCode)
+	}
+
+	var authResp struct {
+		DeviceCode      string `json:"device_code"`
+		UserCode        string `json:"user_code"`
+		VerificationURI string `json:"verification_uri"`
+		VerificationURL string `json:"verification_url"` // Google's name for verification_uri
+		ExpiresIn       int    `json:"expires_in"`
+		Interval        int    `json:"interval"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&authResp); err != nil {
+		return nil, fmt.Errorf("failed to decode device auth response: %w", err)
+	}
+	if authResp.DeviceCode == "" {
+		return nil, fmt.Errorf("device auth response has no device_code")
+	}
+
+	verificationURI := authResp.VerificationURI
+	if verificationURI == "" {
+		verificationURI = authResp.VerificationURL
+	}
+
+	return &DeviceAuthorization{
+		DeviceCode:      authResp.DeviceCode,
+		UserCode:        authResp.UserCode,
+		VerificationURI: verificationURI,
+		ExpiresIn:       authResp.ExpiresIn,
+		Interval:        authResp.Interval,
+	}, nil
+}
+
+// poll exchanges deviceCode for an access token once the user approved it
+func (g *deviceGrant) poll(ctx context.Context, deviceCode string) (*tokenResponse, error) {
+	data := url.Values{}
+	data.Set("client_id", g.clientID)
+	if g.clientSecret != "" {
+		data.Set("client_secret", g.clientSecret)
+	}
+	data.Set("device_code", deviceCode)
+	data.Set("grant_type", "urn:ietf:params:oauth:grant-type:device_code")
+
+	resp, err := g.post(ctx, g.tokenURL, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to poll token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var tokenResp tokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return nil, fmt.Errorf("failed to decode token response: %w", err)
+	}
+
+	switch tokenResp.Error {
+	case "":
+	case "authorization_pending":
+		return nil, apperr.Errorf(apperr.AuthPending, "authorization pending")
+	case "slow_down":
+		return nil, apperr.Errorf(apperr.SlowDown, "polling too fast")
+	case "access_denied":
+		return nil, apperr.Errorf(apperr.AccessDenied, "authorization denied by user")
+	case "expired_token":
+		return nil, apperr.Errorf(apperr.ExpiredToken, "device auth session expired")
+	default:
+		return nil, fmt.Errorf("token error: %s", tokenResp.Error)
+	}
+
+	if tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("token response has no access_token")
+	}
+	return &tokenResp, nil
+}
+
+func (g *deviceGrant) post(ctx context.Context, endpoint string, data url.Values) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "memoya-server/1.0")
+	return g.httpClient.Do(req)
+}
+
+// getJSON fetches endpoint with accessToken and decodes the response into v
+func getJSON(ctx context.Context, client *http.Client, endpoint, accessToken string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "memoya-server/1.0")
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request to %s failed with status: %d", endpoint, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func newProviderHTTPClient() *http.Client {
+	return &http.Client{Timeout: 30 * time.Second}
+}
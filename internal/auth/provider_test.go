@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pankona/memoya/internal/apperr"
+)
+
+// newAuthorizationServer serves a device authorization grant whose token
+// endpoint answers with the errors in pending before issuing a token
+func newAuthorizationServer(t *testing.T, pending ...string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+
+	discovery := func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{
+			"issuer":                        ts.URL,
+			"device_authorization_endpoint": ts.URL + "/device",
+			"token_endpoint":                ts.URL + "/token",
+			"userinfo_endpoint":             ts.URL + "/userinfo",
+		})
+	}
+	mux.HandleFunc("/.well-known/openid-configuration", discovery)
+	mux.HandleFunc("/tenant/.well-known/openid-configuration", discovery)
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("client_id") != "client" {
+			http.Error(w, "unknown client", http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, map[string]interface{}{
+			"device_code":      "device-1",
+			"user_code":        "ABCD-EFGH",
+			"verification_uri": ts.URL + "/activate",
+			"expires_in":       900,
+			"interval":         5,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("grant_type") != "urn:ietf:params:oauth:grant-type:device_code" || r.FormValue("device_code") != "device-1" {
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]string{"error": "invalid_grant"})
+			return
+		}
+		if len(pending) > 0 {
+			// GitHub reports pending authorizations with status 200
+			writeJSON(w, map[string]string{"error": pending[0]})
+			pending = pending[1:]
+			return
+		}
+		writeJSON(w, map[string]string{"access_token": "access-1", "token_type": "bearer"})
+	})
+	userinfo := func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-1" {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, map[string]interface{}{"sub": "oidc-user", "id": 583231, "login": "octocat"})
+	}
+	mux.HandleFunc("/userinfo", userinfo)
+	mux.HandleFunc("/user", userinfo)
+
+	return ts
+}
+
+func TestGitHubProvider(t *testing.T) {
+	ts := newAuthorizationServer(t, "authorization_pending", "slow_down")
+	p := NewGitHubProvider("client", "")
+	p.grant.deviceAuthURL = ts.URL + "/device"
+	p.grant.tokenURL = ts.URL + "/token"
+	p.userURL = ts.URL + "/user"
+	ctx := context.Background()
+
+	device, err := p.StartDevice(ctx)
+	if err != nil {
+		t.Fatalf("StartDevice failed: %v", err)
+	}
+	if device.DeviceCode != "device-1" || device.UserCode != "ABCD-EFGH" || device.Interval != 5 {
+		t.Errorf("Unexpected device authorization: %+v", device)
+	}
+
+	for _, want := range []apperr.Kind{apperr.AuthPending, apperr.SlowDown} {
+		if _, err := p.PollDevice(ctx, device.DeviceCode); apperr.KindOf(err) != want {
+			t.Errorf("Expected %v, got %v", want, err)
+		}
+	}
+
+	identity, err := p.PollDevice(ctx, device.DeviceCode)
+	if err != nil {
+		t.Fatalf("PollDevice failed: %v", err)
+	}
+	if identity.Provider != "github" || identity.Subject != "583231" {
+		t.Errorf("Expected the numeric GitHub user ID, got %+v", identity)
+	}
+}
+
+func TestOIDCProvider(t *testing.T) {
+	ts := newAuthorizationServer(t, "access_denied")
+	ctx := context.Background()
+
+	p, err := NewOIDCProvider(ctx, "corp", ts.URL+"/", "client", "secret")
+	if err != nil {
+		t.Fatalf("NewOIDCProvider failed: %v", err)
+	}
+	if p.Name() != "corp" {
+		t.Errorf("Expected name corp, got %q", p.Name())
+	}
+
+	device, err := p.StartDevice(ctx)
+	if err != nil {
+		t.Fatalf("StartDevice failed: %v", err)
+	}
+	if _, err := p.PollDevice(ctx, device.DeviceCode); !apperr.Is(err, apperr.AccessDenied) {
+		t.Errorf("Expected a denied authorization, got %v", err)
+	}
+
+	identity, err := p.PollDevice(ctx, device.DeviceCode)
+	if err != nil {
+		t.Fatalf("PollDevice failed: %v", err)
+	}
+	if identity.Provider != "corp" || identity.Subject != "oidc-user" {
+		t.Errorf("Expected the sub claim, got %+v", identity)
+	}
+
+	// The discovery document must belong to the configured issuer
+	if _, err := NewOIDCProvider(ctx, "corp", ts.URL+"/tenant", "client", ""); err == nil {
+		t.Error("Expected an error for a discovery document of another issuer")
+	}
+}
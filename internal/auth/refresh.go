@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+const (
+	// RefreshTokenPrefix starts every refresh token
+	RefreshTokenPrefix = "mrt_"
+
+	// RefreshTokenTTL is how long a refresh token stays valid. Each rotation
+	// issues a new token, so clients in regular use stay signed in.
+	RefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+// RefreshTokenService issues and rotates refresh tokens
+type RefreshTokenService struct {
+	storage storage.Storage
+	now     func() time.Time
+}
+
+func NewRefreshTokenService(storage storage.Storage) *RefreshTokenService {
+	return &RefreshTokenService{
+		storage: storage,
+		now:     time.Now,
+	}
+}
+
+// Issue starts the token family of a session and returns its first refresh
+// token
+func (s *RefreshTokenService) Issue(ctx context.Context, userID, sessionID string) (string, error) {
+	token, raw, err := s.newToken(userID, sessionID)
+	if err != nil {
+		return "", err
+	}
+	if err := s.storage.CreateRefreshToken(ctx, token); err != nil {
+		return "", fmt.Errorf("failed to store refresh token: %w", err)
+	}
+	return raw, nil
+}
+
+// Rotate exchanges a refresh token for a new one in the same family and
+// returns the exchanged token. Presenting a token that was already rotated
+// revokes the whole family, since either it or its successor has been
+// stolen. The token is marked rotated and its successor stored together, so
+// of concurrent presentations of a token only one gets a successor, and the
+// others revoke it.
+func (s *RefreshTokenService) Rotate(ctx context.Context, raw string) (*models.RefreshToken, string, error) {
+	token, err := s.storage.GetRefreshTokenByHash(ctx, HashAccessToken(raw))
+	if apperr.Is(err, apperr.NotFound) {
+		return nil, "", apperr.Errorf(apperr.Unauthorized, "invalid refresh token")
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to look up refresh token: %w", err)
+	}
+
+	now := s.now()
+	if token.RotatedAt != nil {
+		return nil, "", s.revoke(ctx, token.FamilyID)
+	}
+	if now.After(token.ExpiresAt) {
+		return nil, "", apperr.Errorf(apperr.Unauthorized, "refresh token expired")
+	}
+
+	next, raw, err := s.newToken(token.UserID, token.FamilyID)
+	if err != nil {
+		return nil, "", err
+	}
+	rotated := *token
+	rotated.RotatedAt = &now
+	err = s.storage.RotateRefreshToken(ctx, &rotated, next)
+	if apperr.Is(err, apperr.Conflict) {
+		// Rotated by a concurrent request since it was read
+		return nil, "", s.revoke(ctx, token.FamilyID)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+	return &rotated, raw, nil
+}
+
+// revoke deletes a token family whose token was reused, and returns the
+// error reporting it
+func (s *RefreshTokenService) revoke(ctx context.Context, familyID string) error {
+	if err := s.storage.DeleteRefreshTokenFamily(ctx, familyID); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+	return apperr.Errorf(apperr.Unauthorized, "refresh token reused; sign in again")
+}
+
+// newToken returns a new refresh token of the family, and its raw value
+func (s *RefreshTokenService) newToken(userID, familyID string) (*models.RefreshToken, string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	raw := RefreshTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	now := s.now()
+	token := &models.RefreshToken{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: HashAccessToken(raw),
+		CreatedAt: now,
+		ExpiresAt: now.Add(RefreshTokenTTL),
+	}
+	return token, raw, nil
+}
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+// refreshStorage stores refresh tokens. Lookups wait at read, if set, so
+// concurrent rotations all read a token before any rotates it.
+type refreshStorage struct {
+	storage.Storage
+	read *sync.WaitGroup
+
+	mu     sync.Mutex
+	tokens map[string]models.RefreshToken
+}
+
+func (s *refreshStorage) CreateRefreshToken(ctx context.Context, token *models.RefreshToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens[token.ID] = *token
+	return nil
+}
+
+func (s *refreshStorage) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	if s.read != nil {
+		s.read.Done()
+		s.read.Wait()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, token := range s.tokens {
+		if token.TokenHash == tokenHash {
+			return &token, nil
+		}
+	}
+	return nil, apperr.Errorf(apperr.NotFound, "refresh token not found")
+}
+
+func (s *refreshStorage) RotateRefreshToken(ctx context.Context, token, next *models.RefreshToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tokens[token.ID].RotatedAt != nil {
+		return apperr.Errorf(apperr.Conflict, "refresh token was already rotated")
+	}
+	s.tokens[token.ID], s.tokens[next.ID] = *token, *next
+	return nil
+}
+
+func (s *refreshStorage) DeleteRefreshTokenFamily(ctx context.Context, familyID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, token := range s.tokens {
+		if token.FamilyID == familyID {
+			delete(s.tokens, id)
+		}
+	}
+	return nil
+}
+
+func TestRefreshTokenService_RotateConcurrently(t *testing.T) {
+	store := &refreshStorage{tokens: make(map[string]models.RefreshToken)}
+	service := NewRefreshTokenService(store)
+	ctx := context.Background()
+
+	raw, err := service.Issue(ctx, "user-1", "session-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Both presentations read the token before either rotates it
+	store.read = &sync.WaitGroup{}
+	store.read.Add(2)
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, errs[i] = service.Rotate(ctx, raw)
+		}()
+	}
+	wg.Wait()
+	store.read = nil
+
+	rotated := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			rotated++
+		case !apperr.Is(err, apperr.Unauthorized):
+			t.Errorf("Expected the reuse to be unauthorized, got %v", err)
+		}
+	}
+	if rotated != 1 {
+		t.Errorf("Expected exactly one rotation, got %d (%v)", rotated, errs)
+	}
+
+	// The reuse revoked the family, including the successor issued
+	if len(store.tokens) != 0 {
+		t.Errorf("Expected the token family to be revoked, got %d tokens", len(store.tokens))
+	}
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"slices"
+
+	"github.com/pankona/memoya/internal/apperr"
+)
+
+// Scope is a permission granted to a personal access token
+type Scope string
+
+const (
+	ScopeTodosRead  Scope = "todos:read"
+	ScopeTodosWrite Scope = "todos:write"
+	ScopeMemosRead  Scope = "memos:read"
+	ScopeMemosWrite Scope = "memos:write"
+	// ScopeAdmin grants every scope, including managing tokens and the account
+	ScopeAdmin Scope = "admin"
+)
+
+// Scopes lists every scope a token can be granted
+var Scopes = []Scope{ScopeTodosRead, ScopeTodosWrite, ScopeMemosRead, ScopeMemosWrite, ScopeAdmin}
+
+// ScopesKey is the key used to store the granted scopes in request context
+const ScopesKey UserContextKey = "scopes"
+
+// WithScopes restricts the request in ctx to the given scopes. Requests
+// without scopes in their context (device flow logins) have full access.
+func WithScopes(ctx context.Context, scopes []Scope) context.Context {
+	return context.WithValue(ctx, ScopesKey, scopes)
+}
+
+// RequireScope checks that the request in ctx was granted all of scopes
+func RequireScope(ctx context.Context, scopes ...Scope) error {
+	granted, ok := ctx.Value(ScopesKey).([]Scope)
+	if !ok || slices.Contains(granted, ScopeAdmin) {
+		return nil
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(granted, scope) {
+			return apperr.Errorf(apperr.Forbidden, "token is missing the %s scope", scope)
+		}
+	}
+	return nil
+}
+
+// ValidScope reports whether s names a known scope
+func ValidScope(s string) bool {
+	return slices.Contains(Scopes, Scope(s))
+}
@@ -0,0 +1,200 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+// SessionKey is the key used to store the session ID in request context
+const SessionKey UserContextKey = "session_id"
+
+// WithSession records the session of the request in ctx
+func WithSession(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, SessionKey, sessionID)
+}
+
+// SessionFromContext returns the session of the request in ctx. Requests
+// authenticated with a personal access token have none.
+func SessionFromContext(ctx context.Context) string {
+	sessionID, _ := ctx.Value(SessionKey).(string)
+	return sessionID
+}
+
+// Tokens are the credentials handed to a client for a session
+type Tokens struct {
+	SessionID    string
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    int // Lifetime of the access token in seconds
+}
+
+// SessionService tracks sign-ins so their tokens can be revoked
+type SessionService struct {
+	storage       storage.Storage
+	refreshTokens *RefreshTokenService
+	now           func() time.Time
+}
+
+func NewSessionService(storage storage.Storage) *SessionService {
+	return &SessionService{
+		storage:       storage,
+		refreshTokens: NewRefreshTokenService(storage),
+		now:           time.Now,
+	}
+}
+
+// Start records a new sign-in for userID from client and issues its tokens
+func (s *SessionService) Start(ctx context.Context, userID, client string) (*Tokens, error) {
+	now := s.now()
+	session := &models.Session{
+		ID:         uuid.New().String(),
+		UserID:     userID,
+		Client:     client,
+		CreatedAt:  now,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(RefreshTokenTTL),
+	}
+	if err := s.storage.CreateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	refreshToken, err := s.refreshTokens.Issue(ctx, userID, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	return s.tokens(session, refreshToken)
+}
+
+// Refresh exchanges a refresh token for new tokens of the same session
+func (s *SessionService) Refresh(ctx context.Context, raw string) (*Tokens, error) {
+	rotated, refreshToken, err := s.refreshTokens.Rotate(ctx, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.active(ctx, rotated.FamilyID)
+	if err != nil {
+		// Signed out, so the token family has no use left
+		s.storage.DeleteRefreshTokenFamily(ctx, rotated.FamilyID)
+		return nil, err
+	}
+
+	now := s.now()
+	session.LastUsedAt = now
+	session.ExpiresAt = now.Add(RefreshTokenTTL)
+	if err := s.storage.UpdateSession(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to update session: %w", err)
+	}
+	return s.tokens(session, refreshToken)
+}
+
+// Authenticate verifies a JWT and checks that its session has not been
+// signed out
+func (s *SessionService) Authenticate(ctx context.Context, token string) (*Claims, error) {
+	claims, err := ParseJWT(token)
+	if err != nil {
+		return nil, apperr.Errorf(apperr.Unauthorized, "invalid token")
+	}
+	if claims.SessionID == "" {
+		// Issued before sessions existed and so cannot be revoked
+		return nil, apperr.Errorf(apperr.Unauthorized, "token predates sessions; sign in again")
+	}
+	if _, err := s.active(ctx, claims.SessionID); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// List returns the active sessions of userID, most recently used first
+func (s *SessionService) List(ctx context.Context, userID string) ([]*models.Session, error) {
+	sessions, err := s.storage.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	now := s.now()
+	active := make([]*models.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if now.Before(session.ExpiresAt) {
+			active = append(active, session)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].LastUsedAt.After(active[j].LastUsedAt)
+	})
+	return active, nil
+}
+
+// Revoke signs out a session of userID, invalidating its access and refresh
+// tokens
+func (s *SessionService) Revoke(ctx context.Context, userID, sessionID string) error {
+	session, err := s.storage.GetSession(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+	if session.UserID != userID {
+		return apperr.Errorf(apperr.Forbidden, "access denied: session belongs to different user")
+	}
+	return s.delete(ctx, sessionID)
+}
+
+// RevokeAll signs out every session of userID and returns how many there were
+func (s *SessionService) RevokeAll(ctx context.Context, userID string) (int, error) {
+	sessions, err := s.storage.ListSessions(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	for _, session := range sessions {
+		if err := s.delete(ctx, session.ID); err != nil {
+			return 0, err
+		}
+	}
+	return len(sessions), nil
+}
+
+// active returns the session unless it was signed out or has expired
+func (s *SessionService) active(ctx context.Context, sessionID string) (*models.Session, error) {
+	session, err := s.storage.GetSession(ctx, sessionID)
+	if apperr.Is(err, apperr.NotFound) {
+		return nil, apperr.Errorf(apperr.Unauthorized, "session has been signed out")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+	if s.now().After(session.ExpiresAt) {
+		return nil, apperr.Errorf(apperr.Unauthorized, "session expired")
+	}
+	return session, nil
+}
+
+func (s *SessionService) delete(ctx context.Context, sessionID string) error {
+	if err := s.storage.DeleteRefreshTokenFamily(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+	if err := s.storage.DeleteSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
+
+func (s *SessionService) tokens(session *models.Session, refreshToken string) (*Tokens, error) {
+	accessToken, err := GenerateJWT(session.UserID, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tokens{
+		SessionID:    session.ID,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int(AccessTokenTTL.Seconds()),
+	}, nil
+}
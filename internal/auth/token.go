@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+// AccessTokenPrefix starts every personal access token, so they can be told
+// apart from JWTs and found by secret scanners
+const AccessTokenPrefix = "mpat_"
+
+// lastUsedInterval limits how often last-used times are written
+const lastUsedInterval = time.Minute
+
+// TokenService issues and verifies personal access tokens
+type TokenService struct {
+	storage storage.Storage
+	now     func() time.Time
+}
+
+func NewTokenService(storage storage.Storage) *TokenService {
+	return &TokenService{
+		storage: storage,
+		now:     time.Now,
+	}
+}
+
+// IsAccessToken reports whether token looks like a personal access token
+func IsAccessToken(token string) bool {
+	return strings.HasPrefix(token, AccessTokenPrefix)
+}
+
+// HashAccessToken returns the hash stored for a personal access token or a
+// refresh token
+func HashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Create issues a token for the user. The returned token string is shown once;
+// only its hash is stored. A zero expiresIn creates a token that never expires.
+func (s *TokenService) Create(ctx context.Context, userID, name string, scopes []string, expiresIn time.Duration) (string, *models.AccessToken, error) {
+	if strings.TrimSpace(name) == "" {
+		return "", nil, apperr.Errorf(apperr.Validation, "token name is required")
+	}
+	if len(scopes) == 0 {
+		return "", nil, apperr.Errorf(apperr.Validation, "at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if !ValidScope(scope) {
+			return "", nil, apperr.Errorf(apperr.Validation, "unknown scope: %s", scope)
+		}
+	}
+	if expiresIn < 0 {
+		return "", nil, apperr.Errorf(apperr.Validation, "expiry must not be negative")
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+	raw := AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	now := s.now()
+	token := &models.AccessToken{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Name:      name,
+		TokenHash: HashAccessToken(raw),
+		Scopes:    scopes,
+		CreatedAt: now,
+	}
+	if expiresIn > 0 {
+		expiresAt := now.Add(expiresIn)
+		token.ExpiresAt = &expiresAt
+	}
+
+	if err := s.storage.CreateAccessToken(ctx, token); err != nil {
+		return "", nil, fmt.Errorf("failed to store access token: %w", err)
+	}
+
+	return raw, token, nil
+}
+
+// Authenticate returns the stored token for raw and records its use
+func (s *TokenService) Authenticate(ctx context.Context, raw string) (*models.AccessToken, error) {
+	token, err := s.storage.GetAccessTokenByHash(ctx, HashAccessToken(raw))
+	if apperr.Is(err, apperr.NotFound) {
+		return nil, apperr.Errorf(apperr.Unauthorized, "invalid access token")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up access token: %w", err)
+	}
+
+	now := s.now()
+	if token.ExpiresAt != nil && now.After(*token.ExpiresAt) {
+		return nil, apperr.Errorf(apperr.Unauthorized, "access token expired")
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) >= lastUsedInterval {
+		token.LastUsedAt = &now
+		// Failing to record the use must not fail the request
+		s.storage.UpdateAccessToken(ctx, token)
+	}
+
+	return token, nil
+}
+
+// List returns the user's tokens
+func (s *TokenService) List(ctx context.Context, userID string) ([]*models.AccessToken, error) {
+	tokens, err := s.storage.ListAccessTokens(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list access tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// Revoke deletes one of the user's tokens
+func (s *TokenService) Revoke(ctx context.Context, userID, id string) error {
+	token, err := s.storage.GetAccessToken(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get access token: %w", err)
+	}
+	if token.UserID != userID {
+		return apperr.Errorf(apperr.Forbidden, "access denied: access token belongs to different user")
+	}
+
+	if err := s.storage.DeleteAccessToken(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete access token: %w", err)
+	}
+	return nil
+}
+
+// ScopesOf converts the stored scopes of token
+func ScopesOf(token *models.AccessToken) []Scope {
+	scopes := make([]Scope, len(token.Scopes))
+	for i, scope := range token.Scopes {
+		scopes[i] = Scope(scope)
+	}
+	return scopes
+}
@@ -7,14 +7,33 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"sync"
 	"time"
+
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/handlers"
 )
 
+// refreshLeeway is how long before expiry the access token is renewed
+const refreshLeeway = 5 * time.Minute
+
+// CredentialStore persists the tokens of the signed-in user
+type CredentialStore interface {
+	Load() (*handlers.Config, error)
+	Save(config *handlers.Config) error
+}
+
 // HTTPClient handles HTTP communication with the Cloud Run server
 type HTTPClient struct {
 	baseURL    string
 	httpClient *http.Client
 	authToken  string
+
+	// mu serializes token refreshes: a refresh token is single use, so two
+	// concurrent refreshes would look like token reuse to the server
+	mu    sync.Mutex
+	store CredentialStore
 }
 
 // NewHTTPClient creates a new HTTP client instance
@@ -29,32 +48,49 @@ func NewHTTPClient(baseURL string) *HTTPClient {
 
 // SetAuthToken sets the bearer token for authentication
 func (c *HTTPClient) SetAuthToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	c.authToken = token
 }
 
-// CallTool makes an HTTP POST request to the specified MCP tool endpoint
+// SetCredentialStore makes the client read its tokens from store and renew
+// them with the saved refresh token when the access token expires
+func (c *HTTPClient) SetCredentialStore(store CredentialStore) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.store = store
+}
+
+// authEndpoints are the calls served outside /mcp/<tool>
+var authEndpoints = map[string]struct {
+	method string
+	path   string
+}{
+	"device_auth_start": {"POST", "/auth/device_start"},
+	"device_auth_poll":  {"POST", "/auth/device_poll"},
+	"user_info":         {"GET", "/auth/user"},
+	"delete_account":    {"POST", "/auth/delete_account"},
+	"sessions":          {"GET", "/auth/sessions"},
+	"logout":            {"POST", "/auth/logout"},
+	"logout_all":        {"POST", "/auth/logout_all"},
+	"identities":        {"GET", "/auth/identities"},
+}
+
+// CallTool makes an HTTP request to the endpoint of the named tool
 func (c *HTTPClient) CallTool(ctx context.Context, toolName string, args interface{}) ([]byte, error) {
-	// Determine URL based on tool type
-	var url string
-	switch toolName {
-	case "device_auth_start":
-		url = fmt.Sprintf("%s/auth/device_start", c.baseURL)
-	case "device_auth_poll":
-		url = fmt.Sprintf("%s/auth/device_poll", c.baseURL)
-	case "user_info":
-		url = fmt.Sprintf("%s/auth/user", c.baseURL)
-		// Use GET for user info
-		return c.makeRequest(ctx, "GET", url, nil)
-	case "delete_account":
-		url = fmt.Sprintf("%s/auth/delete_account", c.baseURL)
-	default:
-		url = fmt.Sprintf("%s/mcp/%s", c.baseURL, toolName)
-	}
-
-	return c.makeRequest(ctx, "POST", url, args)
+	ep, ok := authEndpoints[toolName]
+	if !ok {
+		return c.makeRequest(ctx, "POST", fmt.Sprintf("%s/mcp/%s", c.baseURL, toolName), args)
+	}
+
+	if ep.method == "GET" {
+		args = nil
+	}
+	return c.makeRequest(ctx, ep.method, c.baseURL+ep.path, args)
 }
 
-// makeRequest is a helper method for making HTTP requests
+// makeRequest is a helper method for making HTTP requests. A request
+// rejected with 401 is retried once after refreshing the access token.
 func (c *HTTPClient) makeRequest(ctx context.Context, method, url string, args interface{}) ([]byte, error) {
 	var jsonData []byte
 	var err error
@@ -67,8 +103,24 @@ func (c *HTTPClient) makeRequest(ctx context.Context, method, url string, args i
 		}
 	}
 
+	token := c.currentToken(ctx)
+	body, err := c.doRequest(ctx, method, url, jsonData, token)
+	if token == "" || !apperr.Is(err, apperr.Unauthorized) {
+		return body, err
+	}
+
+	refreshed, refreshErr := c.refresh(ctx, token)
+	if refreshErr != nil {
+		return nil, err
+	}
+	return c.doRequest(ctx, method, url, jsonData, refreshed)
+}
+
+// doRequest sends a single request with the given bearer token
+func (c *HTTPClient) doRequest(ctx context.Context, method, url string, jsonData []byte, token string) ([]byte, error) {
 	// Create HTTP request
 	var req *http.Request
+	var err error
 	if len(jsonData) > 0 {
 		req, err = http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(jsonData))
 	} else {
@@ -84,8 +136,8 @@ func (c *HTTPClient) makeRequest(ctx context.Context, method, url string, args i
 	req.Header.Set("User-Agent", "memoya-mcp-client/1.0")
 
 	// Add authentication header if token is available
-	if c.authToken != "" {
-		req.Header.Set("Authorization", "Bearer "+c.authToken)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
 	}
 
 	// Execute request
@@ -103,26 +155,128 @@ func (c *HTTPClient) makeRequest(ctx context.Context, method, url string, args i
 
 	// Check HTTP status
 	if resp.StatusCode >= 400 {
-		// Handle authentication errors specially
-		if resp.StatusCode == 401 {
-			return nil, fmt.Errorf("AUTHENTICATION_REQUIRED: %s. Use auth_start to authenticate with memoya", string(body))
-		}
+		return nil, responseError(resp.StatusCode, resp.Header, body)
+	}
+
+	return body, nil
+}
+
+// currentToken returns the access token to send, renewing the saved one
+// first when it is about to expire
+func (c *HTTPClient) currentToken(ctx context.Context) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.store == nil {
+		return c.authToken
+	}
+	config, err := c.store.Load()
+	if err != nil {
+		// No saved sign-in, e.g. after auth_logout
+		c.authToken = ""
+		return ""
+	}
 
-		// Try to parse error response
-		var errorResp struct {
-			Success bool   `json:"success"`
-			Error   string `json:"error"`
-			Code    string `json:"code"`
+	c.authToken = config.AuthToken
+	if config.RefreshToken != "" && config.TokenExpiresAt != nil && time.Until(*config.TokenExpiresAt) < refreshLeeway {
+		// On failure send the old token anyway; the 401 path reports it
+		if token, err := c.refreshLocked(ctx, config); err == nil {
+			return token
 		}
+	}
+	return c.authToken
+}
+
+// refresh renews the access token after rejected was refused by the server
+func (c *HTTPClient) refresh(ctx context.Context, rejected string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.store == nil {
+		return "", apperr.Errorf(apperr.Unauthorized, "no refresh token available")
+	}
+	config, err := c.store.Load()
+	if err != nil {
+		return "", fmt.Errorf("failed to load credentials: %w", err)
+	}
+
+	// Another request may have refreshed while this one was in flight
+	if config.AuthToken != "" && config.AuthToken != rejected {
+		c.authToken = config.AuthToken
+		return config.AuthToken, nil
+	}
+	if config.RefreshToken == "" {
+		return "", apperr.Errorf(apperr.Unauthorized, "no refresh token available")
+	}
+	return c.refreshLocked(ctx, config)
+}
+
+// refreshLocked exchanges the saved refresh token for new tokens and saves
+// them. The caller must hold c.mu.
+func (c *HTTPClient) refreshLocked(ctx context.Context, config *handlers.Config) (string, error) {
+	reqBody, err := json.Marshal(map[string]string{"refresh_token": config.RefreshToken})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal arguments: %w", err)
+	}
 
-		if json.Unmarshal(body, &errorResp) == nil && !errorResp.Success {
-			return nil, fmt.Errorf("server error [%s]: %s", errorResp.Code, errorResp.Error)
+	respData, err := c.doRequest(ctx, "POST", c.baseURL+"/auth/refresh", reqBody, "")
+	if err != nil {
+		if apperr.Is(err, apperr.Unauthorized) {
+			// The sign-in is gone for good; only auth_start can recover
+			config.AuthToken = ""
+			config.RefreshToken = ""
+			config.TokenExpiresAt = nil
+			c.store.Save(config)
+			c.authToken = ""
 		}
+		return "", err
+	}
 
-		return nil, fmt.Errorf("HTTP error %d: %s", resp.StatusCode, string(body))
+	var resp struct {
+		Data struct {
+			AccessToken  string `json:"access_token"`
+			RefreshToken string `json:"refresh_token"`
+			ExpiresIn    int    `json:"expires_in"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(respData, &resp); err != nil || resp.Data.AccessToken == "" {
+		return "", fmt.Errorf("failed to parse refresh response")
 	}
 
-	return body, nil
+	expiresAt := time.Now().Add(time.Duration(resp.Data.ExpiresIn) * time.Second)
+	config.AuthToken = resp.Data.AccessToken
+	config.RefreshToken = resp.Data.RefreshToken
+	config.TokenExpiresAt = &expiresAt
+	if err := c.store.Save(config); err != nil {
+		return "", fmt.Errorf("failed to save refreshed tokens: %w", err)
+	}
+
+	c.authToken = config.AuthToken
+	return c.authToken, nil
+}
+
+// responseError converts an error response into an apperr error of the kind
+// given by its code, so callers need not match on messages. Retry-After is
+// kept as the error's retry delay.
+func responseError(status int, header http.Header, body []byte) error {
+	if status == http.StatusUnauthorized {
+		return apperr.Errorf(apperr.Unauthorized, "authentication required: %s. Use auth_start to authenticate with memoya", string(body))
+	}
+
+	var errorResp struct {
+		Success bool   `json:"success"`
+		Error   string `json:"error"`
+		Code    string `json:"code"`
+	}
+	if json.Unmarshal(body, &errorResp) == nil && !errorResp.Success {
+		err := apperr.Errorf(apperr.FromCode(errorResp.Code), "server error [%s]: %s", errorResp.Code, errorResp.Error)
+		if seconds, convErr := strconv.Atoi(header.Get("Retry-After")); convErr == nil && seconds > 0 {
+			err = err.WithRetryAfter(time.Duration(seconds) * time.Second)
+		}
+		return err
+	}
+
+	return apperr.Errorf(apperr.Internal, "HTTP error %d: %s", status, string(body))
 }
 
 // Ping checks if the server is reachable
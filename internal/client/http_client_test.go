@@ -2,9 +2,14 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
+
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/handlers"
 )
 
 func TestHTTPClient_Ping(t *testing.T) {
@@ -201,3 +206,140 @@ func TestHTTPClient_CallTool_ErrorResponse(t *testing.T) {
 		t.Errorf("Expected error %s, got %s", expectedError, err.Error())
 	}
 }
+
+func TestHTTPClient_CallTool_ErrorKind(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   apperr.Kind
+	}{
+		{"not found", http.StatusNotFound, `{"success":false,"error":"todo not found","code":"NOT_FOUND"}`, apperr.NotFound},
+		{"pending", http.StatusBadRequest, `{"success":false,"error":"authorization pending","code":"AUTHORIZATION_PENDING"}`, apperr.AuthPending},
+		{"unauthorized", http.StatusUnauthorized, `{"success":false,"error":"Invalid token","code":"UNAUTHORIZED"}`, apperr.Unauthorized},
+		{"not JSON", http.StatusBadGateway, `bad gateway`, apperr.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			_, err := NewHTTPClient(server.URL).CallTool(context.Background(), "todo_get", map[string]string{"id": "x"})
+			if got := apperr.KindOf(err); got != tt.want {
+				t.Errorf("Expected %s, got %s (%v)", tt.want, got, err)
+			}
+		})
+	}
+}
+
+// memoryStore is a CredentialStore kept in memory
+type memoryStore struct {
+	config handlers.Config
+}
+
+func (m *memoryStore) Load() (*handlers.Config, error) {
+	config := m.config
+	return &config, nil
+}
+
+func (m *memoryStore) Save(config *handlers.Config) error {
+	m.config = *config
+	return nil
+}
+
+func TestHTTPClient_RefreshOnUnauthorized(t *testing.T) {
+	refreshes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/auth/refresh" {
+			var req struct {
+				RefreshToken string `json:"refresh_token"`
+			}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req.RefreshToken != "mrt_old" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"success":false,"error":"refresh token reused; sign in again","code":"UNAUTHORIZED"}`))
+				return
+			}
+			refreshes++
+			w.Write([]byte(`{"success":true,"data":{"access_token":"fresh","refresh_token":"mrt_new","expires_in":3600}}`))
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"success":false,"error":"token is expired","code":"UNAUTHORIZED"}`))
+			return
+		}
+		w.Write([]byte(`{"success":true}`))
+	}))
+	defer server.Close()
+
+	expiresAt := time.Now().Add(time.Hour)
+	store := &memoryStore{config: handlers.Config{AuthToken: "stale", RefreshToken: "mrt_old", TokenExpiresAt: &expiresAt}}
+	client := NewHTTPClient(server.URL)
+	client.SetCredentialStore(store)
+
+	if _, err := client.CallTool(context.Background(), "todo_list", struct{}{}); err != nil {
+		t.Fatalf("Expected the request to succeed after refreshing, got %v", err)
+	}
+	if refreshes != 1 || store.config.AuthToken != "fresh" || store.config.RefreshToken != "mrt_new" {
+		t.Errorf("Expected rotated tokens to be saved, got %d refreshes and %+v", refreshes, store.config)
+	}
+
+	// A rejected refresh clears the saved sign-in
+	store.config = handlers.Config{AuthToken: "stale", RefreshToken: "mrt_reused", TokenExpiresAt: &expiresAt}
+	_, err := client.CallTool(context.Background(), "todo_list", struct{}{})
+	if !apperr.Is(err, apperr.Unauthorized) {
+		t.Errorf("Expected unauthorized, got %v", err)
+	}
+	if store.config.AuthToken != "" || store.config.RefreshToken != "" {
+		t.Errorf("Expected tokens to be cleared, got %+v", store.config)
+	}
+}
+
+func TestHTTPClient_RefreshNearExpiry(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/auth/refresh":
+			w.Write([]byte(`{"success":true,"data":{"access_token":"fresh","refresh_token":"mrt_new","expires_in":3600}}`))
+		case r.Header.Get("Authorization") == "Bearer fresh":
+			w.Write([]byte(`{"success":true}`))
+		default:
+			t.Errorf("Expected the token to be refreshed before the request, got %q", r.Header.Get("Authorization"))
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	expiresAt := time.Now().Add(time.Minute)
+	store := &memoryStore{config: handlers.Config{AuthToken: "expiring", RefreshToken: "mrt_old", TokenExpiresAt: &expiresAt}}
+	client := NewHTTPClient(server.URL)
+	client.SetCredentialStore(store)
+
+	if _, err := client.CallTool(context.Background(), "todo_list", struct{}{}); err != nil {
+		t.Fatalf("Expected the request to succeed, got %v", err)
+	}
+	if !store.config.TokenExpiresAt.After(time.Now().Add(50 * time.Minute)) {
+		t.Errorf("Expected the new expiry to be saved, got %v", store.config.TokenExpiresAt)
+	}
+}
+
+func TestMCPBridge_HandleError(t *testing.T) {
+	bridge := NewMCPBridge(NewHTTPClient("http://example.com"))
+
+	var resp map[string]any
+	json.Unmarshal(bridge.handleError(apperr.Errorf(apperr.NotFound, "todo not found")), &resp)
+	if resp["code"] != "NOT_FOUND" || resp["suggestion"] == nil {
+		t.Errorf("Expected NOT_FOUND with a suggestion, got %v", resp)
+	}
+
+	resp = nil
+	json.Unmarshal(bridge.handleError(apperr.Errorf(apperr.Unauthorized, "Invalid token")), &resp)
+	if resp["authenticated"] != false {
+		t.Errorf("Expected unauthenticated response, got %v", resp)
+	}
+}
@@ -4,9 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
-	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
 	"github.com/pankona/memoya/internal/handlers"
 )
 
@@ -30,15 +29,27 @@ func (b *MCPBridge) ensureAuth() {
 	}
 }
 
+// errorHints are the actionable messages shown for each error kind
+var errorHints = map[apperr.Kind]string{
+	apperr.NotFound:      "The requested item does not exist. Use the list or search tools to find valid IDs.",
+	apperr.Forbidden:     "The requested item belongs to another user and cannot be accessed.",
+	apperr.Validation:    "The arguments were rejected. Check the tool's input schema and try again.",
+	apperr.Conflict:      "The item was changed concurrently. Fetch it again and retry.",
+	apperr.RateLimited:   "Too many requests were sent. Wait a moment before retrying.",
+	apperr.QuotaExceeded: "The storage quota is used up. Delete items you no longer need, or check quota_status.",
+}
+
 // handleError converts HTTP errors into structured JSON responses for MCP
 func (b *MCPBridge) handleError(err error) []byte {
 	errorMsg := err.Error()
+	kind := apperr.KindOf(err)
 
 	// Check if it's an authentication error
-	if strings.Contains(errorMsg, "AUTHENTICATION_REQUIRED") {
+	if kind == apperr.Unauthorized {
 		response := map[string]interface{}{
 			"success":       false,
 			"authenticated": false,
+			"code":          kind,
 			"error":         "Authentication required",
 			"message":       "Authentication is required to use memoya. Please run the 'auth_start' tool to authenticate.",
 			"suggestion":    "Use the auth_start tool to begin the authentication process.",
@@ -47,13 +58,13 @@ func (b *MCPBridge) handleError(err error) []byte {
 		return jsonBytes
 	}
 
-	// Check if it's an OAuth configuration error
-	if strings.Contains(errorMsg, "OAuth") && strings.Contains(errorMsg, "not found") {
+	if hint, ok := errorHints[kind]; ok {
 		response := map[string]interface{}{
-			"success": false,
-			"error":   "OAuth configuration missing",
-			"message": "The memoya server is not properly configured. Please contact the administrator to set up OAuth credentials.",
-			"details": "This is a server-side configuration issue that requires administrator attention.",
+			"success":    false,
+			"code":       kind,
+			"error":      errorMsg,
+			"message":    fmt.Sprintf("Operation failed: %s", errorMsg),
+			"suggestion": hint,
 		}
 		jsonBytes, _ := json.Marshal(response)
 		return jsonBytes
@@ -62,6 +73,7 @@ func (b *MCPBridge) handleError(err error) []byte {
 	// Generic error response
 	response := map[string]interface{}{
 		"success": false,
+		"code":    kind,
 		"error":   errorMsg,
 		"message": fmt.Sprintf("Operation failed: %s", errorMsg),
 	}
@@ -69,221 +81,14 @@ func (b *MCPBridge) handleError(err error) []byte {
 	return jsonBytes
 }
 
-// Memo operations
-
-func (b *MCPBridge) MemoCreate(ctx context.Context, ss *mcp.ServerSession,
-	params *mcp.CallToolParamsFor[handlers.MemoCreateArgs]) (*mcp.CallToolResultFor[handlers.MemoResult], error) {
-	b.ensureAuth()
-
-	respData, err := b.httpClient.CallTool(ctx, "memo_create", params.Arguments)
-	if err != nil {
-		// Return structured error response instead of failing
-		errorData := b.handleError(err)
-		return &mcp.CallToolResultFor[handlers.MemoResult]{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: string(errorData)},
-			},
-		}, nil
-	}
-
-	return &mcp.CallToolResultFor[handlers.MemoResult]{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: string(respData)},
-		},
-	}, nil
-}
-
-func (b *MCPBridge) MemoList(ctx context.Context, ss *mcp.ServerSession,
-	params *mcp.CallToolParamsFor[handlers.MemoListArgs]) (*mcp.CallToolResultFor[handlers.MemoListResult], error) {
-	b.ensureAuth()
-
-	respData, err := b.httpClient.CallTool(ctx, "memo_list", params.Arguments)
-	if err != nil {
-		errorData := b.handleError(err)
-		return &mcp.CallToolResultFor[handlers.MemoListResult]{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: string(errorData)},
-			},
-		}, nil
-	}
-
-	return &mcp.CallToolResultFor[handlers.MemoListResult]{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: string(respData)},
-		},
-	}, nil
-}
-
-func (b *MCPBridge) MemoUpdate(ctx context.Context, ss *mcp.ServerSession,
-	params *mcp.CallToolParamsFor[handlers.MemoUpdateArgs]) (*mcp.CallToolResultFor[handlers.MemoResult], error) {
-	b.ensureAuth()
-
-	respData, err := b.httpClient.CallTool(ctx, "memo_update", params.Arguments)
-	if err != nil {
-		errorData := b.handleError(err)
-		return &mcp.CallToolResultFor[handlers.MemoResult]{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: string(errorData)},
-			},
-		}, nil
-	}
-
-	return &mcp.CallToolResultFor[handlers.MemoResult]{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: string(respData)},
-		},
-	}, nil
-}
-
-func (b *MCPBridge) MemoDelete(ctx context.Context, ss *mcp.ServerSession,
-	params *mcp.CallToolParamsFor[handlers.MemoDeleteArgs]) (*mcp.CallToolResultFor[handlers.MemoDeleteResult], error) {
-	b.ensureAuth()
-
-	respData, err := b.httpClient.CallTool(ctx, "memo_delete", params.Arguments)
-	if err != nil {
-		errorData := b.handleError(err)
-		return &mcp.CallToolResultFor[handlers.MemoDeleteResult]{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: string(errorData)},
-			},
-		}, nil
-	}
-
-	return &mcp.CallToolResultFor[handlers.MemoDeleteResult]{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: string(respData)},
-		},
-	}, nil
-}
-
-// Todo operations
-
-func (b *MCPBridge) TodoCreate(ctx context.Context, ss *mcp.ServerSession,
-	params *mcp.CallToolParamsFor[handlers.TodoCreateArgs]) (*mcp.CallToolResultFor[handlers.TodoResult], error) {
-	b.ensureAuth()
-
-	respData, err := b.httpClient.CallTool(ctx, "todo_create", params.Arguments)
-	if err != nil {
-		errorData := b.handleError(err)
-		return &mcp.CallToolResultFor[handlers.TodoResult]{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: string(errorData)},
-			},
-		}, nil
-	}
-
-	return &mcp.CallToolResultFor[handlers.TodoResult]{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: string(respData)},
-		},
-	}, nil
-}
-
-func (b *MCPBridge) TodoList(ctx context.Context, ss *mcp.ServerSession,
-	params *mcp.CallToolParamsFor[handlers.TodoListArgs]) (*mcp.CallToolResultFor[handlers.TodoListResult], error) {
-	b.ensureAuth()
-
-	respData, err := b.httpClient.CallTool(ctx, "todo_list", params.Arguments)
-	if err != nil {
-		errorData := b.handleError(err)
-		return &mcp.CallToolResultFor[handlers.TodoListResult]{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: string(errorData)},
-			},
-		}, nil
-	}
-
-	return &mcp.CallToolResultFor[handlers.TodoListResult]{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: string(respData)},
-		},
-	}, nil
-}
-
-func (b *MCPBridge) TodoUpdate(ctx context.Context, ss *mcp.ServerSession,
-	params *mcp.CallToolParamsFor[handlers.TodoUpdateArgs]) (*mcp.CallToolResultFor[handlers.TodoResult], error) {
-	b.ensureAuth()
-
-	respData, err := b.httpClient.CallTool(ctx, "todo_update", params.Arguments)
-	if err != nil {
-		errorData := b.handleError(err)
-		return &mcp.CallToolResultFor[handlers.TodoResult]{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: string(errorData)},
-			},
-		}, nil
-	}
-
-	return &mcp.CallToolResultFor[handlers.TodoResult]{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: string(respData)},
-		},
-	}, nil
-}
-
-func (b *MCPBridge) TodoDelete(ctx context.Context, ss *mcp.ServerSession,
-	params *mcp.CallToolParamsFor[handlers.TodoDeleteArgs]) (*mcp.CallToolResultFor[handlers.DeleteResult], error) {
+// Forward forwards a tool call to memoya-server. Failures are returned as a
+// structured JSON response instead of an error, so the model can see them.
+func (b *MCPBridge) Forward(ctx context.Context, name string, args any) (string, error) {
 	b.ensureAuth()
 
-	respData, err := b.httpClient.CallTool(ctx, "todo_delete", params.Arguments)
+	respData, err := b.httpClient.CallTool(ctx, name, args)
 	if err != nil {
-		errorData := b.handleError(err)
-		return &mcp.CallToolResultFor[handlers.DeleteResult]{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: string(errorData)},
-			},
-		}, nil
+		return string(b.handleError(err)), nil
 	}
-
-	return &mcp.CallToolResultFor[handlers.DeleteResult]{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: string(respData)},
-		},
-	}, nil
-}
-
-// Search operations
-
-func (b *MCPBridge) Search(ctx context.Context, ss *mcp.ServerSession,
-	params *mcp.CallToolParamsFor[handlers.SearchArgs]) (*mcp.CallToolResultFor[handlers.SearchResult], error) {
-	b.ensureAuth()
-
-	respData, err := b.httpClient.CallTool(ctx, "search", params.Arguments)
-	if err != nil {
-		errorData := b.handleError(err)
-		return &mcp.CallToolResultFor[handlers.SearchResult]{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: string(errorData)},
-			},
-		}, nil
-	}
-
-	return &mcp.CallToolResultFor[handlers.SearchResult]{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: string(respData)},
-		},
-	}, nil
-}
-
-// Tag operations
-
-func (b *MCPBridge) TagList(ctx context.Context, ss *mcp.ServerSession,
-	params *mcp.CallToolParamsFor[handlers.TagListArgs]) (*mcp.CallToolResultFor[handlers.TagListResult], error) {
-	b.ensureAuth()
-
-	respData, err := b.httpClient.CallTool(ctx, "tag_list", params.Arguments)
-	if err != nil {
-		errorData := b.handleError(err)
-		return &mcp.CallToolResultFor[handlers.TagListResult]{
-			Content: []mcp.Content{
-				&mcp.TextContent{Text: string(errorData)},
-			},
-		}, nil
-	}
-
-	return &mcp.CallToolResultFor[handlers.TagListResult]{
-		Content: []mcp.Content{
-			&mcp.TextContent{Text: string(respData)},
-		},
-	}, nil
+	return string(respData), nil
 }
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/models"
+)
+
+const (
+	// changesWaitSeconds must stay below the HTTP client timeout
+	changesWaitSeconds   = 25
+	changesMinRetryDelay = 5 * time.Second
+	changesMaxRetryDelay = 2 * time.Minute
+)
+
+type changesRequest struct {
+	Cursor         string `json:"cursor,omitempty"`
+	TimeoutSeconds int    `json:"timeout_seconds"`
+}
+
+type changesResponse struct {
+	Changes []*models.Change `json:"changes"`
+	Cursor  string           `json:"cursor"`
+	Reset   bool             `json:"reset"`
+}
+
+// WatchChanges long-polls the server for changes to the user's todos and memos
+// until ctx is done, passing each batch to notify. A nil batch means the
+// server lost track of the feed and anything may have changed.
+func (b *MCPBridge) WatchChanges(ctx context.Context, notify func([]*models.Change)) {
+	cursor := ""
+	delay := changesMinRetryDelay
+
+	for ctx.Err() == nil {
+		b.ensureAuth()
+
+		resp, err := b.waitChanges(ctx, cursor)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			// Not authenticated yet or server unavailable: try again later
+			log.Printf("Failed to wait for changes, retrying in %s: %v", delay, err)
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return
+			}
+			delay = min(delay*2, changesMaxRetryDelay)
+			continue
+		}
+		cursor = resp.Cursor
+
+		if resp.Reset {
+			notify(nil)
+			// A cursor reset again right away must not become a busy loop of
+			// list changes, so resets back off like failures
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return
+			}
+			delay = min(delay*2, changesMaxRetryDelay)
+			continue
+		}
+		delay = changesMinRetryDelay
+
+		if len(resp.Changes) > 0 {
+			notify(resp.Changes)
+		}
+	}
+}
+
+func (b *MCPBridge) waitChanges(ctx context.Context, cursor string) (*changesResponse, error) {
+	respData, err := b.httpClient.CallTool(ctx, "changes", changesRequest{
+		Cursor:         cursor,
+		TimeoutSeconds: changesWaitSeconds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var resp changesResponse
+	if err := json.Unmarshal(respData, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse changes response: %w", err)
+	}
+	return &resp, nil
+}
+
+// NotifyChanges returns a notify function for WatchChanges that relays changes
+// to the sessions of server. Sessions subscribed to a changed todo or memo,
+// to the today resource or to a tag resource get
+// notifications/resources/updated; the tags of a changed item are not known,
+// so every subscribed tag resource is reported as updated. When todos or
+// memos are created or deleted the resource list changes too, which is
+// announced by re-registering resource, as go-sdk v0.1.0 only sends
+// notifications/resources/list_changed when resources are added or removed.
+// A nil batch reports every subscribed resource as updated.
+func NotifyChanges(server *mcp.Server, subs *Subscriptions, resource *mcp.ServerResource) func([]*models.Change) {
+	return func(changes []*models.Change) {
+		listChanged := changes == nil
+		updated := make(map[string]bool)
+		for _, change := range changes {
+			updated[changeURI(change)] = true
+			if change.Op != models.ChangeOpUpdated {
+				listChanged = true
+			}
+		}
+
+		if listChanged {
+			server.AddResources(resource)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		uris := subs.Subscribed(func(uri string) bool {
+			return changes == nil || updated[uri] || uri == TodayResourceURI || strings.HasPrefix(uri, TagResourcePrefix)
+		})
+		for _, uri := range uris {
+			if err := subs.ResourceUpdated(ctx, uri); err != nil {
+				log.Printf("Failed to notify change of %s: %v", uri, err)
+			}
+		}
+	}
+}
+
+func changeURI(change *models.Change) string {
+	prefix := TodoResourcePrefix
+	if change.Kind == models.ChangeKindMemo {
+		prefix = MemoResourcePrefix
+	}
+	return prefix + url.PathEscape(change.ID)
+}
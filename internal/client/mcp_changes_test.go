@@ -0,0 +1,111 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pankona/memoya/internal/models"
+)
+
+func TestMCPBridge_WatchChanges(t *testing.T) {
+	var cursors []string
+	bridge := newResourceTestBridge(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mcp/changes" {
+			http.NotFound(w, r)
+			return
+		}
+
+		var req changesRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		cursors = append(cursors, req.Cursor)
+
+		switch req.Cursor {
+		case "":
+			json.NewEncoder(w).Encode(changesResponse{Cursor: "feed-a.0"})
+		case "feed-a.0":
+			json.NewEncoder(w).Encode(changesResponse{
+				Changes: []*models.Change{{Kind: models.ChangeKindTodo, ID: "t1", Op: models.ChangeOpCreated}},
+				Cursor:  "feed-a.1",
+			})
+		case "feed-a.1":
+			json.NewEncoder(w).Encode(changesResponse{Cursor: "feed-b.0", Reset: true})
+		default:
+			// Nothing more happens: hold the long poll until the client goes away
+			<-r.Context().Done()
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	batches := make(chan []*models.Change, 2)
+	done := make(chan struct{})
+	go func() {
+		bridge.WatchChanges(ctx, func(changes []*models.Change) { batches <- changes })
+		close(done)
+	}()
+
+	var got [][]*models.Change
+	for len(got) < 2 {
+		select {
+		case batch := <-batches:
+			got = append(got, batch)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for changes")
+		}
+	}
+	cancel()
+	<-done
+
+	if len(got[0]) != 1 || got[0][0].ID != "t1" || got[0][0].Op != models.ChangeOpCreated {
+		t.Errorf("Expected created t1 first, got %v", got[0])
+	}
+	if got[1] != nil {
+		t.Errorf("Expected nil batch on reset, got %v", got[1])
+	}
+	if len(cursors) < 3 || cursors[1] != "feed-a.0" || cursors[2] != "feed-a.1" {
+		t.Errorf("Expected cursors to be passed along, got %v", cursors)
+	}
+}
+
+func TestMCPBridge_WatchChanges_ResetBackoff(t *testing.T) {
+	var mu sync.Mutex
+	requests := 0
+	bridge := newResourceTestBridge(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		json.NewEncoder(w).Encode(changesResponse{Cursor: "1", Reset: true})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	resets := 0
+	bridge.WatchChanges(ctx, func(changes []*models.Change) { resets++ })
+
+	mu.Lock()
+	defer mu.Unlock()
+	if requests != 1 || resets != 1 {
+		t.Errorf("Expected one request and reset before backing off, got %d requests and %d resets", requests, resets)
+	}
+}
+
+func TestChangeURI(t *testing.T) {
+	tests := []struct {
+		change   *models.Change
+		expected string
+	}{
+		{&models.Change{Kind: models.ChangeKindTodo, ID: "t1"}, "memoya://todo/t1"},
+		{&models.Change{Kind: models.ChangeKindMemo, ID: "m 1"}, "memoya://memo/m%201"},
+	}
+
+	for _, tt := range tests {
+		if got := changeURI(tt.change); got != tt.expected {
+			t.Errorf("Expected %s, got %s", tt.expected, got)
+		}
+	}
+}
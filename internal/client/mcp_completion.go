@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Complete serves completion/complete for prompt and resource template
+// arguments using the user's tags, todos and memos on the server
+func (b *MCPBridge) Complete(ctx context.Context, ss *mcp.ServerSession, params *mcp.CompleteParams) (*mcp.CompleteResult, error) {
+	b.ensureAuth()
+
+	respData, err := b.httpClient.CallTool(ctx, "complete", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to complete %s: %w", params.Argument.Name, err)
+	}
+
+	var result mcp.CompleteResult
+	if err := json.Unmarshal(respData, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse completion response: %w", err)
+	}
+
+	return &result, nil
+}
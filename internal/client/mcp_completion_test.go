@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestMCPBridge_Complete(t *testing.T) {
+	var gotParams mcp.CompleteParams
+	bridge := newResourceTestBridge(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mcp/complete" {
+			http.NotFound(w, r)
+			return
+		}
+
+		json.NewDecoder(r.Body).Decode(&gotParams)
+
+		json.NewEncoder(w).Encode(&mcp.CompleteResult{
+			Completion: mcp.CompletionResultDetails{Values: []string{"work", "workshop"}, Total: 2},
+		})
+	})
+
+	result, err := bridge.Complete(context.Background(), nil, &mcp.CompleteParams{
+		Ref:      &mcp.CompleteReference{Type: "ref/prompt", Name: "weekly_review"},
+		Argument: mcp.CompleteParamsArgument{Name: "tags", Value: "wo"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if gotParams.Ref == nil || gotParams.Ref.Name != "weekly_review" || gotParams.Argument.Value != "wo" {
+		t.Errorf("Expected params to be forwarded, got %+v", gotParams)
+	}
+	if len(result.Completion.Values) != 2 || result.Completion.Values[0] != "work" {
+		t.Errorf("Expected [work workshop], got %v", result.Completion.Values)
+	}
+}
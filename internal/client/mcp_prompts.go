@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// GetPrompt renders a prompt on the server, which has access to the user's
+// todos and memos
+func (b *MCPBridge) GetPrompt(ctx context.Context, ss *mcp.ServerSession, params *mcp.GetPromptParams) (*mcp.GetPromptResult, error) {
+	b.ensureAuth()
+
+	respData, err := b.httpClient.CallTool(ctx, "prompt_get", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prompt %s: %w", params.Name, err)
+	}
+
+	var result mcp.GetPromptResult
+	if err := json.Unmarshal(respData, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse prompt response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestMCPBridge_GetPrompt(t *testing.T) {
+	var gotParams mcp.GetPromptParams
+	bridge := newResourceTestBridge(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mcp/prompt_get" {
+			http.NotFound(w, r)
+			return
+		}
+
+		json.NewDecoder(r.Body).Decode(&gotParams)
+
+		json.NewEncoder(w).Encode(&mcp.GetPromptResult{
+			Description: "Review of the period",
+			Messages: []*mcp.PromptMessage{
+				{Role: "user", Content: &mcp.TextContent{Text: "Let's do a review"}},
+			},
+		})
+	})
+
+	result, err := bridge.GetPrompt(context.Background(), nil, &mcp.GetPromptParams{
+		Name:      "weekly_review",
+		Arguments: map[string]string{"tags": "work"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if gotParams.Name != "weekly_review" || gotParams.Arguments["tags"] != "work" {
+		t.Errorf("Expected weekly_review with tags=work, got %+v", gotParams)
+	}
+
+	if len(result.Messages) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(result.Messages))
+	}
+	textContent, ok := result.Messages[0].Content.(*mcp.TextContent)
+	if !ok || textContent.Text != "Let's do a review" {
+		t.Errorf("Expected text content, got %#v", result.Messages[0].Content)
+	}
+}
+
+func TestMCPBridge_GetPrompt_ServerError(t *testing.T) {
+	bridge := newResourceTestBridge(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"success":false,"error":"unknown prompt: nope","code":"INTERNAL_ERROR"}`))
+	})
+
+	if _, err := bridge.GetPrompt(context.Background(), nil, &mcp.GetPromptParams{Name: "nope"}); err == nil {
+		t.Error("Expected error, got nil")
+	}
+}
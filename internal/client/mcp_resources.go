@@ -0,0 +1,276 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/handlers"
+	"github.com/pankona/memoya/internal/models"
+)
+
+// Resource URIs exposed by the bridge
+const (
+	TodoResourcePrefix = "memoya://todo/"
+	MemoResourcePrefix = "memoya://memo/"
+	TagResourcePrefix  = "memoya://tag/"
+	TodayResourceURI   = "memoya://today"
+
+	TodoResourceTemplate = TodoResourcePrefix + "{id}"
+	MemoResourceTemplate = MemoResourcePrefix + "{id}"
+	TagResourceTemplate  = TagResourcePrefix + "{tag}"
+
+	resourceMIMEType = "application/json"
+)
+
+// TodayOverview is the content of the memoya://today resource
+type TodayOverview struct {
+	Date       string         `json:"date"`
+	InProgress []*models.Todo `json:"in_progress"`
+	HighTodo   []*models.Todo `json:"high_priority_todo"`
+	DoneToday  []*models.Todo `json:"done_today"`
+	MemosToday []*models.Memo `json:"memos_today"`
+}
+
+// ReadTodo serves memoya://todo/{id}
+func (b *MCPBridge) ReadTodo(ctx context.Context, ss *mcp.ServerSession, params *mcp.ReadResourceParams) (*mcp.ReadResourceResult, error) {
+	id, err := resourceID(params.URI, TodoResourcePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	b.ensureAuth()
+
+	respData, err := b.httpClient.CallTool(ctx, "todo_get", handlers.TodoGetArgs{ID: id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", params.URI, err)
+	}
+
+	var result handlers.TodoResult
+	if err := json.Unmarshal(respData, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse todo response: %w", err)
+	}
+	if result.Todo == nil {
+		return nil, mcp.ResourceNotFoundError(params.URI)
+	}
+
+	return jsonResource(params.URI, result.Todo)
+}
+
+// ReadMemo serves memoya://memo/{id}
+func (b *MCPBridge) ReadMemo(ctx context.Context, ss *mcp.ServerSession, params *mcp.ReadResourceParams) (*mcp.ReadResourceResult, error) {
+	id, err := resourceID(params.URI, MemoResourcePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	b.ensureAuth()
+
+	respData, err := b.httpClient.CallTool(ctx, "memo_get", handlers.MemoGetArgs{ID: id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", params.URI, err)
+	}
+
+	var result handlers.MemoResult
+	if err := json.Unmarshal(respData, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse memo response: %w", err)
+	}
+	if result.Memo == nil {
+		return nil, mcp.ResourceNotFoundError(params.URI)
+	}
+
+	return jsonResource(params.URI, result.Memo)
+}
+
+// ReadTag serves memoya://tag/{tag} with every todo and memo carrying the tag
+func (b *MCPBridge) ReadTag(ctx context.Context, ss *mcp.ServerSession, params *mcp.ReadResourceParams) (*mcp.ReadResourceResult, error) {
+	tag, err := resourceID(params.URI, TagResourcePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	b.ensureAuth()
+
+	respData, err := b.httpClient.CallTool(ctx, "search", handlers.SearchArgs{
+		Tags: []string{tag},
+		Type: "all",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", params.URI, err)
+	}
+
+	var result handlers.SearchResult
+	if err := json.Unmarshal(respData, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse search response: %w", err)
+	}
+
+	return jsonResource(params.URI, result.Results)
+}
+
+// ReadToday serves memoya://today: work in progress, high priority todos and
+// everything finished or written today
+func (b *MCPBridge) ReadToday(ctx context.Context, ss *mcp.ServerSession, params *mcp.ReadResourceParams) (*mcp.ReadResourceResult, error) {
+	b.ensureAuth()
+
+	todos, err := b.listTodos(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", params.URI, err)
+	}
+	memos, err := b.listMemos(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", params.URI, err)
+	}
+
+	now := time.Now()
+	overview := TodayOverview{
+		Date:       now.Format("2006-01-02"),
+		InProgress: []*models.Todo{},
+		HighTodo:   []*models.Todo{},
+		DoneToday:  []*models.Todo{},
+		MemosToday: []*models.Memo{},
+	}
+
+	for _, todo := range todos {
+		switch {
+		case todo.Status == models.StatusInProgress:
+			overview.InProgress = append(overview.InProgress, todo)
+		case todo.Status == models.StatusTodo && todo.Priority == models.PriorityHigh:
+			overview.HighTodo = append(overview.HighTodo, todo)
+		case todo.Status == models.StatusDone && todo.ClosedAt != nil && sameDay(*todo.ClosedAt, now):
+			overview.DoneToday = append(overview.DoneToday, todo)
+		}
+	}
+
+	for _, memo := range memos {
+		if sameDay(memo.LastModified, now) {
+			overview.MemosToday = append(overview.MemosToday, memo)
+		}
+	}
+
+	return jsonResource(params.URI, overview)
+}
+
+// ResourceListMiddleware extends resources/list with one concrete resource per
+// todo and memo, so clients can browse and attach them without a tool call.
+// The statically registered resources (e.g. memoya://today) are listed first.
+func (b *MCPBridge) ResourceListMiddleware(next mcp.MethodHandler[*mcp.ServerSession]) mcp.MethodHandler[*mcp.ServerSession] {
+	return func(ctx context.Context, ss *mcp.ServerSession, method string, params mcp.Params) (mcp.Result, error) {
+		res, err := next(ctx, ss, method, params)
+		if err != nil || method != "resources/list" {
+			return res, err
+		}
+
+		list, ok := res.(*mcp.ListResourcesResult)
+		if !ok || list.NextCursor != "" {
+			// Append item resources only once, after the last static page
+			return res, nil
+		}
+
+		b.ensureAuth()
+
+		items, err := b.itemResources(ctx)
+		if err != nil {
+			// Listing should keep working while unauthenticated
+			log.Printf("Failed to list todo and memo resources: %v", err)
+			return list, nil
+		}
+
+		list.Resources = append(list.Resources, items...)
+		return list, nil
+	}
+}
+
+func (b *MCPBridge) itemResources(ctx context.Context) ([]*mcp.Resource, error) {
+	todos, err := b.listTodos(ctx)
+	if err != nil {
+		return nil, err
+	}
+	memos, err := b.listMemos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*mcp.Resource, 0, len(todos)+len(memos))
+	for _, todo := range todos {
+		resources = append(resources, &mcp.Resource{
+			URI:         TodoResourcePrefix + url.PathEscape(todo.ID),
+			Name:        todo.Title,
+			Description: fmt.Sprintf("Todo (%s, %s priority)", todo.Status, todo.Priority),
+			MIMEType:    resourceMIMEType,
+		})
+	}
+	for _, memo := range memos {
+		resources = append(resources, &mcp.Resource{
+			URI:         MemoResourcePrefix + url.PathEscape(memo.ID),
+			Name:        memo.Title,
+			Description: "Memo",
+			MIMEType:    resourceMIMEType,
+		})
+	}
+
+	return resources, nil
+}
+
+func (b *MCPBridge) listTodos(ctx context.Context) ([]*models.Todo, error) {
+	respData, err := b.httpClient.CallTool(ctx, "todo_list", handlers.TodoListArgs{})
+	if err != nil {
+		return nil, err
+	}
+
+	var result handlers.TodoListResult
+	if err := json.Unmarshal(respData, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse todo list response: %w", err)
+	}
+	return result.Todos, nil
+}
+
+func (b *MCPBridge) listMemos(ctx context.Context) ([]*models.Memo, error) {
+	respData, err := b.httpClient.CallTool(ctx, "memo_list", handlers.MemoListArgs{})
+	if err != nil {
+		return nil, err
+	}
+
+	var result handlers.MemoListResult
+	if err := json.Unmarshal(respData, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse memo list response: %w", err)
+	}
+	return result.Memos, nil
+}
+
+// resourceID extracts the unescaped path segment following prefix
+func resourceID(uri, prefix string) (string, error) {
+	rest, ok := strings.CutPrefix(uri, prefix)
+	if !ok || rest == "" {
+		return "", mcp.ResourceNotFoundError(uri)
+	}
+
+	id, err := url.PathUnescape(rest)
+	if err != nil {
+		return "", fmt.Errorf("invalid resource URI %s: %w", uri, err)
+	}
+	return id, nil
+}
+
+func jsonResource(uri string, v any) (*mcp.ReadResourceResult, error) {
+	jsonBytes, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource: %w", err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{URI: uri, MIMEType: resourceMIMEType, Text: string(jsonBytes)},
+		},
+	}, nil
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Local().Date()
+	by, bm, bd := b.Local().Date()
+	return ay == by && am == bm && ad == bd
+}
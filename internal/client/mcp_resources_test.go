@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/handlers"
+	"github.com/pankona/memoya/internal/models"
+)
+
+func newResourceTestBridge(t *testing.T, handler http.HandlerFunc) *MCPBridge {
+	t.Helper()
+
+	// Keep ensureAuth away from the real user config
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewMCPBridge(NewHTTPClient(server.URL))
+}
+
+func TestMCPBridge_ReadTodo(t *testing.T) {
+	bridge := newResourceTestBridge(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mcp/todo_get" {
+			http.NotFound(w, r)
+			return
+		}
+
+		var args handlers.TodoGetArgs
+		json.NewDecoder(r.Body).Decode(&args)
+
+		json.NewEncoder(w).Encode(handlers.TodoResult{
+			Success: true,
+			Todo:    &models.Todo{ID: args.ID, Title: "Write docs"},
+		})
+	})
+
+	uri := "memoya://todo/todo-123"
+	result, err := bridge.ReadTodo(context.Background(), nil, &mcp.ReadResourceParams{URI: uri})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result.Contents) != 1 {
+		t.Fatalf("Expected 1 content, got %d", len(result.Contents))
+	}
+
+	contents := result.Contents[0]
+	if contents.URI != uri {
+		t.Errorf("Expected URI %s, got %s", uri, contents.URI)
+	}
+	if contents.MIMEType != "application/json" {
+		t.Errorf("Expected application/json, got %s", contents.MIMEType)
+	}
+
+	var todo models.Todo
+	if err := json.Unmarshal([]byte(contents.Text), &todo); err != nil {
+		t.Fatalf("Failed to parse resource text: %v", err)
+	}
+	if todo.ID != "todo-123" {
+		t.Errorf("Expected todo ID todo-123, got %s", todo.ID)
+	}
+}
+
+func TestMCPBridge_ReadTag_EscapedTag(t *testing.T) {
+	var gotTags []string
+	bridge := newResourceTestBridge(t, func(w http.ResponseWriter, r *http.Request) {
+		var args handlers.SearchArgs
+		json.NewDecoder(r.Body).Decode(&args)
+		gotTags = args.Tags
+
+		json.NewEncoder(w).Encode(handlers.SearchResult{Success: true})
+	})
+
+	_, err := bridge.ReadTag(context.Background(), nil, &mcp.ReadResourceParams{URI: "memoya://tag/side%20project"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(gotTags) != 1 || gotTags[0] != "side project" {
+		t.Errorf("Expected tag filter [side project], got %v", gotTags)
+	}
+}
+
+func TestMCPBridge_ReadToday(t *testing.T) {
+	now := time.Now()
+	yesterday := now.Add(-48 * time.Hour)
+
+	bridge := newResourceTestBridge(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mcp/todo_list":
+			json.NewEncoder(w).Encode(handlers.TodoListResult{
+				Success: true,
+				Todos: []*models.Todo{
+					{ID: "t1", Status: models.StatusInProgress, Priority: models.PriorityNormal},
+					{ID: "t2", Status: models.StatusTodo, Priority: models.PriorityHigh},
+					{ID: "t3", Status: models.StatusTodo, Priority: models.PriorityNormal},
+					{ID: "t4", Status: models.StatusDone, ClosedAt: &now},
+					{ID: "t5", Status: models.StatusDone, ClosedAt: &yesterday},
+				},
+			})
+		case "/mcp/memo_list":
+			json.NewEncoder(w).Encode(handlers.MemoListResult{
+				Success: true,
+				Memos: []*models.Memo{
+					{ID: "m1", LastModified: now},
+					{ID: "m2", LastModified: yesterday},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	result, err := bridge.ReadToday(context.Background(), nil, &mcp.ReadResourceParams{URI: TodayResourceURI})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var overview TodayOverview
+	if err := json.Unmarshal([]byte(result.Contents[0].Text), &overview); err != nil {
+		t.Fatalf("Failed to parse resource text: %v", err)
+	}
+
+	if len(overview.InProgress) != 1 || overview.InProgress[0].ID != "t1" {
+		t.Errorf("Expected in_progress [t1], got %v", overview.InProgress)
+	}
+	if len(overview.HighTodo) != 1 || overview.HighTodo[0].ID != "t2" {
+		t.Errorf("Expected high_priority_todo [t2], got %v", overview.HighTodo)
+	}
+	if len(overview.DoneToday) != 1 || overview.DoneToday[0].ID != "t4" {
+		t.Errorf("Expected done_today [t4], got %v", overview.DoneToday)
+	}
+	if len(overview.MemosToday) != 1 || overview.MemosToday[0].ID != "m1" {
+		t.Errorf("Expected memos_today [m1], got %v", overview.MemosToday)
+	}
+}
+
+func TestMCPBridge_ResourceListMiddleware(t *testing.T) {
+	bridge := newResourceTestBridge(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/mcp/todo_list":
+			json.NewEncoder(w).Encode(handlers.TodoListResult{
+				Success: true,
+				Todos:   []*models.Todo{{ID: "t1", Title: "Todo 1"}},
+			})
+		case "/mcp/memo_list":
+			json.NewEncoder(w).Encode(handlers.MemoListResult{
+				Success: true,
+				Memos:   []*models.Memo{{ID: "m1", Title: "Memo 1"}},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	next := func(ctx context.Context, ss *mcp.ServerSession, method string, params mcp.Params) (mcp.Result, error) {
+		return &mcp.ListResourcesResult{
+			Resources: []*mcp.Resource{{URI: TodayResourceURI, Name: "today"}},
+		}, nil
+	}
+
+	handler := bridge.ResourceListMiddleware(next)
+	res, err := handler(context.Background(), nil, "resources/list", &mcp.ListResourcesParams{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	list := res.(*mcp.ListResourcesResult)
+	var uris []string
+	for _, r := range list.Resources {
+		uris = append(uris, r.URI)
+	}
+
+	expected := []string{TodayResourceURI, "memoya://todo/t1", "memoya://memo/m1"}
+	if strings.Join(uris, ",") != strings.Join(expected, ",") {
+		t.Errorf("Expected resources %v, got %v", expected, uris)
+	}
+}
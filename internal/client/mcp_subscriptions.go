@@ -0,0 +1,231 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+const (
+	methodInitialize           = "initialize"
+	methodSubscribe            = "resources/subscribe"
+	methodUnsubscribe          = "resources/unsubscribe"
+	notificationResourceUpdate = "notifications/resources/updated"
+)
+
+// Subscriptions keeps track of the resources/subscribe requests of the
+// sessions served over its transports, and sends them
+// notifications/resources/updated for the resources they subscribed to.
+//
+// go-sdk v0.1.0 defines these messages but does not route them to servers,
+// so the connections of Transport answer subscribe and unsubscribe requests
+// themselves and announce the capability in their initialize response.
+type Subscriptions struct {
+	mu    sync.Mutex
+	conns map[*subscriptionConn]struct{}
+}
+
+func NewSubscriptions() *Subscriptions {
+	return &Subscriptions{
+		conns: make(map[*subscriptionConn]struct{}),
+	}
+}
+
+// Transport wraps t so the sessions connected over it can subscribe to
+// resources
+func (s *Subscriptions) Transport(t mcp.Transport) mcp.Transport {
+	return &subscriptionTransport{Transport: t, subs: s}
+}
+
+// Subscribed returns the resource URIs any session subscribed to that match
+// the predicate
+func (s *Subscriptions) Subscribed(match func(uri string) bool) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]bool)
+	var uris []string
+	for conn := range s.conns {
+		for uri := range conn.uris {
+			if !seen[uri] && match(uri) {
+				seen[uri] = true
+				uris = append(uris, uri)
+			}
+		}
+	}
+	return uris
+}
+
+// ResourceUpdated notifies the sessions subscribed to uri that it changed.
+// Sessions that cannot be written to are dropped, and the others are still
+// notified.
+func (s *Subscriptions) ResourceUpdated(ctx context.Context, uri string) error {
+	params, err := json.Marshal(map[string]string{"uri": uri})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	var subscribed []*subscriptionConn
+	for conn := range s.conns {
+		if conn.uris[uri] {
+			subscribed = append(subscribed, conn)
+		}
+	}
+	s.mu.Unlock()
+
+	var errs []error
+	for _, conn := range subscribed {
+		notification := &mcp.JSONRPCRequest{Method: notificationResourceUpdate, Params: params}
+		if err := conn.Write(ctx, notification); err != nil {
+			errs = append(errs, fmt.Errorf("failed to notify update of %s: %w", uri, err))
+			s.mu.Lock()
+			delete(s.conns, conn)
+			s.mu.Unlock()
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type subscriptionTransport struct {
+	mcp.Transport
+	subs *Subscriptions
+}
+
+func (t *subscriptionTransport) Connect(ctx context.Context) (mcp.Connection, error) {
+	conn, err := t.Transport.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &subscriptionConn{Connection: conn, subs: t.subs, uris: make(map[string]bool)}
+	t.subs.mu.Lock()
+	t.subs.conns[c] = struct{}{}
+	t.subs.mu.Unlock()
+	return c, nil
+}
+
+// subscriptionConn answers the subscription requests read from the wrapped
+// connection. Writes are serialized, as notifications are written
+// concurrently with the session's own messages.
+type subscriptionConn struct {
+	mcp.Connection
+	subs *Subscriptions
+
+	writeMu sync.Mutex
+	initID  mcp.JSONRPCID // ID of the initialize request, until answered
+
+	// uris are guarded by subs.mu
+	uris map[string]bool
+}
+
+func (c *subscriptionConn) Read(ctx context.Context) (mcp.JSONRPCMessage, error) {
+	for {
+		msg, err := c.Connection.Read(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		req, ok := msg.(*mcp.JSONRPCRequest)
+		if !ok {
+			return msg, nil
+		}
+		switch req.Method {
+		case methodInitialize:
+			c.writeMu.Lock()
+			c.initID = req.ID
+			c.writeMu.Unlock()
+		case methodSubscribe, methodUnsubscribe:
+			if err := c.subscribe(ctx, req); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		return msg, nil
+	}
+}
+
+// subscribe applies a subscribe or unsubscribe request and answers it
+func (c *subscriptionConn) subscribe(ctx context.Context, req *mcp.JSONRPCRequest) error {
+	var params struct {
+		URI string `json:"uri"`
+	}
+	var rerr error
+	if err := json.Unmarshal(req.Params, &params); err != nil || !strings.HasPrefix(params.URI, "memoya://") {
+		rerr = mcp.ResourceNotFoundError(params.URI)
+	} else {
+		c.subs.mu.Lock()
+		if req.Method == methodSubscribe {
+			c.uris[params.URI] = true
+		} else {
+			delete(c.uris, params.URI)
+		}
+		c.subs.mu.Unlock()
+	}
+
+	if !req.ID.IsValid() {
+		// A notification expects no response
+		return nil
+	}
+	resp := &mcp.JSONRPCResponse{ID: req.ID, Error: rerr}
+	if rerr == nil {
+		resp.Result = json.RawMessage(`{}`)
+	}
+	return c.Write(ctx, resp)
+}
+
+func (c *subscriptionConn) Write(ctx context.Context, msg mcp.JSONRPCMessage) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if resp, ok := msg.(*mcp.JSONRPCResponse); ok && c.initID.IsValid() && resp.ID == c.initID {
+		c.initID = mcp.JSONRPCID{}
+		if result, err := withSubscribeCapability(resp.Result); err == nil {
+			resp.Result = result
+		}
+	}
+	return c.Connection.Write(ctx, msg)
+}
+
+func (c *subscriptionConn) Close() error {
+	c.subs.mu.Lock()
+	delete(c.subs.conns, c)
+	c.subs.mu.Unlock()
+	return c.Connection.Close()
+}
+
+// withSubscribeCapability sets capabilities.resources.subscribe in an
+// initialize result
+func withSubscribeCapability(result json.RawMessage) (json.RawMessage, error) {
+	var init map[string]json.RawMessage
+	if err := json.Unmarshal(result, &init); err != nil {
+		return nil, err
+	}
+	capabilities := make(map[string]json.RawMessage)
+	if raw, ok := init["capabilities"]; ok {
+		if err := json.Unmarshal(raw, &capabilities); err != nil {
+			return nil, err
+		}
+	}
+	resources := make(map[string]any)
+	if raw, ok := capabilities["resources"]; ok {
+		if err := json.Unmarshal(raw, &resources); err != nil {
+			return nil, err
+		}
+	}
+	resources["subscribe"] = true
+
+	var err error
+	if capabilities["resources"], err = json.Marshal(resources); err != nil {
+		return nil, err
+	}
+	if init["capabilities"], err = json.Marshal(capabilities); err != nil {
+		return nil, err
+	}
+	return json.Marshal(init)
+}
@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/models"
+)
+
+// subscribingTransport is a client transport sending resources/subscribe or
+// resources/unsubscribe in place of pings, since go-sdk v0.1.0 clients cannot
+// subscribe. It records the initialize result and the resource updates.
+type subscribingTransport struct {
+	mcp.Transport
+	method  string // method sent instead of the next ping
+	uri     string
+	init    chan json.RawMessage
+	updates chan string
+}
+
+func (t *subscribingTransport) Connect(ctx context.Context) (mcp.Connection, error) {
+	conn, err := t.Transport.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &subscribingConn{Connection: conn, t: t}, nil
+}
+
+type subscribingConn struct {
+	mcp.Connection
+	t           *subscribingTransport
+	initialized bool
+}
+
+func (c *subscribingConn) Write(ctx context.Context, msg mcp.JSONRPCMessage) error {
+	if req, ok := msg.(*mcp.JSONRPCRequest); ok && req.Method == "ping" {
+		params, _ := json.Marshal(map[string]string{"uri": c.t.uri})
+		msg = &mcp.JSONRPCRequest{ID: req.ID, Method: c.t.method, Params: params}
+	}
+	return c.Connection.Write(ctx, msg)
+}
+
+func (c *subscribingConn) Read(ctx context.Context) (mcp.JSONRPCMessage, error) {
+	for {
+		msg, err := c.Connection.Read(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch msg := msg.(type) {
+		case *mcp.JSONRPCResponse:
+			// The first response answers initialize
+			if !c.initialized {
+				c.initialized = true
+				c.t.init <- msg.Result
+			}
+		case *mcp.JSONRPCRequest:
+			if msg.Method == notificationResourceUpdate {
+				var params struct {
+					URI string `json:"uri"`
+				}
+				json.Unmarshal(msg.Params, &params)
+				c.t.updates <- params.URI
+				continue
+			}
+		}
+		return msg, nil
+	}
+}
+
+func TestSubscriptions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	subs := NewSubscriptions()
+	server := mcp.NewServer("memoya", "test", nil)
+	today := &mcp.ServerResource{
+		Resource: &mcp.Resource{URI: TodayResourceURI, Name: "today"},
+		Handler: func(context.Context, *mcp.ServerSession, *mcp.ReadResourceParams) (*mcp.ReadResourceResult, error) {
+			return &mcp.ReadResourceResult{}, nil
+		},
+	}
+	server.AddResources(today)
+	if _, err := server.Connect(ctx, subs.Transport(serverTransport)); err != nil {
+		t.Fatalf("Failed to connect server: %v", err)
+	}
+
+	client := &subscribingTransport{
+		Transport: clientTransport,
+		method:    methodSubscribe,
+		uri:       TodoResourcePrefix + "t1",
+		init:      make(chan json.RawMessage, 1),
+		updates:   make(chan string, 10),
+	}
+	session, err := mcp.NewClient("test", "test", nil).Connect(ctx, client)
+	if err != nil {
+		t.Fatalf("Failed to connect client: %v", err)
+	}
+	defer session.Close()
+
+	var init struct {
+		Capabilities struct {
+			Resources struct {
+				Subscribe bool `json:"subscribe"`
+			} `json:"resources"`
+		} `json:"capabilities"`
+	}
+	json.Unmarshal(<-client.init, &init)
+	if !init.Capabilities.Resources.Subscribe {
+		t.Error("Expected the subscribe capability to be announced")
+	}
+
+	if err := session.Ping(ctx, nil); err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+
+	notify := NotifyChanges(server, subs, today)
+	notify([]*models.Change{
+		{Kind: models.ChangeKindMemo, ID: "m1", Op: models.ChangeOpUpdated},
+		{Kind: models.ChangeKindTodo, ID: "t1", Op: models.ChangeOpUpdated},
+	})
+	select {
+	case uri := <-client.updates:
+		if uri != TodoResourcePrefix+"t1" {
+			t.Errorf("Expected an update of the subscribed todo, got %s", uri)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the update")
+	}
+
+	// Nothing is sent once unsubscribed
+	client.method = methodUnsubscribe
+	if err := session.Ping(ctx, nil); err != nil {
+		t.Fatalf("Failed to unsubscribe: %v", err)
+	}
+	notify(nil)
+	if uris := subs.Subscribed(func(string) bool { return true }); len(uris) != 0 {
+		t.Errorf("Expected no subscriptions, got %v", uris)
+	}
+	select {
+	case uri := <-client.updates:
+		t.Errorf("Expected no update after unsubscribing, got %s", uri)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Only memoya resources can be subscribed to
+	client.method, client.uri = methodSubscribe, "file:///etc/passwd"
+	if err := session.Ping(ctx, nil); err == nil {
+		t.Error("Expected an error subscribing to another resource")
+	}
+}
+
+// writeConn records the messages written to it, or fails to write them
+type writeConn struct {
+	mcp.Connection
+	err     error
+	written []mcp.JSONRPCMessage
+}
+
+func (c *writeConn) Write(ctx context.Context, msg mcp.JSONRPCMessage) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.written = append(c.written, msg)
+	return nil
+}
+
+func TestSubscriptions_ResourceUpdatedFailure(t *testing.T) {
+	subs := NewSubscriptions()
+	uri := TodoResourcePrefix + "t1"
+	closed := &writeConn{err: errors.New("session closed")}
+	open := &writeConn{}
+	for _, conn := range []*writeConn{closed, open} {
+		c := &subscriptionConn{Connection: conn, subs: subs, uris: map[string]bool{uri: true}}
+		subs.conns[c] = struct{}{}
+	}
+
+	// The session that cannot be written to does not keep the others from
+	// being notified, and is dropped
+	if err := subs.ResourceUpdated(context.Background(), uri); err == nil || !strings.Contains(err.Error(), "session closed") {
+		t.Errorf("Expected the failed write to be reported, got %v", err)
+	}
+	if len(open.written) != 1 {
+		t.Errorf("Expected the other session to be notified, got %v", open.written)
+	}
+	if len(subs.conns) != 1 {
+		t.Errorf("Expected the failed session to be dropped, got %d sessions", len(subs.conns))
+	}
+
+	if err := subs.ResourceUpdated(context.Background(), uri); err != nil {
+		t.Errorf("Expected no error once dropped, got %v", err)
+	}
+	if len(open.written) != 2 {
+		t.Errorf("Expected the other session to be notified again, got %v", open.written)
+	}
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// jwtSigningKeysSecret is the Secret Manager secret holding the JWT signing keys
+const jwtSigningKeysSecret = "jwt-signing-keys"
+
+// ErrNoSigningKeys is returned when no JWT signing keys are configured
+var ErrNoSigningKeys = errors.New("no JWT signing keys configured: set JWT_SIGNING_KEYS_FILE or create the jwt-signing-keys secret")
+
+// IsProduction reports whether the server runs in production: MEMOYA_ENV is
+// "production", or it is unset and the server runs on Cloud Run
+func IsProduction() bool {
+	if env := os.Getenv("MEMOYA_ENV"); env != "" {
+		return env == "production"
+	}
+	return os.Getenv("K_SERVICE") != ""
+}
+
+// GetJWTSigningKeys retrieves the PEM encoded JWT signing keys from the file
+// or directory of .pem files named by JWT_SIGNING_KEYS_FILE, or from Secret
+// Manager
+func GetJWTSigningKeys(ctx context.Context, projectID string) ([]byte, error) {
+	if path := os.Getenv("JWT_SIGNING_KEYS_FILE"); path != "" {
+		return readKeyFiles(path)
+	}
+
+	if projectID == "" {
+		return nil, ErrNoSigningKeys
+	}
+
+	sm, err := NewSecretManager(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("JWT signing keys unavailable: %w", err)
+	}
+	defer sm.Close()
+
+	data, err := sm.GetSecret(ctx, jwtSigningKeysSecret)
+	if status.Code(err) == codes.NotFound {
+		return nil, ErrNoSigningKeys
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []byte(data), nil
+}
+
+// readKeyFiles reads path, or every .pem file in it if it is a directory
+func readKeyFiles(path string) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read JWT signing keys: %w", err)
+	}
+	if !info.IsDir() {
+		return os.ReadFile(path)
+	}
+
+	files, err := filepath.Glob(filepath.Join(path, "*.pem"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list JWT signing keys: %w", err)
+	}
+	sort.Strings(files)
+
+	var data []byte
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JWT signing key: %w", err)
+		}
+		data = append(data, content...)
+		data = append(data, '\n')
+	}
+	return data, nil
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// LimitsConfig configures the rate limits and quotas of memoya-server. A
+// zero limit is disabled.
+type LimitsConfig struct {
+	RatePerMinute     int // Requests per minute per user
+	RateBurst         int // Requests a user may make at once
+	AuthRatePerMinute int // Requests per minute per IP address to the unauthenticated auth endpoints
+	AuthRateBurst     int // Auth requests an IP address may make at once
+	Quota             QuotaConfig
+}
+
+// QuotaConfig configures how much each user may store. A zero limit is
+// disabled.
+type QuotaConfig struct {
+	MaxTodos            int // Todos per user
+	MaxMemos            int // Memos per user
+	MaxDescriptionBytes int // Size of a todo or memo description
+	MaxTagsPerItem      int // Tags of a todo or memo
+}
+
+// DefaultLimits returns the limits used unless configured otherwise
+func DefaultLimits() LimitsConfig {
+	return LimitsConfig{
+		RatePerMinute:     120,
+		RateBurst:         60,
+		AuthRatePerMinute: 30,
+		AuthRateBurst:     20,
+		Quota: QuotaConfig{
+			MaxTodos:            10000,
+			MaxMemos:            10000,
+			MaxDescriptionBytes: 64 * 1024,
+			MaxTagsPerItem:      20,
+		},
+	}
+}
+
+// GetLimits reads the limits from RATE_LIMIT_PER_MINUTE, RATE_LIMIT_BURST,
+// AUTH_RATE_LIMIT_PER_MINUTE, AUTH_RATE_LIMIT_BURST, QUOTA_MAX_TODOS,
+// QUOTA_MAX_MEMOS, QUOTA_MAX_DESCRIPTION_BYTES and QUOTA_MAX_TAGS. Unset
+// variables keep the default; 0 disables the limit.
+func GetLimits() (LimitsConfig, error) {
+	limits := DefaultLimits()
+	settings := []struct {
+		env   string
+		value *int
+	}{
+		{"RATE_LIMIT_PER_MINUTE", &limits.RatePerMinute},
+		{"RATE_LIMIT_BURST", &limits.RateBurst},
+		{"AUTH_RATE_LIMIT_PER_MINUTE", &limits.AuthRatePerMinute},
+		{"AUTH_RATE_LIMIT_BURST", &limits.AuthRateBurst},
+		{"QUOTA_MAX_TODOS", &limits.Quota.MaxTodos},
+		{"QUOTA_MAX_MEMOS", &limits.Quota.MaxMemos},
+		{"QUOTA_MAX_DESCRIPTION_BYTES", &limits.Quota.MaxDescriptionBytes},
+		{"QUOTA_MAX_TAGS", &limits.Quota.MaxTagsPerItem},
+	}
+
+	for _, setting := range settings {
+		value := os.Getenv(setting.env)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return LimitsConfig{}, fmt.Errorf("%s must be a non-negative integer, got %q", setting.env, value)
+		}
+		*setting.value = n
+	}
+
+	return limits, nil
+}
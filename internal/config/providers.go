@@ -0,0 +1,189 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Identity provider types
+const (
+	ProviderTypeGoogle = "google"
+	ProviderTypeGitHub = "github"
+	ProviderTypeOIDC   = "oidc"
+)
+
+// providerName matches the names identity providers are configured by
+var providerName = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// IdentityProviderConfig configures an identity provider users sign in with
+type IdentityProviderConfig struct {
+	Name         string // Name users pick the provider by
+	Type         string // ProviderTypeGoogle, ProviderTypeGitHub or ProviderTypeOIDC
+	ClientID     string
+	ClientSecret string
+	Issuer       string // Issuer URL of OIDC providers
+}
+
+// FakeIdPProviderName is the identity provider name of the fake identity
+// provider
+const FakeIdPProviderName = "fake"
+
+// fakeIdPClientID is the client ID memoya uses at the fake identity provider
+const fakeIdPClientID = "memoya-dev"
+
+// FakeIdPConfig configures the built-in fake identity provider for local
+// development
+type FakeIdPConfig struct {
+	Addr        string   // Address the fake identity provider listens on
+	Identities  []string // Test identities users can sign in as
+	AutoApprove string   // Identity every device code is approved for, if any
+}
+
+// Issuer returns the URL memoya reaches the fake identity provider at
+func (c *FakeIdPConfig) Issuer() string {
+	host, port, err := net.SplitHostPort(c.Addr)
+	if err != nil {
+		return "http://" + c.Addr
+	}
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "localhost"
+	}
+	return "http://" + net.JoinHostPort(host, port)
+}
+
+// GetFakeIdP reads the fake identity provider settings: FAKE_IDP_ADDR
+// enables it, FAKE_IDP_IDENTITIES lists the test identities (default
+// "dev-user") and FAKE_IDP_AUTO_APPROVE names the identity every code is
+// approved for ("true" for the first one). It returns nil when the fake
+// identity provider is disabled, and an error in production.
+func GetFakeIdP() (*FakeIdPConfig, error) {
+	addr := os.Getenv("FAKE_IDP_ADDR")
+	if addr == "" {
+		return nil, nil
+	}
+	if IsProduction() {
+		return nil, fmt.Errorf("the fake identity provider cannot be used in production")
+	}
+
+	cfg := &FakeIdPConfig{Addr: addr}
+	for _, identity := range strings.Split(os.Getenv("FAKE_IDP_IDENTITIES"), ",") {
+		if identity = strings.TrimSpace(identity); identity != "" {
+			cfg.Identities = append(cfg.Identities, identity)
+		}
+	}
+	if len(cfg.Identities) == 0 {
+		cfg.Identities = []string{"dev-user"}
+	}
+
+	switch autoApprove := strings.TrimSpace(os.Getenv("FAKE_IDP_AUTO_APPROVE")); autoApprove {
+	case "", "false":
+	case "true":
+		cfg.AutoApprove = cfg.Identities[0]
+	default:
+		for _, identity := range cfg.Identities {
+			if identity == autoApprove {
+				cfg.AutoApprove = autoApprove
+			}
+		}
+		if cfg.AutoApprove == "" {
+			return nil, fmt.Errorf("FAKE_IDP_AUTO_APPROVE names %q, which is not in FAKE_IDP_IDENTITIES", autoApprove)
+		}
+	}
+
+	return cfg, nil
+}
+
+// GetIdentityProviders reads the identity providers named by the
+// comma-separated IDENTITY_PROVIDERS (default "google", or "fake" when the
+// fake identity provider is enabled); the first one is the default.
+// "google" uses GetOAuthCredentials, "github" GITHUB_CLIENT_ID and
+// GITHUB_CLIENT_SECRET, "fake" GetFakeIdP, and any other name is an OIDC
+// provider configured by OIDC_<NAME>_ISSUER, OIDC_<NAME>_CLIENT_ID and
+// OIDC_<NAME>_CLIENT_SECRET.
+func GetIdentityProviders(ctx context.Context, projectID string) ([]IdentityProviderConfig, error) {
+	names := os.Getenv("IDENTITY_PROVIDERS")
+	if names == "" {
+		names = ProviderTypeGoogle
+		if os.Getenv("FAKE_IDP_ADDR") != "" {
+			names = FakeIdPProviderName
+		}
+	}
+
+	var providers []IdentityProviderConfig
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(names, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if !providerName.MatchString(name) {
+			return nil, fmt.Errorf("invalid identity provider name %q", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("identity provider %q is listed twice", name)
+		}
+		seen[name] = true
+
+		switch name {
+		case ProviderTypeGoogle:
+			credentials, err := GetOAuthCredentials(ctx, projectID)
+			if err != nil {
+				return nil, err
+			}
+			providers = append(providers, IdentityProviderConfig{
+				Name:         name,
+				Type:         ProviderTypeGoogle,
+				ClientID:     credentials.ClientID,
+				ClientSecret: credentials.ClientSecret,
+			})
+		case ProviderTypeGitHub:
+			clientID := os.Getenv("GITHUB_CLIENT_ID")
+			if clientID == "" {
+				return nil, fmt.Errorf("GitHub sign-in requires GITHUB_CLIENT_ID")
+			}
+			providers = append(providers, IdentityProviderConfig{
+				Name:         name,
+				Type:         ProviderTypeGitHub,
+				ClientID:     clientID,
+				ClientSecret: os.Getenv("GITHUB_CLIENT_SECRET"), // Optional for the device flow
+			})
+		case FakeIdPProviderName:
+			fake, err := GetFakeIdP()
+			if err != nil {
+				return nil, err
+			}
+			if fake == nil {
+				return nil, fmt.Errorf("the fake identity provider requires FAKE_IDP_ADDR")
+			}
+			providers = append(providers, IdentityProviderConfig{
+				Name:     name,
+				Type:     ProviderTypeOIDC,
+				ClientID: fakeIdPClientID,
+				Issuer:   fake.Issuer(),
+			})
+		default:
+			prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+			issuer := os.Getenv(prefix + "ISSUER")
+			clientID := os.Getenv(prefix + "CLIENT_ID")
+			if issuer == "" || clientID == "" {
+				return nil, fmt.Errorf("OIDC provider %q requires %sISSUER and %sCLIENT_ID", name, prefix, prefix)
+			}
+			providers = append(providers, IdentityProviderConfig{
+				Name:         name,
+				Type:         ProviderTypeOIDC,
+				ClientID:     clientID,
+				ClientSecret: os.Getenv(prefix + "CLIENT_SECRET"),
+				Issuer:       issuer,
+			})
+		}
+	}
+
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("no identity providers configured: IDENTITY_PROVIDERS is empty")
+	}
+	return providers, nil
+}
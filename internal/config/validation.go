@@ -0,0 +1,21 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// GetLowercaseTags reports whether TAGS_LOWERCASE asks for tags to be stored
+// in lower case. Unset means false.
+func GetLowercaseTags() (bool, error) {
+	value := os.Getenv("TAGS_LOWERCASE")
+	if value == "" {
+		return false, nil
+	}
+	lowercase, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("TAGS_LOWERCASE must be true or false, got %q", value)
+	}
+	return lowercase, nil
+}
@@ -0,0 +1,306 @@
+// Package fakeidp is an OpenID provider for local development and tests. It
+// serves discovery, the device authorization grant (RFC 8628) and userinfo
+// for a fixed set of test identities, so sign-in works without Google or
+// network access. It performs no authentication and must not be exposed.
+package fakeidp
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultExpiresIn = 10 * time.Minute
+	defaultInterval  = 5
+)
+
+// Options configure a fake identity provider
+type Options struct {
+	Identities  []string      // Subjects of the test identities users can sign in as
+	AutoApprove string        // Identity every device code is approved for; empty to approve on the verification page
+	ExpiresIn   time.Duration // Lifetime of device codes (default 10 minutes)
+	Interval    int           // Seconds clients wait between polls (default 5)
+}
+
+// grant is a device authorization in progress
+type grant struct {
+	userCode  string
+	subject   string // Set once approved
+	denied    bool
+	expiresAt time.Time
+}
+
+// Server is a fake identity provider. It is an http.Handler; the issuer is
+// the URL it is reached at.
+type Server struct {
+	opts Options
+	mux  *http.ServeMux
+	now  func() time.Time
+
+	mu     sync.Mutex
+	grants map[string]*grant // By device code
+	tokens map[string]string // Access token to subject
+}
+
+// New creates a fake identity provider
+func New(opts Options) *Server {
+	if opts.ExpiresIn <= 0 {
+		opts.ExpiresIn = defaultExpiresIn
+	}
+	if opts.Interval <= 0 {
+		opts.Interval = defaultInterval
+	}
+
+	s := &Server{
+		opts:   opts,
+		mux:    http.NewServeMux(),
+		now:    time.Now,
+		grants: make(map[string]*grant),
+		tokens: make(map[string]string),
+	}
+	s.mux.HandleFunc("GET /.well-known/openid-configuration", s.discovery)
+	s.mux.HandleFunc("POST /device_authorization", s.deviceAuthorization)
+	s.mux.HandleFunc("POST /token", s.token)
+	s.mux.HandleFunc("GET /userinfo", s.userinfo)
+	s.mux.HandleFunc("GET /device", s.verificationPage)
+	s.mux.HandleFunc("POST /device", s.verify)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// Approve approves the device code with userCode for the test identity
+// subject, as a user would on the verification page
+func (s *Server) Approve(userCode, subject string) error {
+	if !s.isIdentity(subject) {
+		return fmt.Errorf("unknown identity %q", subject)
+	}
+	return s.decide(userCode, func(g *grant) { g.subject = subject })
+}
+
+// Deny declines the device code with userCode
+func (s *Server) Deny(userCode string) error {
+	return s.decide(userCode, func(g *grant) { g.denied = true })
+}
+
+func (s *Server) decide(userCode string, decide func(*grant)) error {
+	userCode = strings.ToUpper(strings.TrimSpace(userCode))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, g := range s.grants {
+		if g.userCode == userCode && s.now().Before(g.expiresAt) {
+			if g.subject != "" || g.denied {
+				return fmt.Errorf("code %s was already used", userCode)
+			}
+			decide(g)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown or expired code %s", userCode)
+}
+
+func (s *Server) isIdentity(subject string) bool {
+	for _, identity := range s.opts.Identities {
+		if identity == subject {
+			return true
+		}
+	}
+	return false
+}
+
+// issuer returns the URL the request reached the server at
+func issuer(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
+
+func (s *Server) discovery(w http.ResponseWriter, r *http.Request) {
+	base := issuer(r)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                        base,
+		"device_authorization_endpoint": base + "/device_authorization",
+		"token_endpoint":                base + "/token",
+		"userinfo_endpoint":             base + "/userinfo",
+		"grant_types_supported":         []string{"urn:ietf:params:oauth:grant-type:device_code"},
+		"subject_types_supported":       []string{"public"},
+	})
+}
+
+func (s *Server) deviceAuthorization(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("client_id") == "" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	g := &grant{
+		userCode:  userCode(),
+		expiresAt: s.now().Add(s.opts.ExpiresIn),
+	}
+	if s.opts.AutoApprove != "" {
+		g.subject = s.opts.AutoApprove
+	}
+	deviceCode := randomString(32)
+
+	s.mu.Lock()
+	s.grants[deviceCode] = g
+	s.mu.Unlock()
+
+	verificationURI := issuer(r) + "/device"
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"device_code":               deviceCode,
+		"user_code":                 g.userCode,
+		"verification_uri":          verificationURI,
+		"verification_uri_complete": verificationURI + "?user_code=" + g.userCode,
+		"expires_in":                int(s.opts.ExpiresIn.Seconds()),
+		"interval":                  s.opts.Interval,
+	})
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("grant_type") != "urn:ietf:params:oauth:grant-type:device_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deviceCode := r.FormValue("device_code")
+	g, ok := s.grants[deviceCode]
+	switch {
+	case !ok:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+	case !s.now().Before(g.expiresAt):
+		delete(s.grants, deviceCode)
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "expired_token"})
+	case g.denied:
+		delete(s.grants, deviceCode)
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "access_denied"})
+	case g.subject == "":
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "authorization_pending"})
+	default:
+		// Device codes are exchanged once
+		delete(s.grants, deviceCode)
+		accessToken := randomString(32)
+		s.tokens[accessToken] = g.subject
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"access_token": accessToken,
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	}
+}
+
+func (s *Server) userinfo(w http.ResponseWriter, r *http.Request) {
+	accessToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	s.mu.Lock()
+	subject, ok := s.tokens[accessToken]
+	s.mu.Unlock()
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_token"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{
+		"sub":  subject,
+		"name": subject,
+	})
+}
+
+var pageTemplate = template.Must(template.New("device").Parse(`<!DOCTYPE html>
+<html>
+<head><title>memoya fake identity provider</title></head>
+<body>
+<h1>memoya fake identity provider</h1>
+<p>For local development only. Approving a code signs the device in as the selected test identity.</p>
+{{if .Message}}<p><strong>{{.Message}}</strong></p>{{end}}
+<form method="post" action="/device">
+<p><label>Code <input name="user_code" value="{{.UserCode}}" autofocus></label></p>
+<p><label>Identity <select name="subject">{{range .Identities}}<option>{{.}}</option>{{end}}</select></label></p>
+<p><button name="action" value="approve">Approve</button> <button name="action" value="deny">Deny</button></p>
+</form>
+</body>
+</html>
+`))
+
+type pageData struct {
+	UserCode   string
+	Identities []string
+	Message    string
+}
+
+func (s *Server) verificationPage(w http.ResponseWriter, r *http.Request) {
+	s.renderPage(w, http.StatusOK, r.URL.Query().Get("user_code"), "")
+}
+
+func (s *Server) verify(w http.ResponseWriter, r *http.Request) {
+	userCode := r.FormValue("user_code")
+
+	var err error
+	message := "Denied. You can close this window."
+	if r.FormValue("action") == "deny" {
+		err = s.Deny(userCode)
+	} else {
+		subject := r.FormValue("subject")
+		err = s.Approve(userCode, subject)
+		message = fmt.Sprintf("Signed in as %s. You can close this window.", subject)
+	}
+	if err != nil {
+		s.renderPage(w, http.StatusBadRequest, userCode, err.Error())
+		return
+	}
+	s.renderPage(w, http.StatusOK, "", message)
+}
+
+func (s *Server) renderPage(w http.ResponseWriter, status int, userCode, message string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	pageTemplate.Execute(w, pageData{UserCode: userCode, Identities: s.opts.Identities, Message: message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// userCodeAlphabet avoids vowels and easily confused letters (RFC 8628
+// section 6.1)
+const userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+
+// userCode returns a code like "WDJB-MJHT"
+func userCode() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	code := make([]byte, 0, 9)
+	for i, c := range b {
+		if i == 4 {
+			code = append(code, '-')
+		}
+		code = append(code, userCodeAlphabet[int(c)%len(userCodeAlphabet)])
+	}
+	return string(code)
+}
+
+func randomString(n int) string {
+	b := make([]byte, n)
+	rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
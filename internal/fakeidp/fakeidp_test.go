@@ -0,0 +1,74 @@
+package fakeidp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func post(t *testing.T, s *Server, path string, form url.Values) map[string]interface{} {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+
+	var body map[string]interface{}
+	json.Unmarshal(rec.Body.Bytes(), &body)
+	return body
+}
+
+func TestServer_DeviceGrant(t *testing.T) {
+	s := New(Options{Identities: []string{"alice"}})
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	start := func() (string, string) {
+		body := post(t, s, "/device_authorization", url.Values{"client_id": {"memoya-dev"}})
+		return body["device_code"].(string), body["user_code"].(string)
+	}
+	poll := func(deviceCode string) string {
+		body := post(t, s, "/token", url.Values{
+			"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+			"device_code": {deviceCode},
+		})
+		if errCode, ok := body["error"].(string); ok {
+			return errCode
+		}
+		return "ok"
+	}
+
+	deviceCode, userCode := start()
+	if got := poll(deviceCode); got != "authorization_pending" {
+		t.Errorf("Expected authorization_pending, got %s", got)
+	}
+	if err := s.Approve(userCode, "mallory"); err == nil {
+		t.Error("Expected approving an unknown identity to fail")
+	}
+	if err := s.Approve(strings.ToLower(userCode), "alice"); err != nil {
+		t.Fatalf("Approve failed: %v", err)
+	}
+	if got := poll(deviceCode); got != "ok" {
+		t.Errorf("Expected a token, got %s", got)
+	}
+	if got := poll(deviceCode); got != "invalid_grant" {
+		t.Errorf("Expected the device code to be single-use, got %s", got)
+	}
+
+	deviceCode, userCode = start()
+	s.Deny(userCode)
+	if got := poll(deviceCode); got != "access_denied" {
+		t.Errorf("Expected access_denied, got %s", got)
+	}
+
+	deviceCode, _ = start()
+	now = now.Add(defaultExpiresIn)
+	if got := poll(deviceCode); got != "expired_token" {
+		t.Errorf("Expected expired_token, got %s", got)
+	}
+}
@@ -13,12 +13,93 @@ import (
 	"net/url"
 	"strings"
 	"time"
+
+	"github.com/oapi-codegen/runtime"
 )
 
 const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AuditAction.
+const (
+	AccountDelete AuditAction = "account.delete"
+	AuthSignIn    AuditAction = "auth.sign_in"
+	AuthSignOut   AuditAction = "auth.sign_out"
+	AuthUnlink    AuditAction = "auth.unlink"
+	MemoCreate    AuditAction = "memo.create"
+	MemoDelete    AuditAction = "memo.delete"
+	MemoUpdate    AuditAction = "memo.update"
+	TodoCreate    AuditAction = "todo.create"
+	TodoDelete    AuditAction = "todo.delete"
+	TodoUpdate    AuditAction = "todo.update"
+	TokenCreate   AuditAction = "token.create"
+	TokenRevoke   AuditAction = "token.revoke"
+)
+
+// Defines values for AuditEventTargetType.
+const (
+	AuditEventTargetTypeIdentity AuditEventTargetType = "identity"
+	AuditEventTargetTypeMemo     AuditEventTargetType = "memo"
+	AuditEventTargetTypeSession  AuditEventTargetType = "session"
+	AuditEventTargetTypeTodo     AuditEventTargetType = "todo"
+	AuditEventTargetTypeToken    AuditEventTargetType = "token"
+	AuditEventTargetTypeUser     AuditEventTargetType = "user"
+)
+
+// Defines values for BatchOperationKind.
+const (
+	BatchOperationKindMemo BatchOperationKind = "memo"
+	BatchOperationKindTodo BatchOperationKind = "todo"
+)
+
+// Defines values for BatchOperationOp.
+const (
+	Create BatchOperationOp = "create"
+	Delete BatchOperationOp = "delete"
+	Update BatchOperationOp = "update"
+)
+
+// Defines values for BatchOperationPriority.
+const (
+	BatchOperationPriorityHigh   BatchOperationPriority = "high"
+	BatchOperationPriorityNormal BatchOperationPriority = "normal"
+)
+
+// Defines values for BatchOperationStatus.
+const (
+	BatchOperationStatusBacklog    BatchOperationStatus = "backlog"
+	BatchOperationStatusDone       BatchOperationStatus = "done"
+	BatchOperationStatusInProgress BatchOperationStatus = "in_progress"
+	BatchOperationStatusTodo       BatchOperationStatus = "todo"
+)
+
+// Defines values for BatchOperationResultStatus.
+const (
+	Applied BatchOperationResultStatus = "applied"
+	Failed  BatchOperationResultStatus = "failed"
+	Skipped BatchOperationResultStatus = "skipped"
+)
+
+// Defines values for ChangeKind.
+const (
+	ChangeKindMemo ChangeKind = "memo"
+	ChangeKindTodo ChangeKind = "todo"
+)
+
+// Defines values for ChangeOp.
+const (
+	Created ChangeOp = "created"
+	Deleted ChangeOp = "deleted"
+	Updated ChangeOp = "updated"
+)
+
+// Defines values for CompleteRequestRefType.
+const (
+	Refprompt   CompleteRequestRefType = "ref/prompt"
+	Refresource CompleteRequestRefType = "ref/resource"
+)
+
 // Defines values for DeviceAuthPollResponseDataStatus.
 const (
 	Completed DeviceAuthPollResponseDataStatus = "completed"
@@ -27,6 +108,41 @@ const (
 	Pending   DeviceAuthPollResponseDataStatus = "pending"
 )
 
+// Defines values for JWKAlg.
+const (
+	EdDSA JWKAlg = "EdDSA"
+	RS256 JWKAlg = "RS256"
+)
+
+// Defines values for JWKKty.
+const (
+	OKP JWKKty = "OKP"
+	RSA JWKKty = "RSA"
+)
+
+// Defines values for PromptGetRequestName.
+const (
+	BrainstormFromMemos PromptGetRequestName = "brainstorm_from_memos"
+	PlanMyDay           PromptGetRequestName = "plan_my_day"
+	TriageBacklog       PromptGetRequestName = "triage_backlog"
+	WeeklyReview        PromptGetRequestName = "weekly_review"
+)
+
+// Defines values for PromptMessageRole.
+const (
+	PromptMessageRoleAssistant PromptMessageRole = "assistant"
+	PromptMessageRoleUser      PromptMessageRole = "user"
+)
+
+// Defines values for Scope.
+const (
+	Admin      Scope = "admin"
+	MemosRead  Scope = "memos:read"
+	MemosWrite Scope = "memos:write"
+	TodosRead  Scope = "todos:read"
+	TodosWrite Scope = "todos:write"
+)
+
 // Defines values for SearchRequestType.
 const (
 	SearchRequestTypeAll  SearchRequestType = "all"
@@ -76,6 +192,20 @@ const (
 	TodoListRequestStatusTodo       TodoListRequestStatus = "todo"
 )
 
+// Defines values for TodoPatchPriority.
+const (
+	TodoPatchPriorityHigh   TodoPatchPriority = "high"
+	TodoPatchPriorityNormal TodoPatchPriority = "normal"
+)
+
+// Defines values for TodoPatchStatus.
+const (
+	TodoPatchStatusBacklog    TodoPatchStatus = "backlog"
+	TodoPatchStatusDone       TodoPatchStatus = "done"
+	TodoPatchStatusInProgress TodoPatchStatus = "in_progress"
+	TodoPatchStatusTodo       TodoPatchStatus = "todo"
+)
+
 // Defines values for TodoUpdateRequestPriority.
 const (
 	TodoUpdateRequestPriorityHigh   TodoUpdateRequestPriority = "high"
@@ -90,6 +220,47 @@ const (
 	TodoUpdateRequestStatusTodo       TodoUpdateRequestStatus = "todo"
 )
 
+// Defines values for ListActivityV2ParamsTargetType.
+const (
+	ListActivityV2ParamsTargetTypeIdentity ListActivityV2ParamsTargetType = "identity"
+	ListActivityV2ParamsTargetTypeMemo     ListActivityV2ParamsTargetType = "memo"
+	ListActivityV2ParamsTargetTypeSession  ListActivityV2ParamsTargetType = "session"
+	ListActivityV2ParamsTargetTypeTodo     ListActivityV2ParamsTargetType = "todo"
+	ListActivityV2ParamsTargetTypeToken    ListActivityV2ParamsTargetType = "token"
+	ListActivityV2ParamsTargetTypeUser     ListActivityV2ParamsTargetType = "user"
+)
+
+// Defines values for SearchV2ParamsType.
+const (
+	SearchV2ParamsTypeAll  SearchV2ParamsType = "all"
+	SearchV2ParamsTypeMemo SearchV2ParamsType = "memo"
+	SearchV2ParamsTypeTodo SearchV2ParamsType = "todo"
+)
+
+// Defines values for ListTodosV2ParamsStatus.
+const (
+	ListTodosV2ParamsStatusBacklog    ListTodosV2ParamsStatus = "backlog"
+	ListTodosV2ParamsStatusDone       ListTodosV2ParamsStatus = "done"
+	ListTodosV2ParamsStatusInProgress ListTodosV2ParamsStatus = "in_progress"
+	ListTodosV2ParamsStatusTodo       ListTodosV2ParamsStatus = "todo"
+)
+
+// Defines values for ListTodosV2ParamsPriority.
+const (
+	High   ListTodosV2ParamsPriority = "high"
+	Normal ListTodosV2ParamsPriority = "normal"
+)
+
+// AccessToken defines model for AccessToken.
+type AccessToken struct {
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	Id         *string    `json:"id,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Name       *string    `json:"name,omitempty"`
+	Scopes     *[]Scope   `json:"scopes,omitempty"`
+}
+
 // AccountDeleteRequest defines model for AccountDeleteRequest.
 type AccountDeleteRequest struct {
 	// Confirm Confirmation flag for deletion
@@ -102,6 +273,180 @@ type AccountDeleteResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	Action  *AuditAction   `json:"action,omitempty"`
+	Changes *[]FieldChange `json:"changes,omitempty"`
+
+	// Client User-Agent of the request
+	Client    *string    `json:"client,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+	RequestId *string    `json:"request_id,omitempty"`
+
+	// SessionId Sign-in session the change was made in
+	SessionId  *string               `json:"session_id,omitempty"`
+	TargetId   *string               `json:"target_id,omitempty"`
+	TargetType *AuditEventTargetType `json:"target_type,omitempty"`
+
+	// TokenId Personal access token the change was made with
+	TokenId *string `json:"token_id,omitempty"`
+
+	// Tool Tool that made the change, if any
+	Tool *string `json:"tool,omitempty"`
+}
+
+// AuditEventTargetType defines model for AuditEvent.TargetType.
+type AuditEventTargetType string
+
+// BatchError Why an operation failed, with the codes of error responses
+type BatchError struct {
+	Code    *string       `json:"code,omitempty"`
+	Fields  *[]FieldError `json:"fields,omitempty"`
+	Message *string       `json:"message,omitempty"`
+}
+
+// BatchOperation One change, taking the fields of the corresponding todo or memo
+// endpoint. Updates and deletes need an id; fields the operation does
+// not take are an error.
+type BatchOperation struct {
+	Description *string `json:"description,omitempty"`
+
+	// Id ID of the item to update or delete, or "$" followed by a ref
+	Id          *string            `json:"id,omitempty"`
+	Kind        BatchOperationKind `json:"kind"`
+	LinkedTodos *[]string          `json:"linked_todos,omitempty"`
+	Op          BatchOperationOp   `json:"op"`
+
+	// ParentId Parent of a created todo
+	ParentId *string                 `json:"parent_id,omitempty"`
+	Priority *BatchOperationPriority `json:"priority,omitempty"`
+
+	// Ref Name later operations refer to the created item by, as "$" followed by it
+	Ref    *string               `json:"ref,omitempty"`
+	Status *BatchOperationStatus `json:"status,omitempty"`
+	Tags   *[]string             `json:"tags,omitempty"`
+	Title  *string               `json:"title,omitempty"`
+}
+
+// BatchOperationKind defines model for BatchOperation.Kind.
+type BatchOperationKind string
+
+// BatchOperationOp defines model for BatchOperation.Op.
+type BatchOperationOp string
+
+// BatchOperationPriority defines model for BatchOperation.Priority.
+type BatchOperationPriority string
+
+// BatchOperationStatus defines model for BatchOperation.Status.
+type BatchOperationStatus string
+
+// BatchOperationResult defines model for BatchOperationResult.
+type BatchOperationResult struct {
+	// Error Why an operation failed, with the codes of error responses
+	Error *BatchError `json:"error,omitempty"`
+
+	// Id ID of the item, omitted for creates not applied
+	Id *string `json:"id,omitempty"`
+
+	// Index Position of the operation in the request
+	Index *int    `json:"index,omitempty"`
+	Kind  *string `json:"kind,omitempty"`
+	Memo  *Memo   `json:"memo,omitempty"`
+	Op    *string `json:"op,omitempty"`
+	Ref   *string `json:"ref,omitempty"`
+
+	// Status skipped operations were not applied because another failed
+	Status *BatchOperationResultStatus `json:"status,omitempty"`
+	Todo   *Todo                       `json:"todo,omitempty"`
+}
+
+// BatchOperationResultStatus skipped operations were not applied because another failed
+type BatchOperationResultStatus string
+
+// BatchRequest defines model for BatchRequest.
+type BatchRequest struct {
+	// AllOrNothing Apply no operation unless all of them succeed
+	AllOrNothing *bool            `json:"all_or_nothing,omitempty"`
+	Operations   []BatchOperation `json:"operations"`
+}
+
+// BatchResponse defines model for BatchResponse.
+type BatchResponse struct {
+	Applied *int                    `json:"applied,omitempty"`
+	Failed  *int                    `json:"failed,omitempty"`
+	Message *string                 `json:"message,omitempty"`
+	Results *[]BatchOperationResult `json:"results,omitempty"`
+
+	// Success Whether every operation was applied
+	Success *bool `json:"success,omitempty"`
+}
+
+// Change defines model for Change.
+type Change struct {
+	At   *time.Time  `json:"at,omitempty"`
+	Id   *string     `json:"id,omitempty"`
+	Kind *ChangeKind `json:"kind,omitempty"`
+	Op   *ChangeOp   `json:"op,omitempty"`
+}
+
+// ChangeKind defines model for Change.Kind.
+type ChangeKind string
+
+// ChangeOp defines model for Change.Op.
+type ChangeOp string
+
+// ChangesRequest defines model for ChangesRequest.
+type ChangesRequest struct {
+	// Cursor Cursor returned by the previous call
+	Cursor *string `json:"cursor,omitempty"`
+
+	// TimeoutSeconds How long to wait for a change
+	TimeoutSeconds *int `json:"timeout_seconds,omitempty"`
+}
+
+// ChangesResponse defines model for ChangesResponse.
+type ChangesResponse struct {
+	Changes *[]Change `json:"changes,omitempty"`
+	Cursor  *string   `json:"cursor,omitempty"`
+	Reset   *bool     `json:"reset,omitempty"`
+	Success *bool     `json:"success,omitempty"`
+}
+
+// CompleteRequest defines model for CompleteRequest.
+type CompleteRequest struct {
+	Argument struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"argument"`
+	Context *struct {
+		Arguments *map[string]string `json:"arguments,omitempty"`
+	} `json:"context,omitempty"`
+	Ref struct {
+		// Name Prompt name (ref/prompt)
+		Name *string                `json:"name,omitempty"`
+		Type CompleteRequestRefType `json:"type"`
+
+		// Uri Resource URI template (ref/resource)
+		Uri *string `json:"uri,omitempty"`
+	} `json:"ref"`
+}
+
+// CompleteRequestRefType defines model for CompleteRequest.Ref.Type.
+type CompleteRequestRefType string
+
+// CompleteResponse defines model for CompleteResponse.
+type CompleteResponse struct {
+	Completion *struct {
+		HasMore *bool     `json:"hasMore,omitempty"`
+		Total   *int      `json:"total,omitempty"`
+		Values  *[]string `json:"values,omitempty"`
+	} `json:"completion,omitempty"`
+}
+
 // DeviceAuthPollRequest defines model for DeviceAuthPollRequest.
 type DeviceAuthPollRequest struct {
 	// DeviceCode Device code from start request
@@ -114,8 +459,15 @@ type DeviceAuthPollResponse struct {
 		// AccessToken JWT access token (only when authenticated)
 		AccessToken *string `json:"access_token,omitempty"`
 
+		// ExpiresIn Lifetime of the access token in seconds
+		ExpiresIn *int `json:"expires_in,omitempty"`
+
+		// RefreshToken Refresh token used to renew the access token (only when authenticated)
+		RefreshToken *string `json:"refresh_token,omitempty"`
+
 		// Status Authentication status
-		Status *DeviceAuthPollResponseDataStatus `json:"status,omitempty"`
+		Status    *DeviceAuthPollResponseDataStatus `json:"status,omitempty"`
+		TokenType *string                           `json:"token_type,omitempty"`
 	} `json:"data,omitempty"`
 	Message *string `json:"message,omitempty"`
 	Success *bool   `json:"success,omitempty"`
@@ -128,6 +480,12 @@ type DeviceAuthPollResponseDataStatus string
 type DeviceAuthStartRequest struct {
 	// ClientId OAuth client ID
 	ClientId *string `json:"client_id,omitempty"`
+
+	// Link Link the identity to the calling user instead of signing in
+	Link *bool `json:"link,omitempty"`
+
+	// Provider Identity provider to sign in with (default the server's first provider)
+	Provider *string `json:"provider,omitempty"`
 }
 
 // DeviceAuthStartResponse defines model for DeviceAuthStartResponse.
@@ -142,6 +500,9 @@ type DeviceAuthStartResponse struct {
 		// Interval Polling interval in seconds
 		Interval *int `json:"interval,omitempty"`
 
+		// Provider Identity provider the user signs in with
+		Provider *string `json:"provider,omitempty"`
+
 		// UserCode User code to enter on verification URL
 		UserCode *string `json:"user_code,omitempty"`
 
@@ -157,14 +518,94 @@ type DeviceAuthStartResponse struct {
 
 // Error defines model for Error.
 type Error struct {
-	// Code Error code
+	// Code Stable error code: BAD_REQUEST, VALIDATION_ERROR, UNAUTHORIZED,
+	// FORBIDDEN, NOT_FOUND, CONFLICT, AUTHORIZATION_PENDING,
+	// RATE_LIMITED, QUOTA_EXCEEDED, PRECONDITION_FAILED,
+	// CONFIRMATION_REQUIRED or INTERNAL_ERROR
 	Code *string `json:"code,omitempty"`
 
 	// Error Error message
-	Error   *string `json:"error,omitempty"`
+	Error *string `json:"error,omitempty"`
+
+	// Fields The invalid arguments of a VALIDATION_ERROR, if known
+	Fields  *[]FieldError `json:"fields,omitempty"`
+	Success *bool         `json:"success,omitempty"`
+}
+
+// FieldChange defines model for FieldChange.
+type FieldChange struct {
+	Field *string `json:"field,omitempty"`
+
+	// From Previous value, truncated to 100 characters; omitted if unset
+	From *string `json:"from,omitempty"`
+
+	// To New value, truncated to 100 characters; omitted if cleared
+	To *string `json:"to,omitempty"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field JSON name of the argument, with the index for list items
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Identity defines model for Identity.
+type Identity struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+	Provider  *string    `json:"provider,omitempty"`
+
+	// Subject Account ID at the provider
+	Subject *string `json:"subject,omitempty"`
+}
+
+// IdentityListResponse defines model for IdentityListResponse.
+type IdentityListResponse struct {
+	Data    *[]Identity `json:"data,omitempty"`
+	Message *string     `json:"message,omitempty"`
+	Success *bool       `json:"success,omitempty"`
+}
+
+// IdentityUnlinkResponse defines model for IdentityUnlinkResponse.
+type IdentityUnlinkResponse struct {
+	Message *string `json:"message,omitempty"`
 	Success *bool   `json:"success,omitempty"`
 }
 
+// JWK defines model for JWK.
+type JWK struct {
+	Alg JWKAlg `json:"alg"`
+
+	// Crv Curve of OKP keys
+	Crv *string `json:"crv,omitempty"`
+
+	// E Exponent of RSA keys (base64url)
+	E *string `json:"e,omitempty"`
+
+	// Kid Key ID; the RFC 7638 thumbprint unless set explicitly
+	Kid string `json:"kid"`
+	Kty JWKKty `json:"kty"`
+
+	// N Modulus of RSA keys (base64url)
+	N   *string `json:"n,omitempty"`
+	Use *string `json:"use,omitempty"`
+
+	// X Public key of OKP keys (base64url)
+	X *string `json:"x,omitempty"`
+}
+
+// JWKAlg defines model for JWK.Alg.
+type JWKAlg string
+
+// JWKKty defines model for JWK.Kty.
+type JWKKty string
+
+// JWKS defines model for JWKS.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
 // Memo defines model for Memo.
 type Memo struct {
 	ClosedAt     *time.Time `json:"closed_at"`
@@ -173,8 +614,11 @@ type Memo struct {
 	Id           *string    `json:"id,omitempty"`
 	LastModified *time.Time `json:"last_modified,omitempty"`
 	LinkedTodos  *[]string  `json:"linked_todos,omitempty"`
-	Tags         *[]string  `json:"tags,omitempty"`
-	Title        *string    `json:"title,omitempty"`
+
+	// SourceTodoId Todo the memo was demoted from
+	SourceTodoId *string   `json:"source_todo_id,omitempty"`
+	Tags         *[]string `json:"tags,omitempty"`
+	Title        *string   `json:"title,omitempty"`
 }
 
 // MemoCreateRequest defines model for MemoCreateRequest.
@@ -211,6 +655,22 @@ type MemoDeleteResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// MemoGetRequest defines model for MemoGetRequest.
+type MemoGetRequest struct {
+	// Id Memo ID to retrieve
+	Id string `json:"id"`
+}
+
+// MemoGetResponse defines model for MemoGetResponse.
+type MemoGetResponse struct {
+	Memo    *Memo   `json:"memo,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+
+	// Todos Todos the memo links
+	Todos *[]Todo `json:"todos,omitempty"`
+}
+
 // MemoListRequest defines model for MemoListRequest.
 type MemoListRequest struct {
 	// Tags Filter by tags
@@ -224,6 +684,14 @@ type MemoListResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// MemoPatch defines model for MemoPatch.
+type MemoPatch struct {
+	Description *string   `json:"description,omitempty"`
+	LinkedTodos *[]string `json:"linked_todos,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+	Title       *string   `json:"title,omitempty"`
+}
+
 // MemoUpdateRequest defines model for MemoUpdateRequest.
 type MemoUpdateRequest struct {
 	// Description New description
@@ -249,6 +717,62 @@ type MemoUpdateResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// PromptGetRequest defines model for PromptGetRequest.
+type PromptGetRequest struct {
+	// Arguments Prompt arguments (tags, from, to)
+	Arguments *map[string]string `json:"arguments,omitempty"`
+
+	// Name Prompt name
+	Name PromptGetRequestName `json:"name"`
+}
+
+// PromptGetRequestName Prompt name
+type PromptGetRequestName string
+
+// PromptGetResponse defines model for PromptGetResponse.
+type PromptGetResponse struct {
+	Description *string          `json:"description,omitempty"`
+	Messages    *[]PromptMessage `json:"messages,omitempty"`
+}
+
+// PromptMessage defines model for PromptMessage.
+type PromptMessage struct {
+	Content *struct {
+		Text *string `json:"text,omitempty"`
+		Type *string `json:"type,omitempty"`
+	} `json:"content,omitempty"`
+	Role *PromptMessageRole `json:"role,omitempty"`
+}
+
+// PromptMessageRole defines model for PromptMessage.Role.
+type PromptMessageRole string
+
+// RefreshRequest defines model for RefreshRequest.
+type RefreshRequest struct {
+	// RefreshToken Refresh token from the last sign-in or refresh
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefreshResponse defines model for RefreshResponse.
+type RefreshResponse struct {
+	Data *struct {
+		// AccessToken New JWT access token
+		AccessToken string `json:"access_token"`
+
+		// ExpiresIn Lifetime of the access token in seconds
+		ExpiresIn int `json:"expires_in"`
+
+		// RefreshToken New refresh token; the presented one is no longer valid
+		RefreshToken string  `json:"refresh_token"`
+		TokenType    *string `json:"token_type,omitempty"`
+	} `json:"data,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+}
+
+// Scope Permission granted to a token; admin grants all
+type Scope string
+
 // SearchRequest defines model for SearchRequest.
 type SearchRequest struct {
 	// Query Search query string
@@ -279,6 +803,47 @@ type SearchResults struct {
 	Todos *[]Todo `json:"todos,omitempty"`
 }
 
+// Session defines model for Session.
+type Session struct {
+	// Client User-Agent of the sign-in request
+	Client    *string    `json:"client,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Current Whether this is the session of the calling token
+	Current   *bool      `json:"current,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+
+	// LastUsedAt Last sign-in or token refresh
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// SessionListResponse defines model for SessionListResponse.
+type SessionListResponse struct {
+	Data    *[]Session `json:"data,omitempty"`
+	Message *string    `json:"message,omitempty"`
+	Success *bool      `json:"success,omitempty"`
+}
+
+// SessionRevokeRequest defines model for SessionRevokeRequest.
+type SessionRevokeRequest struct {
+	// SessionId Session to sign out (default the calling one)
+	SessionId *string `json:"session_id,omitempty"`
+}
+
+// SessionRevokeResponse defines model for SessionRevokeResponse.
+type SessionRevokeResponse struct {
+	Data *struct {
+		// Current Whether the calling session was signed out
+		Current *bool `json:"current,omitempty"`
+
+		// Revoked Number of sessions signed out
+		Revoked *int `json:"revoked,omitempty"`
+	} `json:"data,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+}
+
 // TagListRequest Empty request body for tag listing
 type TagListRequest = map[string]interface{}
 
@@ -299,9 +864,12 @@ type Todo struct {
 	LastModified *time.Time    `json:"last_modified,omitempty"`
 	ParentId     *string       `json:"parent_id,omitempty"`
 	Priority     *TodoPriority `json:"priority,omitempty"`
-	Status       *TodoStatus   `json:"status,omitempty"`
-	Tags         *[]string     `json:"tags,omitempty"`
-	Title        *string       `json:"title,omitempty"`
+
+	// SourceMemoId Memo the todo was promoted from
+	SourceMemoId *string     `json:"source_memo_id,omitempty"`
+	Status       *TodoStatus `json:"status,omitempty"`
+	Tags         *[]string   `json:"tags,omitempty"`
+	Title        *string     `json:"title,omitempty"`
 }
 
 // TodoPriority defines model for Todo.Priority.
@@ -354,6 +922,24 @@ type TodoDeleteRequest struct {
 type TodoDeleteResponse struct {
 	Message *string `json:"message,omitempty"`
 	Success *bool   `json:"success,omitempty"`
+
+	// UnlinkedMemos Memos the deleted todo was removed from
+	UnlinkedMemos *[]string `json:"unlinked_memos,omitempty"`
+}
+
+// TodoGetRequest defines model for TodoGetRequest.
+type TodoGetRequest struct {
+	// Id Todo ID to retrieve
+	Id string `json:"id"`
+}
+
+// TodoGetResponse defines model for TodoGetResponse.
+type TodoGetResponse struct {
+	// Backlinks Memos linking the todo
+	Backlinks *[]Memo `json:"backlinks,omitempty"`
+	Message   *string `json:"message,omitempty"`
+	Success   *bool   `json:"success,omitempty"`
+	Todo      *Todo   `json:"todo,omitempty"`
 }
 
 // TodoListRequest defines model for TodoListRequest.
@@ -381,6 +967,21 @@ type TodoListResponse struct {
 	Todos   *[]Todo `json:"todos,omitempty"`
 }
 
+// TodoPatch defines model for TodoPatch.
+type TodoPatch struct {
+	Description *string            `json:"description,omitempty"`
+	Priority    *TodoPatchPriority `json:"priority,omitempty"`
+	Status      *TodoPatchStatus   `json:"status,omitempty"`
+	Tags        *[]string          `json:"tags,omitempty"`
+	Title       *string            `json:"title,omitempty"`
+}
+
+// TodoPatchPriority defines model for TodoPatch.Priority.
+type TodoPatchPriority string
+
+// TodoPatchStatus defines model for TodoPatch.Status.
+type TodoPatchStatus string
+
 // TodoUpdateRequest defines model for TodoUpdateRequest.
 type TodoUpdateRequest struct {
 	// Description New description
@@ -415,6 +1016,37 @@ type TodoUpdateResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// TokenCreateRequest defines model for TokenCreateRequest.
+type TokenCreateRequest struct {
+	// ExpiresInDays Days until the token expires (default 0, never)
+	ExpiresInDays *int    `json:"expires_in_days,omitempty"`
+	Name          string  `json:"name"`
+	Scopes        []Scope `json:"scopes"`
+}
+
+// TokenCreateResponse defines model for TokenCreateResponse.
+type TokenCreateResponse struct {
+	AccessToken *AccessToken `json:"access_token,omitempty"`
+	Message     *string      `json:"message,omitempty"`
+	Success     *bool        `json:"success,omitempty"`
+
+	// Token The token; shown only once
+	Token *string `json:"token,omitempty"`
+}
+
+// TokenListResponse defines model for TokenListResponse.
+type TokenListResponse struct {
+	Message *string        `json:"message,omitempty"`
+	Success *bool          `json:"success,omitempty"`
+	Tokens  *[]AccessToken `json:"tokens,omitempty"`
+}
+
+// TokenRevokeResponse defines model for TokenRevokeResponse.
+type TokenRevokeResponse struct {
+	Message *string `json:"message,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+}
+
 // UserInfoResponse defines model for UserInfoResponse.
 type UserInfoResponse struct {
 	Data *struct {
@@ -428,59 +1060,260 @@ type UserInfoResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// ItemID defines model for ItemID.
+type ItemID = string
+
+// Page defines model for Page.
+type Page = int
+
+// PerPage defines model for PerPage.
+type PerPage = int
+
+// TagFilter defines model for TagFilter.
+type TagFilter = []string
+
 // BadRequest defines model for BadRequest.
 type BadRequest = Error
 
+// Forbidden defines model for Forbidden.
+type Forbidden = Error
+
 // InternalServerError defines model for InternalServerError.
 type InternalServerError = Error
 
 // NotFound defines model for NotFound.
 type NotFound = Error
 
+// PreconditionFailed defines model for PreconditionFailed.
+type PreconditionFailed = Error
+
+// RateLimited defines model for RateLimited.
+type RateLimited = Error
+
 // Unauthorized defines model for Unauthorized.
 type Unauthorized = Error
 
-// DeleteAccountJSONRequestBody defines body for DeleteAccount for application/json ContentType.
-type DeleteAccountJSONRequestBody = AccountDeleteRequest
+// ListActivityV2Params defines parameters for ListActivityV2.
+type ListActivityV2Params struct {
+	// Action Filter by action
+	Action *AuditAction `form:"action,omitempty" json:"action,omitempty"`
 
-// PollDeviceAuthJSONRequestBody defines body for PollDeviceAuth for application/json ContentType.
-type PollDeviceAuthJSONRequestBody = DeviceAuthPollRequest
+	// TargetType Filter by the kind of thing changed
+	TargetType *ListActivityV2ParamsTargetType `form:"target_type,omitempty" json:"target_type,omitempty"`
 
-// StartDeviceAuthJSONRequestBody defines body for StartDeviceAuth for application/json ContentType.
-type StartDeviceAuthJSONRequestBody = DeviceAuthStartRequest
+	// TargetId Filter by the ID of the thing changed
+	TargetId *string `form:"target_id,omitempty" json:"target_id,omitempty"`
 
-// CreateMemoJSONRequestBody defines body for CreateMemo for application/json ContentType.
-type CreateMemoJSONRequestBody = MemoCreateRequest
+	// Tool Filter by the tool that made the change
+	Tool *string `form:"tool,omitempty" json:"tool,omitempty"`
 
-// DeleteMemoJSONRequestBody defines body for DeleteMemo for application/json ContentType.
-type DeleteMemoJSONRequestBody = MemoDeleteRequest
+	// Since Only events at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
 
-// ListMemosJSONRequestBody defines body for ListMemos for application/json ContentType.
-type ListMemosJSONRequestBody = MemoListRequest
+	// Until Only events before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
 
-// UpdateMemoJSONRequestBody defines body for UpdateMemo for application/json ContentType.
-type UpdateMemoJSONRequestBody = MemoUpdateRequest
+	// Page Page number, starting at 1
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-// SearchJSONRequestBody defines body for Search for application/json ContentType.
-type SearchJSONRequestBody = SearchRequest
+	// PerPage Items per page
+	PerPage *PerPage `form:"per_page,omitempty" json:"per_page,omitempty"`
 
-// ListTagsJSONRequestBody defines body for ListTags for application/json ContentType.
-type ListTagsJSONRequestBody = TagListRequest
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
 
-// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
-type CreateTodoJSONRequestBody = TodoCreateRequest
+// ListActivityV2ParamsTargetType defines parameters for ListActivityV2.
+type ListActivityV2ParamsTargetType string
 
-// DeleteTodoJSONRequestBody defines body for DeleteTodo for application/json ContentType.
-type DeleteTodoJSONRequestBody = TodoDeleteRequest
+// ListMemosV2Params defines parameters for ListMemosV2.
+type ListMemosV2Params struct {
+	// Tag Filter by tag; repeat to require several tags
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
 
-// ListTodosJSONRequestBody defines body for ListTodos for application/json ContentType.
-type ListTodosJSONRequestBody = TodoListRequest
+	// Page Page number, starting at 1
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
-type UpdateTodoJSONRequestBody = TodoUpdateRequest
+	// PerPage Items per page
+	PerPage *PerPage `form:"per_page,omitempty" json:"per_page,omitempty"`
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// DeleteMemoV2Params defines parameters for DeleteMemoV2.
+type DeleteMemoV2Params struct {
+	// IfMatch Only apply the change if the ETag still matches
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetMemoV2Params defines parameters for GetMemoV2.
+type GetMemoV2Params struct {
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// UpdateMemoV2Params defines parameters for UpdateMemoV2.
+type UpdateMemoV2Params struct {
+	// IfMatch Only apply the change if the ETag still matches
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// SearchV2Params defines parameters for SearchV2.
+type SearchV2Params struct {
+	// Q Keyword matched against titles and descriptions
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Type Filter by type
+	Type *SearchV2ParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Tag Filter by tag; repeat to require several tags
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// SearchV2ParamsType defines parameters for SearchV2.
+type SearchV2ParamsType string
+
+// ListTagsV2Params defines parameters for ListTagsV2.
+type ListTagsV2Params struct {
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListTodosV2Params defines parameters for ListTodosV2.
+type ListTodosV2Params struct {
+	// Status Filter by status
+	Status *ListTodosV2ParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Priority Filter by priority
+	Priority *ListTodosV2ParamsPriority `form:"priority,omitempty" json:"priority,omitempty"`
+
+	// Tag Filter by tag; repeat to require several tags
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Page Page number, starting at 1
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Items per page
+	PerPage *PerPage `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListTodosV2ParamsStatus defines parameters for ListTodosV2.
+type ListTodosV2ParamsStatus string
+
+// ListTodosV2ParamsPriority defines parameters for ListTodosV2.
+type ListTodosV2ParamsPriority string
+
+// DeleteTodoV2Params defines parameters for DeleteTodoV2.
+type DeleteTodoV2Params struct {
+	// IfMatch Only apply the change if the ETag still matches
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetTodoV2Params defines parameters for GetTodoV2.
+type GetTodoV2Params struct {
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// UpdateTodoV2Params defines parameters for UpdateTodoV2.
+type UpdateTodoV2Params struct {
+	// IfMatch Only apply the change if the ETag still matches
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// DeleteAccountJSONRequestBody defines body for DeleteAccount for application/json ContentType.
+type DeleteAccountJSONRequestBody = AccountDeleteRequest
+
+// PollDeviceAuthJSONRequestBody defines body for PollDeviceAuth for application/json ContentType.
+type PollDeviceAuthJSONRequestBody = DeviceAuthPollRequest
+
+// StartDeviceAuthJSONRequestBody defines body for StartDeviceAuth for application/json ContentType.
+type StartDeviceAuthJSONRequestBody = DeviceAuthStartRequest
+
+// LogoutJSONRequestBody defines body for Logout for application/json ContentType.
+type LogoutJSONRequestBody = SessionRevokeRequest
+
+// RefreshAuthJSONRequestBody defines body for RefreshAuth for application/json ContentType.
+type RefreshAuthJSONRequestBody = RefreshRequest
+
+// CreateAccessTokenJSONRequestBody defines body for CreateAccessToken for application/json ContentType.
+type CreateAccessTokenJSONRequestBody = TokenCreateRequest
+
+// WaitChangesJSONRequestBody defines body for WaitChanges for application/json ContentType.
+type WaitChangesJSONRequestBody = ChangesRequest
+
+// CompleteArgumentJSONRequestBody defines body for CompleteArgument for application/json ContentType.
+type CompleteArgumentJSONRequestBody = CompleteRequest
+
+// CreateMemoJSONRequestBody defines body for CreateMemo for application/json ContentType.
+type CreateMemoJSONRequestBody = MemoCreateRequest
+
+// DeleteMemoJSONRequestBody defines body for DeleteMemo for application/json ContentType.
+type DeleteMemoJSONRequestBody = MemoDeleteRequest
+
+// GetMemoJSONRequestBody defines body for GetMemo for application/json ContentType.
+type GetMemoJSONRequestBody = MemoGetRequest
+
+// ListMemosJSONRequestBody defines body for ListMemos for application/json ContentType.
+type ListMemosJSONRequestBody = MemoListRequest
+
+// UpdateMemoJSONRequestBody defines body for UpdateMemo for application/json ContentType.
+type UpdateMemoJSONRequestBody = MemoUpdateRequest
+
+// GetPromptJSONRequestBody defines body for GetPrompt for application/json ContentType.
+type GetPromptJSONRequestBody = PromptGetRequest
+
+// SearchJSONRequestBody defines body for Search for application/json ContentType.
+type SearchJSONRequestBody = SearchRequest
+
+// ListTagsJSONRequestBody defines body for ListTags for application/json ContentType.
+type ListTagsJSONRequestBody = TagListRequest
+
+// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
+type CreateTodoJSONRequestBody = TodoCreateRequest
+
+// DeleteTodoJSONRequestBody defines body for DeleteTodo for application/json ContentType.
+type DeleteTodoJSONRequestBody = TodoDeleteRequest
+
+// GetTodoJSONRequestBody defines body for GetTodo for application/json ContentType.
+type GetTodoJSONRequestBody = TodoGetRequest
+
+// ListTodosJSONRequestBody defines body for ListTodos for application/json ContentType.
+type ListTodosJSONRequestBody = TodoListRequest
+
+// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
+type UpdateTodoJSONRequestBody = TodoUpdateRequest
+
+// BatchV2JSONRequestBody defines body for BatchV2 for application/json ContentType.
+type BatchV2JSONRequestBody = BatchRequest
+
+// CreateMemoV2JSONRequestBody defines body for CreateMemoV2 for application/json ContentType.
+type CreateMemoV2JSONRequestBody = MemoCreateRequest
+
+// UpdateMemoV2JSONRequestBody defines body for UpdateMemoV2 for application/json ContentType.
+type UpdateMemoV2JSONRequestBody = MemoPatch
+
+// CreateTodoV2JSONRequestBody defines body for CreateTodoV2 for application/json ContentType.
+type CreateTodoV2JSONRequestBody = TodoCreateRequest
+
+// UpdateTodoV2JSONRequestBody defines body for UpdateTodoV2 for application/json ContentType.
+type UpdateTodoV2JSONRequestBody = TodoPatch
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
 // Doer performs HTTP requests.
 //
@@ -552,6 +1385,9 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetJWKS request
+	GetJWKS(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteAccountWithBody request with any body
 	DeleteAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -567,12 +1403,55 @@ type ClientInterface interface {
 
 	StartDeviceAuth(ctx context.Context, body StartDeviceAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListIdentities request
+	ListIdentities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnlinkIdentity request
+	UnlinkIdentity(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LogoutWithBody request with any body
+	LogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Logout(ctx context.Context, body LogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LogoutAll request
+	LogoutAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshAuthWithBody request with any body
+	RefreshAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RefreshAuth(ctx context.Context, body RefreshAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSessions request
+	ListSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAccessTokens request
+	ListAccessTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAccessTokenWithBody request with any body
+	CreateAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAccessToken(ctx context.Context, body CreateAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RevokeAccessToken request
+	RevokeAccessToken(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUserInfo request
 	GetUserInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// HealthCheck request
 	HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WaitChangesWithBody request with any body
+	WaitChangesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WaitChanges(ctx context.Context, body WaitChangesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CompleteArgumentWithBody request with any body
+	CompleteArgumentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CompleteArgument(ctx context.Context, body CompleteArgumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateMemoWithBody request with any body
 	CreateMemoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -583,6 +1462,11 @@ type ClientInterface interface {
 
 	DeleteMemo(ctx context.Context, body DeleteMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetMemoWithBody request with any body
+	GetMemoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetMemo(ctx context.Context, body GetMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListMemosWithBody request with any body
 	ListMemosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -593,6 +1477,11 @@ type ClientInterface interface {
 
 	UpdateMemo(ctx context.Context, body UpdateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetPromptWithBody request with any body
+	GetPromptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetPrompt(ctx context.Context, body GetPromptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SearchWithBody request with any body
 	SearchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -613,6 +1502,11 @@ type ClientInterface interface {
 
 	DeleteTodo(ctx context.Context, body DeleteTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetTodoWithBody request with any body
+	GetTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	GetTodo(ctx context.Context, body GetTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListTodosWithBody request with any body
 	ListTodosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -622,6 +1516,70 @@ type ClientInterface interface {
 	UpdateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateTodo(ctx context.Context, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListActivityV2 request
+	ListActivityV2(ctx context.Context, params *ListActivityV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BatchV2WithBody request with any body
+	BatchV2WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BatchV2(ctx context.Context, body BatchV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListMemosV2 request
+	ListMemosV2(ctx context.Context, params *ListMemosV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateMemoV2WithBody request with any body
+	CreateMemoV2WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateMemoV2(ctx context.Context, body CreateMemoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteMemoV2 request
+	DeleteMemoV2(ctx context.Context, id ItemID, params *DeleteMemoV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMemoV2 request
+	GetMemoV2(ctx context.Context, id ItemID, params *GetMemoV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateMemoV2WithBody request with any body
+	UpdateMemoV2WithBody(ctx context.Context, id ItemID, params *UpdateMemoV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateMemoV2(ctx context.Context, id ItemID, params *UpdateMemoV2Params, body UpdateMemoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchV2 request
+	SearchV2(ctx context.Context, params *SearchV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTagsV2 request
+	ListTagsV2(ctx context.Context, params *ListTagsV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListTodosV2 request
+	ListTodosV2(ctx context.Context, params *ListTodosV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTodoV2WithBody request with any body
+	CreateTodoV2WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTodoV2(ctx context.Context, body CreateTodoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTodoV2 request
+	DeleteTodoV2(ctx context.Context, id ItemID, params *DeleteTodoV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodoV2 request
+	GetTodoV2(ctx context.Context, id ItemID, params *GetTodoV2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTodoV2WithBody request with any body
+	UpdateTodoV2WithBody(ctx context.Context, id ItemID, params *UpdateTodoV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTodoV2(ctx context.Context, id ItemID, params *UpdateTodoV2Params, body UpdateTodoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetJWKS(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJWKSRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) DeleteAccountWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -696,8 +1654,8 @@ func (c *Client) StartDeviceAuth(ctx context.Context, body StartDeviceAuthJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUserInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserInfoRequest(c.Server)
+func (c *Client) ListIdentities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListIdentitiesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -708,8 +1666,8 @@ func (c *Client) GetUserInfo(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHealthCheckRequest(c.Server)
+func (c *Client) UnlinkIdentity(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnlinkIdentityRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -720,8 +1678,8 @@ func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMemoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMemoRequestWithBody(c.Server, contentType, body)
+func (c *Client) LogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -732,8 +1690,8 @@ func (c *Client) CreateMemoWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateMemo(ctx context.Context, body CreateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateMemoRequest(c.Server, body)
+func (c *Client) Logout(ctx context.Context, body LogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -744,8 +1702,8 @@ func (c *Client) CreateMemo(ctx context.Context, body CreateMemoJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteMemoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteMemoRequestWithBody(c.Server, contentType, body)
+func (c *Client) LogoutAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutAllRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -756,8 +1714,8 @@ func (c *Client) DeleteMemoWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteMemo(ctx context.Context, body DeleteMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteMemoRequest(c.Server, body)
+func (c *Client) RefreshAuthWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshAuthRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -768,8 +1726,8 @@ func (c *Client) DeleteMemo(ctx context.Context, body DeleteMemoJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMemosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMemosRequestWithBody(c.Server, contentType, body)
+func (c *Client) RefreshAuth(ctx context.Context, body RefreshAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshAuthRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -780,8 +1738,8 @@ func (c *Client) ListMemosWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMemos(ctx context.Context, body ListMemosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMemosRequest(c.Server, body)
+func (c *Client) ListSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSessionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -792,8 +1750,8 @@ func (c *Client) ListMemos(ctx context.Context, body ListMemosJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMemoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMemoRequestWithBody(c.Server, contentType, body)
+func (c *Client) ListAccessTokens(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAccessTokensRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -804,8 +1762,8 @@ func (c *Client) UpdateMemoWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMemo(ctx context.Context, body UpdateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMemoRequest(c.Server, body)
+func (c *Client) CreateAccessTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAccessTokenRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -816,8 +1774,8 @@ func (c *Client) UpdateMemo(ctx context.Context, body UpdateMemoJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) SearchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateAccessToken(ctx context.Context, body CreateAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAccessTokenRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -828,8 +1786,8 @@ func (c *Client) SearchWithBody(ctx context.Context, contentType string, body io
 	return c.Client.Do(req)
 }
 
-func (c *Client) Search(ctx context.Context, body SearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchRequest(c.Server, body)
+func (c *Client) RevokeAccessToken(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRevokeAccessTokenRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -840,8 +1798,8 @@ func (c *Client) Search(ctx context.Context, body SearchJSONRequestBody, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTagsRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetUserInfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserInfoRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -852,8 +1810,8 @@ func (c *Client) ListTagsWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListTags(ctx context.Context, body ListTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTagsRequest(c.Server, body)
+func (c *Client) HealthCheck(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthCheckRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -864,8 +1822,8 @@ func (c *Client) ListTags(ctx context.Context, body ListTagsJSONRequestBody, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTodoRequestWithBody(c.Server, contentType, body)
+func (c *Client) WaitChangesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWaitChangesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -876,8 +1834,8 @@ func (c *Client) CreateTodoWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTodoRequest(c.Server, body)
+func (c *Client) WaitChanges(ctx context.Context, body WaitChangesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWaitChangesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -888,8 +1846,8 @@ func (c *Client) CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTodoRequestWithBody(c.Server, contentType, body)
+func (c *Client) CompleteArgumentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteArgumentRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -900,8 +1858,8 @@ func (c *Client) DeleteTodoWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteTodo(ctx context.Context, body DeleteTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteTodoRequest(c.Server, body)
+func (c *Client) CompleteArgument(ctx context.Context, body CompleteArgumentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteArgumentRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -912,8 +1870,8 @@ func (c *Client) DeleteTodo(ctx context.Context, body DeleteTodoJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListTodosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTodosRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateMemoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMemoRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -924,8 +1882,8 @@ func (c *Client) ListTodosWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListTodos(ctx context.Context, body ListTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTodosRequest(c.Server, body)
+func (c *Client) CreateMemo(ctx context.Context, body CreateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMemoRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -936,8 +1894,8 @@ func (c *Client) ListTodos(ctx context.Context, body ListTodosJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTodoRequestWithBody(c.Server, contentType, body)
+func (c *Client) DeleteMemoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMemoRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -948,8 +1906,8 @@ func (c *Client) UpdateTodoWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateTodo(ctx context.Context, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateTodoRequest(c.Server, body)
+func (c *Client) DeleteMemo(ctx context.Context, body DeleteMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMemoRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -960,353 +1918,500 @@ func (c *Client) UpdateTodo(ctx context.Context, body UpdateTodoJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-// NewDeleteAccountRequest calls the generic DeleteAccount builder with application/json body
-func NewDeleteAccountRequest(server string, body DeleteAccountJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetMemoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMemoRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDeleteAccountRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteAccountRequestWithBody generates requests for DeleteAccount with any type of body
-func NewDeleteAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetMemo(ctx context.Context, body GetMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMemoRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/delete_account")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListMemosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMemosRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPollDeviceAuthRequest calls the generic PollDeviceAuth builder with application/json body
-func NewPollDeviceAuthRequest(server string, body PollDeviceAuthJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListMemos(ctx context.Context, body ListMemosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMemosRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPollDeviceAuthRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPollDeviceAuthRequestWithBody generates requests for PollDeviceAuth with any type of body
-func NewPollDeviceAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateMemoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMemoRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/device_poll")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateMemo(ctx context.Context, body UpdateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMemoRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewStartDeviceAuthRequest calls the generic StartDeviceAuth builder with application/json body
-func NewStartDeviceAuthRequest(server string, body StartDeviceAuthJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetPromptWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPromptRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewStartDeviceAuthRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewStartDeviceAuthRequestWithBody generates requests for StartDeviceAuth with any type of body
-func NewStartDeviceAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetPrompt(ctx context.Context, body GetPromptJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPromptRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/device_start")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SearchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetUserInfoRequest generates requests for GetUserInfo
-func NewGetUserInfoRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) Search(ctx context.Context, body SearchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/user")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTagsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewHealthCheckRequest generates requests for HealthCheck
-func NewHealthCheckRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListTags(ctx context.Context, body ListTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTagsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateMemoRequest calls the generic CreateMemo builder with application/json body
-func NewCreateMemoRequest(server string, body CreateMemoJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateTodo(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateMemoRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateMemoRequestWithBody generates requests for CreateMemo with any type of body
-func NewCreateMemoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTodoRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/mcp/memo_create")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteTodo(ctx context.Context, body DeleteTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTodoRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteMemoRequest calls the generic DeleteMemo builder with application/json body
-func NewDeleteMemoRequest(server string, body DeleteMemoJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDeleteMemoRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteMemoRequestWithBody generates requests for DeleteMemo with any type of body
-func NewDeleteMemoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetTodo(ctx context.Context, body GetTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/mcp/memo_delete")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListTodosWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTodosRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListMemosRequest calls the generic ListMemos builder with application/json body
-func NewListMemosRequest(server string, body ListMemosJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListTodos(ctx context.Context, body ListTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTodosRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewListMemosRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListMemosRequestWithBody generates requests for ListMemos with any type of body
-func NewListMemosRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateTodoWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/mcp/memo_list")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateTodo(ctx context.Context, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) ListActivityV2(ctx context.Context, params *ListActivityV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListActivityV2Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateMemoRequest calls the generic UpdateMemo builder with application/json body
-func NewUpdateMemoRequest(server string, body UpdateMemoJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) BatchV2WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchV2RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMemoRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateMemoRequestWithBody generates requests for UpdateMemo with any type of body
-func NewUpdateMemoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) BatchV2(ctx context.Context, body BatchV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBatchV2Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/mcp/memo_update")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListMemosV2(ctx context.Context, params *ListMemosV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMemosV2Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) CreateMemoV2WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMemoV2RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) CreateMemoV2(ctx context.Context, body CreateMemoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateMemoV2Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) DeleteMemoV2(ctx context.Context, id ItemID, params *DeleteMemoV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMemoV2Request(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewSearchRequest calls the generic Search builder with application/json body
-func NewSearchRequest(server string, body SearchJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetMemoV2(ctx context.Context, id ItemID, params *GetMemoV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMemoV2Request(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewSearchRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewSearchRequestWithBody generates requests for Search with any type of body
-func NewSearchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+func (c *Client) UpdateMemoV2WithBody(ctx context.Context, id ItemID, params *UpdateMemoV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMemoV2RequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateMemoV2(ctx context.Context, id ItemID, params *UpdateMemoV2Params, body UpdateMemoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMemoV2Request(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchV2(ctx context.Context, params *SearchV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchV2Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTagsV2(ctx context.Context, params *ListTagsV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTagsV2Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListTodosV2(ctx context.Context, params *ListTodosV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTodosV2Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTodoV2WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoV2RequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTodoV2(ctx context.Context, body CreateTodoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoV2Request(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteTodoV2(ctx context.Context, id ItemID, params *DeleteTodoV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTodoV2Request(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTodoV2(ctx context.Context, id ItemID, params *GetTodoV2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoV2Request(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTodoV2WithBody(ctx context.Context, id ItemID, params *UpdateTodoV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoV2RequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTodoV2(ctx context.Context, id ItemID, params *UpdateTodoV2Params, body UpdateTodoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoV2Request(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetJWKSRequest generates requests for GetJWKS
+func NewGetJWKSRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1314,7 +2419,7 @@ func NewSearchRequestWithBody(server string, contentType string, body io.Reader)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mcp/search")
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1324,29 +2429,27 @@ func NewSearchRequestWithBody(server string, contentType string, body io.Reader)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewListTagsRequest calls the generic ListTags builder with application/json body
-func NewListTagsRequest(server string, body ListTagsJSONRequestBody) (*http.Request, error) {
+// NewDeleteAccountRequest calls the generic DeleteAccount builder with application/json body
+func NewDeleteAccountRequest(server string, body DeleteAccountJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewListTagsRequestWithBody(server, "application/json", bodyReader)
+	return NewDeleteAccountRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewListTagsRequestWithBody generates requests for ListTags with any type of body
-func NewListTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteAccountRequestWithBody generates requests for DeleteAccount with any type of body
+func NewDeleteAccountRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1354,7 +2457,7 @@ func NewListTagsRequestWithBody(server string, contentType string, body io.Reade
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mcp/tag_list")
+	operationPath := fmt.Sprintf("/auth/delete_account")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1374,19 +2477,19 @@ func NewListTagsRequestWithBody(server string, contentType string, body io.Reade
 	return req, nil
 }
 
-// NewCreateTodoRequest calls the generic CreateTodo builder with application/json body
-func NewCreateTodoRequest(server string, body CreateTodoJSONRequestBody) (*http.Request, error) {
+// NewPollDeviceAuthRequest calls the generic PollDeviceAuth builder with application/json body
+func NewPollDeviceAuthRequest(server string, body PollDeviceAuthJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateTodoRequestWithBody(server, "application/json", bodyReader)
+	return NewPollDeviceAuthRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateTodoRequestWithBody generates requests for CreateTodo with any type of body
-func NewCreateTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPollDeviceAuthRequestWithBody generates requests for PollDeviceAuth with any type of body
+func NewPollDeviceAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1394,7 +2497,7 @@ func NewCreateTodoRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mcp/todo_create")
+	operationPath := fmt.Sprintf("/auth/device_poll")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1414,19 +2517,19 @@ func NewCreateTodoRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewDeleteTodoRequest calls the generic DeleteTodo builder with application/json body
-func NewDeleteTodoRequest(server string, body DeleteTodoJSONRequestBody) (*http.Request, error) {
+// NewStartDeviceAuthRequest calls the generic StartDeviceAuth builder with application/json body
+func NewStartDeviceAuthRequest(server string, body StartDeviceAuthJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDeleteTodoRequestWithBody(server, "application/json", bodyReader)
+	return NewStartDeviceAuthRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewDeleteTodoRequestWithBody generates requests for DeleteTodo with any type of body
-func NewDeleteTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewStartDeviceAuthRequestWithBody generates requests for StartDeviceAuth with any type of body
+func NewStartDeviceAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1434,7 +2537,7 @@ func NewDeleteTodoRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mcp/todo_delete")
+	operationPath := fmt.Sprintf("/auth/device_start")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1454,27 +2557,50 @@ func NewDeleteTodoRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewListTodosRequest calls the generic ListTodos builder with application/json body
-func NewListTodosRequest(server string, body ListTodosJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewListIdentitiesRequest generates requests for ListIdentities
+func NewListIdentitiesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewListTodosRequestWithBody(server, "application/json", bodyReader)
+
+	operationPath := fmt.Sprintf("/auth/identities")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// NewListTodosRequestWithBody generates requests for ListTodos with any type of body
-func NewListTodosRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUnlinkIdentityRequest generates requests for UnlinkIdentity
+func NewUnlinkIdentityRequest(server string, id string) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mcp/todo_list")
+	operationPath := fmt.Sprintf("/auth/identities/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1484,29 +2610,27 @@ func NewListTodosRequestWithBody(server string, contentType string, body io.Read
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewUpdateTodoRequest calls the generic UpdateTodo builder with application/json body
-func NewUpdateTodoRequest(server string, body UpdateTodoJSONRequestBody) (*http.Request, error) {
+// NewLogoutRequest calls the generic Logout builder with application/json body
+func NewLogoutRequest(server string, body LogoutJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateTodoRequestWithBody(server, "application/json", bodyReader)
+	return NewLogoutRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewUpdateTodoRequestWithBody generates requests for UpdateTodo with any type of body
-func NewUpdateTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewLogoutRequestWithBody generates requests for Logout with any type of body
+func NewLogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1514,7 +2638,7 @@ func NewUpdateTodoRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/mcp/todo_update")
+	operationPath := fmt.Sprintf("/auth/logout")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1534,752 +2658,5565 @@ func NewUpdateTodoRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewLogoutAllRequest generates requests for LogoutAll
+func NewLogoutAllRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/auth/logout_all")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// DeleteAccountWithBodyWithResponse request with any body
-	DeleteAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteAccountResponse, error)
+// NewRefreshAuthRequest calls the generic RefreshAuth builder with application/json body
+func NewRefreshAuthRequest(server string, body RefreshAuthJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefreshAuthRequestWithBody(server, "application/json", bodyReader)
+}
 
-	DeleteAccountWithResponse(ctx context.Context, body DeleteAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteAccountResponse, error)
+// NewRefreshAuthRequestWithBody generates requests for RefreshAuth with any type of body
+func NewRefreshAuthRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// PollDeviceAuthWithBodyWithResponse request with any body
-	PollDeviceAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PollDeviceAuthResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	PollDeviceAuthWithResponse(ctx context.Context, body PollDeviceAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PollDeviceAuthResponse, error)
+	operationPath := fmt.Sprintf("/auth/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// StartDeviceAuthWithBodyWithResponse request with any body
-	StartDeviceAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartDeviceAuthResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	StartDeviceAuthWithResponse(ctx context.Context, body StartDeviceAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*StartDeviceAuthResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetUserInfoWithResponse request
-	GetUserInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserInfoResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// HealthCheckWithResponse request
-	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+	return req, nil
+}
 
-	// CreateMemoWithBodyWithResponse request with any body
-	CreateMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMemoResponse, error)
+// NewListSessionsRequest generates requests for ListSessions
+func NewListSessionsRequest(server string) (*http.Request, error) {
+	var err error
 
-	CreateMemoWithResponse(ctx context.Context, body CreateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMemoResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteMemoWithBodyWithResponse request with any body
-	DeleteMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMemoResponse, error)
+	operationPath := fmt.Sprintf("/auth/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	DeleteMemoWithResponse(ctx context.Context, body DeleteMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMemoResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListMemosWithBodyWithResponse request with any body
-	ListMemosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListMemosResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	ListMemosWithResponse(ctx context.Context, body ListMemosJSONRequestBody, reqEditors ...RequestEditorFn) (*ListMemosResponse, error)
+	return req, nil
+}
 
-	// UpdateMemoWithBodyWithResponse request with any body
-	UpdateMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMemoResponse, error)
+// NewListAccessTokensRequest generates requests for ListAccessTokens
+func NewListAccessTokensRequest(server string) (*http.Request, error) {
+	var err error
 
-	UpdateMemoWithResponse(ctx context.Context, body UpdateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMemoResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// SearchWithBodyWithResponse request with any body
-	SearchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchResponse, error)
+	operationPath := fmt.Sprintf("/auth/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	SearchWithResponse(ctx context.Context, body SearchJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListTagsWithBodyWithResponse request with any body
-	ListTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListTagsResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	ListTagsWithResponse(ctx context.Context, body ListTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*ListTagsResponse, error)
+	return req, nil
+}
 
-	// CreateTodoWithBodyWithResponse request with any body
-	CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+// NewCreateAccessTokenRequest calls the generic CreateAccessToken builder with application/json body
+func NewCreateAccessTokenRequest(server string, body CreateAccessTokenJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateAccessTokenRequestWithBody(server, "application/json", bodyReader)
+}
 
-	CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+// NewCreateAccessTokenRequestWithBody generates requests for CreateAccessToken with any type of body
+func NewCreateAccessTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// DeleteTodoWithBodyWithResponse request with any body
-	DeleteTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	DeleteTodoWithResponse(ctx context.Context, body DeleteTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error)
+	operationPath := fmt.Sprintf("/auth/tokens")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListTodosWithBodyWithResponse request with any body
-	ListTodosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListTodosResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	ListTodosWithResponse(ctx context.Context, body ListTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*ListTodosResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateTodoWithBodyWithResponse request with any body
-	UpdateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	UpdateTodoWithResponse(ctx context.Context, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+	return req, nil
 }
 
-type DeleteAccountResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AccountDeleteResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON500      *InternalServerError
-}
+// NewRevokeAccessTokenRequest generates requests for RevokeAccessToken
+func NewRevokeAccessTokenRequest(server string, id string) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r DeleteAccountResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAccountResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PollDeviceAuthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeviceAuthPollResponse
-	JSON400      *BadRequest
-	JSON500      *InternalServerError
-}
+	operationPath := fmt.Sprintf("/auth/tokens/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PollDeviceAuthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PollDeviceAuthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type StartDeviceAuthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeviceAuthStartResponse
-	JSON400      *BadRequest
-	JSON500      *InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r StartDeviceAuthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetUserInfoRequest generates requests for GetUserInfo
+func NewGetUserInfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StartDeviceAuthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/auth/user")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetUserInfoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UserInfoResponse
-	JSON401      *Unauthorized
-	JSON500      *InternalServerError
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetUserInfoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserInfoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewHealthCheckRequest generates requests for HealthCheck
+func NewHealthCheckRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type HealthCheckResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *struct {
-		Status    string    `json:"status"`
-		Timestamp time.Time `json:"timestamp"`
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// Status returns HTTPResponse.Status
-func (r HealthCheckResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HealthCheckResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateMemoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MemoCreateResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON500      *InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateMemoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewWaitChangesRequest calls the generic WaitChanges builder with application/json body
+func NewWaitChangesRequest(server string, body WaitChangesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewWaitChangesRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateMemoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewWaitChangesRequestWithBody generates requests for WaitChanges with any type of body
+func NewWaitChangesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteMemoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MemoDeleteResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-	JSON500      *InternalServerError
-}
+	operationPath := fmt.Sprintf("/mcp/changes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteMemoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMemoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListMemosResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MemoListResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON500      *InternalServerError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r ListMemosResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListMemosResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCompleteArgumentRequest calls the generic CompleteArgument builder with application/json body
+func NewCompleteArgumentRequest(server string, body CompleteArgumentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewCompleteArgumentRequestWithBody(server, "application/json", bodyReader)
 }
 
-type UpdateMemoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MemoUpdateResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-	JSON500      *InternalServerError
-}
+// NewCompleteArgumentRequestWithBody generates requests for CompleteArgument with any type of body
+func NewCompleteArgumentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r UpdateMemoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMemoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/mcp/complete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
-
-type SearchResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SearchResult
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON500      *InternalServerError
-}
 
-// Status returns HTTPResponse.Status
-func (r SearchResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListTagsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TagListResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON500      *InternalServerError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r ListTagsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListTagsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCreateMemoRequest calls the generic CreateMemo builder with application/json body
+func NewCreateMemoRequest(server string, body CreateMemoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMemoRequestWithBody(server, "application/json", bodyReader)
 }
 
-type CreateTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TodoCreateResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON500      *InternalServerError
-}
+// NewCreateMemoRequestWithBody generates requests for CreateMemo with any type of body
+func NewCreateMemoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r CreateTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/mcp/memo_create")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type DeleteTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TodoDeleteResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-	JSON500      *InternalServerError
-}
-
-// Status returns HTTPResponse.Status
-func (r DeleteTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListTodosResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TodoListResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON500      *InternalServerError
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r ListTodosResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListTodosResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewDeleteMemoRequest calls the generic DeleteMemo builder with application/json body
+func NewDeleteMemoRequest(server string, body DeleteMemoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteMemoRequestWithBody(server, "application/json", bodyReader)
 }
 
-type UpdateTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TodoUpdateResponse
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON404      *NotFound
-	JSON500      *InternalServerError
-}
+// NewDeleteMemoRequestWithBody generates requests for DeleteMemo with any type of body
+func NewDeleteMemoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r UpdateTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/mcp/memo_delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-// DeleteAccountWithBodyWithResponse request with arbitrary body returning *DeleteAccountResponse
-func (c *ClientWithResponses) DeleteAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteAccountResponse, error) {
-	rsp, err := c.DeleteAccountWithBody(ctx, contentType, body, reqEditors...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteAccountResponse(rsp)
-}
 
-func (c *ClientWithResponses) DeleteAccountWithResponse(ctx context.Context, body DeleteAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteAccountResponse, error) {
-	rsp, err := c.DeleteAccount(ctx, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteAccountResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// PollDeviceAuthWithBodyWithResponse request with arbitrary body returning *PollDeviceAuthResponse
-func (c *ClientWithResponses) PollDeviceAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PollDeviceAuthResponse, error) {
-	rsp, err := c.PollDeviceAuthWithBody(ctx, contentType, body, reqEditors...)
+// NewGetMemoRequest calls the generic GetMemo builder with application/json body
+func NewGetMemoRequest(server string, body GetMemoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePollDeviceAuthResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewGetMemoRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) PollDeviceAuthWithResponse(ctx context.Context, body PollDeviceAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PollDeviceAuthResponse, error) {
-	rsp, err := c.PollDeviceAuth(ctx, body, reqEditors...)
+// NewGetMemoRequestWithBody generates requests for GetMemo with any type of body
+func NewGetMemoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePollDeviceAuthResponse(rsp)
-}
 
-// StartDeviceAuthWithBodyWithResponse request with arbitrary body returning *StartDeviceAuthResponse
-func (c *ClientWithResponses) StartDeviceAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartDeviceAuthResponse, error) {
-	rsp, err := c.StartDeviceAuthWithBody(ctx, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/mcp/memo_get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStartDeviceAuthResponse(rsp)
-}
 
-func (c *ClientWithResponses) StartDeviceAuthWithResponse(ctx context.Context, body StartDeviceAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*StartDeviceAuthResponse, error) {
-	rsp, err := c.StartDeviceAuth(ctx, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseStartDeviceAuthResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// GetUserInfoWithResponse request returning *GetUserInfoResponse
-func (c *ClientWithResponses) GetUserInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserInfoResponse, error) {
-	rsp, err := c.GetUserInfo(ctx, reqEditors...)
+// NewListMemosRequest calls the generic ListMemos builder with application/json body
+func NewListMemosRequest(server string, body ListMemosJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUserInfoResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewListMemosRequestWithBody(server, "application/json", bodyReader)
 }
 
-// HealthCheckWithResponse request returning *HealthCheckResponse
-func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
-	rsp, err := c.HealthCheck(ctx, reqEditors...)
+// NewListMemosRequestWithBody generates requests for ListMemos with any type of body
+func NewListMemosRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseHealthCheckResponse(rsp)
-}
 
-// CreateMemoWithBodyWithResponse request with arbitrary body returning *CreateMemoResponse
-func (c *ClientWithResponses) CreateMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMemoResponse, error) {
-	rsp, err := c.CreateMemoWithBody(ctx, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/mcp/memo_list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateMemoResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateMemoWithResponse(ctx context.Context, body CreateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMemoResponse, error) {
-	rsp, err := c.CreateMemo(ctx, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateMemoResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// DeleteMemoWithBodyWithResponse request with arbitrary body returning *DeleteMemoResponse
-func (c *ClientWithResponses) DeleteMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMemoResponse, error) {
-	rsp, err := c.DeleteMemoWithBody(ctx, contentType, body, reqEditors...)
+// NewUpdateMemoRequest calls the generic UpdateMemo builder with application/json body
+func NewUpdateMemoRequest(server string, body UpdateMemoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteMemoResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMemoRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) DeleteMemoWithResponse(ctx context.Context, body DeleteMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMemoResponse, error) {
-	rsp, err := c.DeleteMemo(ctx, body, reqEditors...)
+// NewUpdateMemoRequestWithBody generates requests for UpdateMemo with any type of body
+func NewUpdateMemoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteMemoResponse(rsp)
-}
 
-// ListMemosWithBodyWithResponse request with arbitrary body returning *ListMemosResponse
-func (c *ClientWithResponses) ListMemosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListMemosResponse, error) {
-	rsp, err := c.ListMemosWithBody(ctx, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/mcp/memo_update")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListMemosResponse(rsp)
-}
 
-func (c *ClientWithResponses) ListMemosWithResponse(ctx context.Context, body ListMemosJSONRequestBody, reqEditors ...RequestEditorFn) (*ListMemosResponse, error) {
-	rsp, err := c.ListMemos(ctx, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListMemosResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// UpdateMemoWithBodyWithResponse request with arbitrary body returning *UpdateMemoResponse
-func (c *ClientWithResponses) UpdateMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMemoResponse, error) {
-	rsp, err := c.UpdateMemoWithBody(ctx, contentType, body, reqEditors...)
+// NewGetPromptRequest calls the generic GetPrompt builder with application/json body
+func NewGetPromptRequest(server string, body GetPromptJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMemoResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewGetPromptRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) UpdateMemoWithResponse(ctx context.Context, body UpdateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMemoResponse, error) {
-	rsp, err := c.UpdateMemo(ctx, body, reqEditors...)
+// NewGetPromptRequestWithBody generates requests for GetPrompt with any type of body
+func NewGetPromptRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateMemoResponse(rsp)
-}
 
-// SearchWithBodyWithResponse request with arbitrary body returning *SearchResponse
-func (c *ClientWithResponses) SearchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchResponse, error) {
-	rsp, err := c.SearchWithBody(ctx, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/mcp/prompt_get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSearchResponse(rsp)
-}
 
-func (c *ClientWithResponses) SearchWithResponse(ctx context.Context, body SearchJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchResponse, error) {
-	rsp, err := c.Search(ctx, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseSearchResponse(rsp)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ListTagsWithBodyWithResponse request with arbitrary body returning *ListTagsResponse
-func (c *ClientWithResponses) ListTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListTagsResponse, error) {
-	rsp, err := c.ListTagsWithBody(ctx, contentType, body, reqEditors...)
+// NewSearchRequest calls the generic Search builder with application/json body
+func NewSearchRequest(server string, body SearchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListTagsResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewSearchRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) ListTagsWithResponse(ctx context.Context, body ListTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*ListTagsResponse, error) {
-	rsp, err := c.ListTags(ctx, body, reqEditors...)
+// NewSearchRequestWithBody generates requests for Search with any type of body
+func NewSearchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListTagsResponse(rsp)
+
+	operationPath := fmt.Sprintf("/mcp/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// CreateTodoWithBodyWithResponse request with arbitrary body returning *CreateTodoResponse
-func (c *ClientWithResponses) CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
-	rsp, err := c.CreateTodoWithBody(ctx, contentType, body, reqEditors...)
+// NewListTagsRequest calls the generic ListTags builder with application/json body
+func NewListTagsRequest(server string, body ListTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTodoResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewListTagsRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
-	rsp, err := c.CreateTodo(ctx, body, reqEditors...)
+// NewListTagsRequestWithBody generates requests for ListTags with any type of body
+func NewListTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTodoResponse(rsp)
+
+	operationPath := fmt.Sprintf("/mcp/tag_list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// DeleteTodoWithBodyWithResponse request with arbitrary body returning *DeleteTodoResponse
-func (c *ClientWithResponses) DeleteTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error) {
-	rsp, err := c.DeleteTodoWithBody(ctx, contentType, body, reqEditors...)
+// NewCreateTodoRequest calls the generic CreateTodo builder with application/json body
+func NewCreateTodoRequest(server string, body CreateTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteTodoResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTodoRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) DeleteTodoWithResponse(ctx context.Context, body DeleteTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error) {
-	rsp, err := c.DeleteTodo(ctx, body, reqEditors...)
+// NewCreateTodoRequestWithBody generates requests for CreateTodo with any type of body
+func NewCreateTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteTodoResponse(rsp)
+
+	operationPath := fmt.Sprintf("/mcp/todo_create")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// ListTodosWithBodyWithResponse request with arbitrary body returning *ListTodosResponse
-func (c *ClientWithResponses) ListTodosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListTodosResponse, error) {
-	rsp, err := c.ListTodosWithBody(ctx, contentType, body, reqEditors...)
+// NewDeleteTodoRequest calls the generic DeleteTodo builder with application/json body
+func NewDeleteTodoRequest(server string, body DeleteTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListTodosResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewDeleteTodoRequestWithBody(server, "application/json", bodyReader)
 }
 
-func (c *ClientWithResponses) ListTodosWithResponse(ctx context.Context, body ListTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*ListTodosResponse, error) {
-	rsp, err := c.ListTodos(ctx, body, reqEditors...)
+// NewDeleteTodoRequestWithBody generates requests for DeleteTodo with any type of body
+func NewDeleteTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListTodosResponse(rsp)
+
+	operationPath := fmt.Sprintf("/mcp/todo_delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// UpdateTodoWithBodyWithResponse request with arbitrary body returning *UpdateTodoResponse
-func (c *ClientWithResponses) UpdateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
-	rsp, err := c.UpdateTodoWithBody(ctx, contentType, body, reqEditors...)
+// NewGetTodoRequest calls the generic GetTodo builder with application/json body
+func NewGetTodoRequest(server string, body GetTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateTodoResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewGetTodoRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewGetTodoRequestWithBody generates requests for GetTodo with any type of body
+func NewGetTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mcp/todo_get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTodosRequest calls the generic ListTodos builder with application/json body
+func NewListTodosRequest(server string, body ListTodosJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewListTodosRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewListTodosRequestWithBody generates requests for ListTodos with any type of body
+func NewListTodosRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mcp/todo_list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUpdateTodoRequest calls the generic UpdateTodo builder with application/json body
+func NewUpdateTodoRequest(server string, body UpdateTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTodoRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateTodoRequestWithBody generates requests for UpdateTodo with any type of body
+func NewUpdateTodoRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/mcp/todo_update")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) UpdateTodoWithResponse(ctx context.Context, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
-	rsp, err := c.UpdateTodo(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// NewListActivityV2Request generates requests for ListActivityV2
+func NewListActivityV2Request(server string, params *ListActivityV2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/activity")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target_type", runtime.ParamLocationQuery, *params.TargetType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TargetId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "target_id", runtime.ParamLocationQuery, *params.TargetId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tool != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tool", runtime.ParamLocationQuery, *params.Tool); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewBatchV2Request calls the generic BatchV2 builder with application/json body
+func NewBatchV2Request(server string, body BatchV2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBatchV2RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewBatchV2RequestWithBody generates requests for BatchV2 with any type of body
+func NewBatchV2RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListMemosV2Request generates requests for ListMemosV2
+func NewListMemosV2Request(server string, params *ListMemosV2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/memos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCreateMemoV2Request calls the generic CreateMemoV2 builder with application/json body
+func NewCreateMemoV2Request(server string, body CreateMemoV2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateMemoV2RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateMemoV2RequestWithBody generates requests for CreateMemoV2 with any type of body
+func NewCreateMemoV2RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/memos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteMemoV2Request generates requests for DeleteMemoV2
+func NewDeleteMemoV2Request(server string, id ItemID, params *DeleteMemoV2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/memos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetMemoV2Request generates requests for GetMemoV2
+func NewGetMemoV2Request(server string, id ItemID, params *GetMemoV2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/memos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateMemoV2Request calls the generic UpdateMemoV2 builder with application/json body
+func NewUpdateMemoV2Request(server string, id ItemID, params *UpdateMemoV2Params, body UpdateMemoV2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMemoV2RequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateMemoV2RequestWithBody generates requests for UpdateMemoV2 with any type of body
+func NewUpdateMemoV2RequestWithBody(server string, id ItemID, params *UpdateMemoV2Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/memos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewSearchV2Request generates requests for SearchV2
+func NewSearchV2Request(server string, params *SearchV2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Q != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListTagsV2Request generates requests for ListTagsV2
+func NewListTagsV2Request(server string, params *ListTagsV2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/tags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListTodosV2Request generates requests for ListTodosV2
+func NewListTodosV2Request(server string, params *ListTodosV2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/todos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Priority != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "priority", runtime.ParamLocationQuery, *params.Priority); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PerPage != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "per_page", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCreateTodoV2Request calls the generic CreateTodoV2 builder with application/json body
+func NewCreateTodoV2Request(server string, body CreateTodoV2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTodoV2RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTodoV2RequestWithBody generates requests for CreateTodoV2 with any type of body
+func NewCreateTodoV2RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/todos")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTodoV2Request generates requests for DeleteTodoV2
+func NewDeleteTodoV2Request(server string, id ItemID, params *DeleteTodoV2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTodoV2Request generates requests for GetTodoV2
+func NewGetTodoV2Request(server string, id ItemID, params *GetTodoV2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateTodoV2Request calls the generic UpdateTodoV2 builder with application/json body
+func NewUpdateTodoV2Request(server string, id ItemID, params *UpdateTodoV2Params, body UpdateTodoV2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTodoV2RequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateTodoV2RequestWithBody generates requests for UpdateTodoV2 with any type of body
+func NewUpdateTodoV2RequestWithBody(server string, id ItemID, params *UpdateTodoV2Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v2/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetJWKSWithResponse request
+	GetJWKSWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJWKSResponse, error)
+
+	// DeleteAccountWithBodyWithResponse request with any body
+	DeleteAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteAccountResponse, error)
+
+	DeleteAccountWithResponse(ctx context.Context, body DeleteAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteAccountResponse, error)
+
+	// PollDeviceAuthWithBodyWithResponse request with any body
+	PollDeviceAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PollDeviceAuthResponse, error)
+
+	PollDeviceAuthWithResponse(ctx context.Context, body PollDeviceAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PollDeviceAuthResponse, error)
+
+	// StartDeviceAuthWithBodyWithResponse request with any body
+	StartDeviceAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartDeviceAuthResponse, error)
+
+	StartDeviceAuthWithResponse(ctx context.Context, body StartDeviceAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*StartDeviceAuthResponse, error)
+
+	// ListIdentitiesWithResponse request
+	ListIdentitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListIdentitiesResponse, error)
+
+	// UnlinkIdentityWithResponse request
+	UnlinkIdentityWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UnlinkIdentityResponse, error)
+
+	// LogoutWithBodyWithResponse request with any body
+	LogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogoutResponse, error)
+
+	LogoutWithResponse(ctx context.Context, body LogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*LogoutResponse, error)
+
+	// LogoutAllWithResponse request
+	LogoutAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutAllResponse, error)
+
+	// RefreshAuthWithBodyWithResponse request with any body
+	RefreshAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshAuthResponse, error)
+
+	RefreshAuthWithResponse(ctx context.Context, body RefreshAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshAuthResponse, error)
+
+	// ListSessionsWithResponse request
+	ListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSessionsResponse, error)
+
+	// ListAccessTokensWithResponse request
+	ListAccessTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAccessTokensResponse, error)
+
+	// CreateAccessTokenWithBodyWithResponse request with any body
+	CreateAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAccessTokenResponse, error)
+
+	CreateAccessTokenWithResponse(ctx context.Context, body CreateAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAccessTokenResponse, error)
+
+	// RevokeAccessTokenWithResponse request
+	RevokeAccessTokenWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RevokeAccessTokenResponse, error)
+
+	// GetUserInfoWithResponse request
+	GetUserInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserInfoResponse, error)
+
+	// HealthCheckWithResponse request
+	HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error)
+
+	// WaitChangesWithBodyWithResponse request with any body
+	WaitChangesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WaitChangesResponse, error)
+
+	WaitChangesWithResponse(ctx context.Context, body WaitChangesJSONRequestBody, reqEditors ...RequestEditorFn) (*WaitChangesResponse, error)
+
+	// CompleteArgumentWithBodyWithResponse request with any body
+	CompleteArgumentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteArgumentResponse, error)
+
+	CompleteArgumentWithResponse(ctx context.Context, body CompleteArgumentJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteArgumentResponse, error)
+
+	// CreateMemoWithBodyWithResponse request with any body
+	CreateMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMemoResponse, error)
+
+	CreateMemoWithResponse(ctx context.Context, body CreateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMemoResponse, error)
+
+	// DeleteMemoWithBodyWithResponse request with any body
+	DeleteMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMemoResponse, error)
+
+	DeleteMemoWithResponse(ctx context.Context, body DeleteMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMemoResponse, error)
+
+	// GetMemoWithBodyWithResponse request with any body
+	GetMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetMemoResponse, error)
+
+	GetMemoWithResponse(ctx context.Context, body GetMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*GetMemoResponse, error)
+
+	// ListMemosWithBodyWithResponse request with any body
+	ListMemosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListMemosResponse, error)
+
+	ListMemosWithResponse(ctx context.Context, body ListMemosJSONRequestBody, reqEditors ...RequestEditorFn) (*ListMemosResponse, error)
+
+	// UpdateMemoWithBodyWithResponse request with any body
+	UpdateMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMemoResponse, error)
+
+	UpdateMemoWithResponse(ctx context.Context, body UpdateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMemoResponse, error)
+
+	// GetPromptWithBodyWithResponse request with any body
+	GetPromptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetPromptResponse, error)
+
+	GetPromptWithResponse(ctx context.Context, body GetPromptJSONRequestBody, reqEditors ...RequestEditorFn) (*GetPromptResponse, error)
+
+	// SearchWithBodyWithResponse request with any body
+	SearchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchResponse, error)
+
+	SearchWithResponse(ctx context.Context, body SearchJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchResponse, error)
+
+	// ListTagsWithBodyWithResponse request with any body
+	ListTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListTagsResponse, error)
+
+	ListTagsWithResponse(ctx context.Context, body ListTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*ListTagsResponse, error)
+
+	// CreateTodoWithBodyWithResponse request with any body
+	CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+
+	CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+
+	// DeleteTodoWithBodyWithResponse request with any body
+	DeleteTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error)
+
+	DeleteTodoWithResponse(ctx context.Context, body DeleteTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error)
+
+	// GetTodoWithBodyWithResponse request with any body
+	GetTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetTodoResponse, error)
+
+	GetTodoWithResponse(ctx context.Context, body GetTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*GetTodoResponse, error)
+
+	// ListTodosWithBodyWithResponse request with any body
+	ListTodosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListTodosResponse, error)
+
+	ListTodosWithResponse(ctx context.Context, body ListTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*ListTodosResponse, error)
+
+	// UpdateTodoWithBodyWithResponse request with any body
+	UpdateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+
+	UpdateTodoWithResponse(ctx context.Context, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+
+	// ListActivityV2WithResponse request
+	ListActivityV2WithResponse(ctx context.Context, params *ListActivityV2Params, reqEditors ...RequestEditorFn) (*ListActivityV2Response, error)
+
+	// BatchV2WithBodyWithResponse request with any body
+	BatchV2WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchV2Response, error)
+
+	BatchV2WithResponse(ctx context.Context, body BatchV2JSONRequestBody, reqEditors ...RequestEditorFn) (*BatchV2Response, error)
+
+	// ListMemosV2WithResponse request
+	ListMemosV2WithResponse(ctx context.Context, params *ListMemosV2Params, reqEditors ...RequestEditorFn) (*ListMemosV2Response, error)
+
+	// CreateMemoV2WithBodyWithResponse request with any body
+	CreateMemoV2WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMemoV2Response, error)
+
+	CreateMemoV2WithResponse(ctx context.Context, body CreateMemoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMemoV2Response, error)
+
+	// DeleteMemoV2WithResponse request
+	DeleteMemoV2WithResponse(ctx context.Context, id ItemID, params *DeleteMemoV2Params, reqEditors ...RequestEditorFn) (*DeleteMemoV2Response, error)
+
+	// GetMemoV2WithResponse request
+	GetMemoV2WithResponse(ctx context.Context, id ItemID, params *GetMemoV2Params, reqEditors ...RequestEditorFn) (*GetMemoV2Response, error)
+
+	// UpdateMemoV2WithBodyWithResponse request with any body
+	UpdateMemoV2WithBodyWithResponse(ctx context.Context, id ItemID, params *UpdateMemoV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMemoV2Response, error)
+
+	UpdateMemoV2WithResponse(ctx context.Context, id ItemID, params *UpdateMemoV2Params, body UpdateMemoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMemoV2Response, error)
+
+	// SearchV2WithResponse request
+	SearchV2WithResponse(ctx context.Context, params *SearchV2Params, reqEditors ...RequestEditorFn) (*SearchV2Response, error)
+
+	// ListTagsV2WithResponse request
+	ListTagsV2WithResponse(ctx context.Context, params *ListTagsV2Params, reqEditors ...RequestEditorFn) (*ListTagsV2Response, error)
+
+	// ListTodosV2WithResponse request
+	ListTodosV2WithResponse(ctx context.Context, params *ListTodosV2Params, reqEditors ...RequestEditorFn) (*ListTodosV2Response, error)
+
+	// CreateTodoV2WithBodyWithResponse request with any body
+	CreateTodoV2WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoV2Response, error)
+
+	CreateTodoV2WithResponse(ctx context.Context, body CreateTodoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoV2Response, error)
+
+	// DeleteTodoV2WithResponse request
+	DeleteTodoV2WithResponse(ctx context.Context, id ItemID, params *DeleteTodoV2Params, reqEditors ...RequestEditorFn) (*DeleteTodoV2Response, error)
+
+	// GetTodoV2WithResponse request
+	GetTodoV2WithResponse(ctx context.Context, id ItemID, params *GetTodoV2Params, reqEditors ...RequestEditorFn) (*GetTodoV2Response, error)
+
+	// UpdateTodoV2WithBodyWithResponse request with any body
+	UpdateTodoV2WithBodyWithResponse(ctx context.Context, id ItemID, params *UpdateTodoV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoV2Response, error)
+
+	UpdateTodoV2WithResponse(ctx context.Context, id ItemID, params *UpdateTodoV2Params, body UpdateTodoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoV2Response, error)
+}
+
+type GetJWKSResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKS
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJWKSResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJWKSResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAccountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AccountDeleteResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAccountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAccountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PollDeviceAuthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeviceAuthPollResponse
+	JSON400      *Error
+	JSON403      *Error
+	JSON404      *NotFound
+	JSON409      *Error
+	JSON410      *Error
+	JSON429      *Error
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PollDeviceAuthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PollDeviceAuthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartDeviceAuthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeviceAuthStartResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r StartDeviceAuthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartDeviceAuthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListIdentitiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IdentityListResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListIdentitiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListIdentitiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnlinkIdentityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IdentityUnlinkResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UnlinkIdentityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnlinkIdentityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SessionRevokeResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogoutAllResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SessionRevokeResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutAllResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutAllResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshAuthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RefreshResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshAuthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshAuthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SessionListResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAccessTokensResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokenListResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAccessTokensResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAccessTokensResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAccessTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokenCreateResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAccessTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAccessTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RevokeAccessTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokenRevokeResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r RevokeAccessTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RevokeAccessTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserInfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserInfoResponse
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserInfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserInfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthCheckResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status    string    `json:"status"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthCheckResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthCheckResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WaitChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ChangesResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r WaitChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WaitChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteArgumentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CompleteResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteArgumentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteArgumentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMemoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemoCreateResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMemoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMemoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMemoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemoDeleteResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMemoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMemoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMemoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemoGetResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMemoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMemoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMemosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemoListResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMemosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMemosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMemoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MemoUpdateResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMemoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMemoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPromptResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PromptGetResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPromptResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPromptResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchResult
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TagListResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoCreateResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoDeleteResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoGetResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTodosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoListResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TodoUpdateResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListActivityV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AuditEvent
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListActivityV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListActivityV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BatchV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchResponse
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r BatchV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BatchV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMemosV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Memo
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMemosV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMemosV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateMemoV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Memo
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateMemoV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateMemoV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMemoV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON412      *PreconditionFailed
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMemoV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMemoV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMemoV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Memo
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMemoV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMemoV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMemoV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Memo
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON412      *PreconditionFailed
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMemoV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMemoV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchResults
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTagsV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTagsV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTagsV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTodosV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Todo
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTodosV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTodosV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTodoV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Todo
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTodoV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTodoV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTodoV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON412      *PreconditionFailed
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTodoV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTodoV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Todo
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTodoV2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Todo
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON412      *PreconditionFailed
+	JSON429      *RateLimited
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTodoV2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTodoV2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetJWKSWithResponse request returning *GetJWKSResponse
+func (c *ClientWithResponses) GetJWKSWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetJWKSResponse, error) {
+	rsp, err := c.GetJWKS(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJWKSResponse(rsp)
+}
+
+// DeleteAccountWithBodyWithResponse request with arbitrary body returning *DeleteAccountResponse
+func (c *ClientWithResponses) DeleteAccountWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteAccountResponse, error) {
+	rsp, err := c.DeleteAccountWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteAccountWithResponse(ctx context.Context, body DeleteAccountJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteAccountResponse, error) {
+	rsp, err := c.DeleteAccount(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountResponse(rsp)
+}
+
+// PollDeviceAuthWithBodyWithResponse request with arbitrary body returning *PollDeviceAuthResponse
+func (c *ClientWithResponses) PollDeviceAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PollDeviceAuthResponse, error) {
+	rsp, err := c.PollDeviceAuthWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePollDeviceAuthResponse(rsp)
+}
+
+func (c *ClientWithResponses) PollDeviceAuthWithResponse(ctx context.Context, body PollDeviceAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*PollDeviceAuthResponse, error) {
+	rsp, err := c.PollDeviceAuth(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePollDeviceAuthResponse(rsp)
+}
+
+// StartDeviceAuthWithBodyWithResponse request with arbitrary body returning *StartDeviceAuthResponse
+func (c *ClientWithResponses) StartDeviceAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartDeviceAuthResponse, error) {
+	rsp, err := c.StartDeviceAuthWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartDeviceAuthResponse(rsp)
+}
+
+func (c *ClientWithResponses) StartDeviceAuthWithResponse(ctx context.Context, body StartDeviceAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*StartDeviceAuthResponse, error) {
+	rsp, err := c.StartDeviceAuth(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartDeviceAuthResponse(rsp)
+}
+
+// ListIdentitiesWithResponse request returning *ListIdentitiesResponse
+func (c *ClientWithResponses) ListIdentitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListIdentitiesResponse, error) {
+	rsp, err := c.ListIdentities(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListIdentitiesResponse(rsp)
+}
+
+// UnlinkIdentityWithResponse request returning *UnlinkIdentityResponse
+func (c *ClientWithResponses) UnlinkIdentityWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UnlinkIdentityResponse, error) {
+	rsp, err := c.UnlinkIdentity(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnlinkIdentityResponse(rsp)
+}
+
+// LogoutWithBodyWithResponse request with arbitrary body returning *LogoutResponse
+func (c *ClientWithResponses) LogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogoutResponse, error) {
+	rsp, err := c.LogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) LogoutWithResponse(ctx context.Context, body LogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*LogoutResponse, error) {
+	rsp, err := c.Logout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutResponse(rsp)
+}
+
+// LogoutAllWithResponse request returning *LogoutAllResponse
+func (c *ClientWithResponses) LogoutAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutAllResponse, error) {
+	rsp, err := c.LogoutAll(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutAllResponse(rsp)
+}
+
+// RefreshAuthWithBodyWithResponse request with arbitrary body returning *RefreshAuthResponse
+func (c *ClientWithResponses) RefreshAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshAuthResponse, error) {
+	rsp, err := c.RefreshAuthWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshAuthResponse(rsp)
+}
+
+func (c *ClientWithResponses) RefreshAuthWithResponse(ctx context.Context, body RefreshAuthJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshAuthResponse, error) {
+	rsp, err := c.RefreshAuth(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshAuthResponse(rsp)
+}
+
+// ListSessionsWithResponse request returning *ListSessionsResponse
+func (c *ClientWithResponses) ListSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListSessionsResponse, error) {
+	rsp, err := c.ListSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSessionsResponse(rsp)
+}
+
+// ListAccessTokensWithResponse request returning *ListAccessTokensResponse
+func (c *ClientWithResponses) ListAccessTokensWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListAccessTokensResponse, error) {
+	rsp, err := c.ListAccessTokens(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAccessTokensResponse(rsp)
+}
+
+// CreateAccessTokenWithBodyWithResponse request with arbitrary body returning *CreateAccessTokenResponse
+func (c *ClientWithResponses) CreateAccessTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAccessTokenResponse, error) {
+	rsp, err := c.CreateAccessTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAccessTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAccessTokenWithResponse(ctx context.Context, body CreateAccessTokenJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAccessTokenResponse, error) {
+	rsp, err := c.CreateAccessToken(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAccessTokenResponse(rsp)
+}
+
+// RevokeAccessTokenWithResponse request returning *RevokeAccessTokenResponse
+func (c *ClientWithResponses) RevokeAccessTokenWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*RevokeAccessTokenResponse, error) {
+	rsp, err := c.RevokeAccessToken(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRevokeAccessTokenResponse(rsp)
+}
+
+// GetUserInfoWithResponse request returning *GetUserInfoResponse
+func (c *ClientWithResponses) GetUserInfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserInfoResponse, error) {
+	rsp, err := c.GetUserInfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserInfoResponse(rsp)
+}
+
+// HealthCheckWithResponse request returning *HealthCheckResponse
+func (c *ClientWithResponses) HealthCheckWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthCheckResponse, error) {
+	rsp, err := c.HealthCheck(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthCheckResponse(rsp)
+}
+
+// WaitChangesWithBodyWithResponse request with arbitrary body returning *WaitChangesResponse
+func (c *ClientWithResponses) WaitChangesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WaitChangesResponse, error) {
+	rsp, err := c.WaitChangesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWaitChangesResponse(rsp)
+}
+
+func (c *ClientWithResponses) WaitChangesWithResponse(ctx context.Context, body WaitChangesJSONRequestBody, reqEditors ...RequestEditorFn) (*WaitChangesResponse, error) {
+	rsp, err := c.WaitChanges(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWaitChangesResponse(rsp)
+}
+
+// CompleteArgumentWithBodyWithResponse request with arbitrary body returning *CompleteArgumentResponse
+func (c *ClientWithResponses) CompleteArgumentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CompleteArgumentResponse, error) {
+	rsp, err := c.CompleteArgumentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteArgumentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CompleteArgumentWithResponse(ctx context.Context, body CompleteArgumentJSONRequestBody, reqEditors ...RequestEditorFn) (*CompleteArgumentResponse, error) {
+	rsp, err := c.CompleteArgument(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteArgumentResponse(rsp)
+}
+
+// CreateMemoWithBodyWithResponse request with arbitrary body returning *CreateMemoResponse
+func (c *ClientWithResponses) CreateMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMemoResponse, error) {
+	rsp, err := c.CreateMemoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMemoResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateMemoWithResponse(ctx context.Context, body CreateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMemoResponse, error) {
+	rsp, err := c.CreateMemo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMemoResponse(rsp)
+}
+
+// DeleteMemoWithBodyWithResponse request with arbitrary body returning *DeleteMemoResponse
+func (c *ClientWithResponses) DeleteMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteMemoResponse, error) {
+	rsp, err := c.DeleteMemoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMemoResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteMemoWithResponse(ctx context.Context, body DeleteMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteMemoResponse, error) {
+	rsp, err := c.DeleteMemo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMemoResponse(rsp)
+}
+
+// GetMemoWithBodyWithResponse request with arbitrary body returning *GetMemoResponse
+func (c *ClientWithResponses) GetMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetMemoResponse, error) {
+	rsp, err := c.GetMemoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMemoResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetMemoWithResponse(ctx context.Context, body GetMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*GetMemoResponse, error) {
+	rsp, err := c.GetMemo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMemoResponse(rsp)
+}
+
+// ListMemosWithBodyWithResponse request with arbitrary body returning *ListMemosResponse
+func (c *ClientWithResponses) ListMemosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListMemosResponse, error) {
+	rsp, err := c.ListMemosWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMemosResponse(rsp)
+}
+
+func (c *ClientWithResponses) ListMemosWithResponse(ctx context.Context, body ListMemosJSONRequestBody, reqEditors ...RequestEditorFn) (*ListMemosResponse, error) {
+	rsp, err := c.ListMemos(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMemosResponse(rsp)
+}
+
+// UpdateMemoWithBodyWithResponse request with arbitrary body returning *UpdateMemoResponse
+func (c *ClientWithResponses) UpdateMemoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMemoResponse, error) {
+	rsp, err := c.UpdateMemoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMemoResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMemoWithResponse(ctx context.Context, body UpdateMemoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMemoResponse, error) {
+	rsp, err := c.UpdateMemo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMemoResponse(rsp)
+}
+
+// GetPromptWithBodyWithResponse request with arbitrary body returning *GetPromptResponse
+func (c *ClientWithResponses) GetPromptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetPromptResponse, error) {
+	rsp, err := c.GetPromptWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPromptResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetPromptWithResponse(ctx context.Context, body GetPromptJSONRequestBody, reqEditors ...RequestEditorFn) (*GetPromptResponse, error) {
+	rsp, err := c.GetPrompt(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPromptResponse(rsp)
+}
+
+// SearchWithBodyWithResponse request with arbitrary body returning *SearchResponse
+func (c *ClientWithResponses) SearchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SearchResponse, error) {
+	rsp, err := c.SearchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchResponse(rsp)
+}
+
+func (c *ClientWithResponses) SearchWithResponse(ctx context.Context, body SearchJSONRequestBody, reqEditors ...RequestEditorFn) (*SearchResponse, error) {
+	rsp, err := c.Search(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchResponse(rsp)
+}
+
+// ListTagsWithBodyWithResponse request with arbitrary body returning *ListTagsResponse
+func (c *ClientWithResponses) ListTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListTagsResponse, error) {
+	rsp, err := c.ListTagsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTagsResponse(rsp)
+}
+
+func (c *ClientWithResponses) ListTagsWithResponse(ctx context.Context, body ListTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*ListTagsResponse, error) {
+	rsp, err := c.ListTags(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTagsResponse(rsp)
+}
+
+// CreateTodoWithBodyWithResponse request with arbitrary body returning *CreateTodoResponse
+func (c *ClientWithResponses) CreateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
+	rsp, err := c.CreateTodoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTodoWithResponse(ctx context.Context, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
+	rsp, err := c.CreateTodo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoResponse(rsp)
+}
+
+// DeleteTodoWithBodyWithResponse request with arbitrary body returning *DeleteTodoResponse
+func (c *ClientWithResponses) DeleteTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error) {
+	rsp, err := c.DeleteTodoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) DeleteTodoWithResponse(ctx context.Context, body DeleteTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*DeleteTodoResponse, error) {
+	rsp, err := c.DeleteTodo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTodoResponse(rsp)
+}
+
+// GetTodoWithBodyWithResponse request with arbitrary body returning *GetTodoResponse
+func (c *ClientWithResponses) GetTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*GetTodoResponse, error) {
+	rsp, err := c.GetTodoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) GetTodoWithResponse(ctx context.Context, body GetTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*GetTodoResponse, error) {
+	rsp, err := c.GetTodo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoResponse(rsp)
+}
+
+// ListTodosWithBodyWithResponse request with arbitrary body returning *ListTodosResponse
+func (c *ClientWithResponses) ListTodosWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ListTodosResponse, error) {
+	rsp, err := c.ListTodosWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTodosResponse(rsp)
+}
+
+func (c *ClientWithResponses) ListTodosWithResponse(ctx context.Context, body ListTodosJSONRequestBody, reqEditors ...RequestEditorFn) (*ListTodosResponse, error) {
+	rsp, err := c.ListTodos(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTodosResponse(rsp)
+}
+
+// UpdateTodoWithBodyWithResponse request with arbitrary body returning *UpdateTodoResponse
+func (c *ClientWithResponses) UpdateTodoWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
+	rsp, err := c.UpdateTodoWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTodoWithResponse(ctx context.Context, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
+	rsp, err := c.UpdateTodo(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoResponse(rsp)
+}
+
+// ListActivityV2WithResponse request returning *ListActivityV2Response
+func (c *ClientWithResponses) ListActivityV2WithResponse(ctx context.Context, params *ListActivityV2Params, reqEditors ...RequestEditorFn) (*ListActivityV2Response, error) {
+	rsp, err := c.ListActivityV2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListActivityV2Response(rsp)
+}
+
+// BatchV2WithBodyWithResponse request with arbitrary body returning *BatchV2Response
+func (c *ClientWithResponses) BatchV2WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BatchV2Response, error) {
+	rsp, err := c.BatchV2WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchV2Response(rsp)
+}
+
+func (c *ClientWithResponses) BatchV2WithResponse(ctx context.Context, body BatchV2JSONRequestBody, reqEditors ...RequestEditorFn) (*BatchV2Response, error) {
+	rsp, err := c.BatchV2(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBatchV2Response(rsp)
+}
+
+// ListMemosV2WithResponse request returning *ListMemosV2Response
+func (c *ClientWithResponses) ListMemosV2WithResponse(ctx context.Context, params *ListMemosV2Params, reqEditors ...RequestEditorFn) (*ListMemosV2Response, error) {
+	rsp, err := c.ListMemosV2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMemosV2Response(rsp)
+}
+
+// CreateMemoV2WithBodyWithResponse request with arbitrary body returning *CreateMemoV2Response
+func (c *ClientWithResponses) CreateMemoV2WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateMemoV2Response, error) {
+	rsp, err := c.CreateMemoV2WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMemoV2Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateMemoV2WithResponse(ctx context.Context, body CreateMemoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateMemoV2Response, error) {
+	rsp, err := c.CreateMemoV2(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateMemoV2Response(rsp)
+}
+
+// DeleteMemoV2WithResponse request returning *DeleteMemoV2Response
+func (c *ClientWithResponses) DeleteMemoV2WithResponse(ctx context.Context, id ItemID, params *DeleteMemoV2Params, reqEditors ...RequestEditorFn) (*DeleteMemoV2Response, error) {
+	rsp, err := c.DeleteMemoV2(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMemoV2Response(rsp)
+}
+
+// GetMemoV2WithResponse request returning *GetMemoV2Response
+func (c *ClientWithResponses) GetMemoV2WithResponse(ctx context.Context, id ItemID, params *GetMemoV2Params, reqEditors ...RequestEditorFn) (*GetMemoV2Response, error) {
+	rsp, err := c.GetMemoV2(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMemoV2Response(rsp)
+}
+
+// UpdateMemoV2WithBodyWithResponse request with arbitrary body returning *UpdateMemoV2Response
+func (c *ClientWithResponses) UpdateMemoV2WithBodyWithResponse(ctx context.Context, id ItemID, params *UpdateMemoV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMemoV2Response, error) {
+	rsp, err := c.UpdateMemoV2WithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMemoV2Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMemoV2WithResponse(ctx context.Context, id ItemID, params *UpdateMemoV2Params, body UpdateMemoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMemoV2Response, error) {
+	rsp, err := c.UpdateMemoV2(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMemoV2Response(rsp)
+}
+
+// SearchV2WithResponse request returning *SearchV2Response
+func (c *ClientWithResponses) SearchV2WithResponse(ctx context.Context, params *SearchV2Params, reqEditors ...RequestEditorFn) (*SearchV2Response, error) {
+	rsp, err := c.SearchV2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchV2Response(rsp)
+}
+
+// ListTagsV2WithResponse request returning *ListTagsV2Response
+func (c *ClientWithResponses) ListTagsV2WithResponse(ctx context.Context, params *ListTagsV2Params, reqEditors ...RequestEditorFn) (*ListTagsV2Response, error) {
+	rsp, err := c.ListTagsV2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTagsV2Response(rsp)
+}
+
+// ListTodosV2WithResponse request returning *ListTodosV2Response
+func (c *ClientWithResponses) ListTodosV2WithResponse(ctx context.Context, params *ListTodosV2Params, reqEditors ...RequestEditorFn) (*ListTodosV2Response, error) {
+	rsp, err := c.ListTodosV2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTodosV2Response(rsp)
+}
+
+// CreateTodoV2WithBodyWithResponse request with arbitrary body returning *CreateTodoV2Response
+func (c *ClientWithResponses) CreateTodoV2WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoV2Response, error) {
+	rsp, err := c.CreateTodoV2WithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoV2Response(rsp)
+}
+
+func (c *ClientWithResponses) CreateTodoV2WithResponse(ctx context.Context, body CreateTodoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoV2Response, error) {
+	rsp, err := c.CreateTodoV2(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoV2Response(rsp)
+}
+
+// DeleteTodoV2WithResponse request returning *DeleteTodoV2Response
+func (c *ClientWithResponses) DeleteTodoV2WithResponse(ctx context.Context, id ItemID, params *DeleteTodoV2Params, reqEditors ...RequestEditorFn) (*DeleteTodoV2Response, error) {
+	rsp, err := c.DeleteTodoV2(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTodoV2Response(rsp)
+}
+
+// GetTodoV2WithResponse request returning *GetTodoV2Response
+func (c *ClientWithResponses) GetTodoV2WithResponse(ctx context.Context, id ItemID, params *GetTodoV2Params, reqEditors ...RequestEditorFn) (*GetTodoV2Response, error) {
+	rsp, err := c.GetTodoV2(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoV2Response(rsp)
+}
+
+// UpdateTodoV2WithBodyWithResponse request with arbitrary body returning *UpdateTodoV2Response
+func (c *ClientWithResponses) UpdateTodoV2WithBodyWithResponse(ctx context.Context, id ItemID, params *UpdateTodoV2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoV2Response, error) {
+	rsp, err := c.UpdateTodoV2WithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoV2Response(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTodoV2WithResponse(ctx context.Context, id ItemID, params *UpdateTodoV2Params, body UpdateTodoV2JSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoV2Response, error) {
+	rsp, err := c.UpdateTodoV2(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoV2Response(rsp)
+}
+
+// ParseGetJWKSResponse parses an HTTP response from a GetJWKSWithResponse call
+func ParseGetJWKSResponse(rsp *http.Response) (*GetJWKSResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJWKSResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKS
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAccountResponse parses an HTTP response from a DeleteAccountWithResponse call
+func ParseDeleteAccountResponse(rsp *http.Response) (*DeleteAccountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAccountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AccountDeleteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePollDeviceAuthResponse parses an HTTP response from a PollDeviceAuthWithResponse call
+func ParsePollDeviceAuthResponse(rsp *http.Response) (*PollDeviceAuthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PollDeviceAuthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeviceAuthPollResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 410:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON410 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartDeviceAuthResponse parses an HTTP response from a StartDeviceAuthWithResponse call
+func ParseStartDeviceAuthResponse(rsp *http.Response) (*StartDeviceAuthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartDeviceAuthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeviceAuthStartResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListIdentitiesResponse parses an HTTP response from a ListIdentitiesWithResponse call
+func ParseListIdentitiesResponse(rsp *http.Response) (*ListIdentitiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListIdentitiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IdentityListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUnlinkIdentityResponse parses an HTTP response from a UnlinkIdentityWithResponse call
+func ParseUnlinkIdentityResponse(rsp *http.Response) (*UnlinkIdentityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UnlinkIdentityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IdentityUnlinkResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogoutResponse parses an HTTP response from a LogoutWithResponse call
+func ParseLogoutResponse(rsp *http.Response) (*LogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SessionRevokeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogoutAllResponse parses an HTTP response from a LogoutAllWithResponse call
+func ParseLogoutAllResponse(rsp *http.Response) (*LogoutAllResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogoutAllResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SessionRevokeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshAuthResponse parses an HTTP response from a RefreshAuthWithResponse call
+func ParseRefreshAuthResponse(rsp *http.Response) (*RefreshAuthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshAuthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RefreshResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListSessionsResponse parses an HTTP response from a ListSessionsWithResponse call
+func ParseListSessionsResponse(rsp *http.Response) (*ListSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SessionListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAccessTokensResponse parses an HTTP response from a ListAccessTokensWithResponse call
+func ParseListAccessTokensResponse(rsp *http.Response) (*ListAccessTokensResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAccessTokensResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAccessTokenResponse parses an HTTP response from a CreateAccessTokenWithResponse call
+func ParseCreateAccessTokenResponse(rsp *http.Response) (*CreateAccessTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAccessTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenCreateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRevokeAccessTokenResponse parses an HTTP response from a RevokeAccessTokenWithResponse call
+func ParseRevokeAccessTokenResponse(rsp *http.Response) (*RevokeAccessTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RevokeAccessTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenRevokeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserInfoResponse parses an HTTP response from a GetUserInfoWithResponse call
+func ParseGetUserInfoResponse(rsp *http.Response) (*GetUserInfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserInfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserInfoResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
+func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &HealthCheckResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status    string    `json:"status"`
+			Timestamp time.Time `json:"timestamp"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWaitChangesResponse parses an HTTP response from a WaitChangesWithResponse call
+func ParseWaitChangesResponse(rsp *http.Response) (*WaitChangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WaitChangesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ChangesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCompleteArgumentResponse parses an HTTP response from a CompleteArgumentWithResponse call
+func ParseCompleteArgumentResponse(rsp *http.Response) (*CompleteArgumentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CompleteArgumentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CompleteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateMemoResponse parses an HTTP response from a CreateMemoWithResponse call
+func ParseCreateMemoResponse(rsp *http.Response) (*CreateMemoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateMemoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemoCreateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteMemoResponse parses an HTTP response from a DeleteMemoWithResponse call
+func ParseDeleteMemoResponse(rsp *http.Response) (*DeleteMemoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteMemoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemoDeleteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetMemoResponse parses an HTTP response from a GetMemoWithResponse call
+func ParseGetMemoResponse(rsp *http.Response) (*GetMemoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMemoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemoGetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListMemosResponse parses an HTTP response from a ListMemosWithResponse call
+func ParseListMemosResponse(rsp *http.Response) (*ListMemosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListMemosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemoListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateMemoResponse parses an HTTP response from a UpdateMemoWithResponse call
+func ParseUpdateMemoResponse(rsp *http.Response) (*UpdateMemoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateMemoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MemoUpdateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPromptResponse parses an HTTP response from a GetPromptWithResponse call
+func ParseGetPromptResponse(rsp *http.Response) (*GetPromptResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPromptResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PromptGetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchResponse parses an HTTP response from a SearchWithResponse call
+func ParseSearchResponse(rsp *http.Response) (*SearchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTagsResponse parses an HTTP response from a ListTagsWithResponse call
+func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTodoResponse parses an HTTP response from a CreateTodoWithResponse call
+func ParseCreateTodoResponse(rsp *http.Response) (*CreateTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoCreateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteTodoResponse parses an HTTP response from a DeleteTodoWithResponse call
+func ParseDeleteTodoResponse(rsp *http.Response) (*DeleteTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoDeleteResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTodoResponse parses an HTTP response from a GetTodoWithResponse call
+func ParseGetTodoResponse(rsp *http.Response) (*GetTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoGetResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListTodosResponse parses an HTTP response from a ListTodosWithResponse call
+func ParseListTodosResponse(rsp *http.Response) (*ListTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoListResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTodoResponse parses an HTTP response from a UpdateTodoWithResponse call
+func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TodoUpdateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
 	}
-	return ParseUpdateTodoResponse(rsp)
+
+	return response, nil
 }
 
-// ParseDeleteAccountResponse parses an HTTP response from a DeleteAccountWithResponse call
-func ParseDeleteAccountResponse(rsp *http.Response) (*DeleteAccountResponse, error) {
+// ParseListActivityV2Response parses an HTTP response from a ListActivityV2WithResponse call
+func ParseListActivityV2Response(rsp *http.Response) (*ListActivityV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAccountResponse{
+	response := &ListActivityV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AccountDeleteResponse
+		var dest []AuditEvent
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2299,6 +8236,13 @@ func ParseDeleteAccountResponse(rsp *http.Response) (*DeleteAccountResponse, err
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2311,22 +8255,22 @@ func ParseDeleteAccountResponse(rsp *http.Response) (*DeleteAccountResponse, err
 	return response, nil
 }
 
-// ParsePollDeviceAuthResponse parses an HTTP response from a PollDeviceAuthWithResponse call
-func ParsePollDeviceAuthResponse(rsp *http.Response) (*PollDeviceAuthResponse, error) {
+// ParseBatchV2Response parses an HTTP response from a BatchV2WithResponse call
+func ParseBatchV2Response(rsp *http.Response) (*BatchV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PollDeviceAuthResponse{
+	response := &BatchV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeviceAuthPollResponse
+		var dest BatchResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2339,6 +8283,27 @@ func ParsePollDeviceAuthResponse(rsp *http.Response) (*PollDeviceAuthResponse, e
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2351,22 +8316,22 @@ func ParsePollDeviceAuthResponse(rsp *http.Response) (*PollDeviceAuthResponse, e
 	return response, nil
 }
 
-// ParseStartDeviceAuthResponse parses an HTTP response from a StartDeviceAuthWithResponse call
-func ParseStartDeviceAuthResponse(rsp *http.Response) (*StartDeviceAuthResponse, error) {
+// ParseListMemosV2Response parses an HTTP response from a ListMemosV2WithResponse call
+func ParseListMemosV2Response(rsp *http.Response) (*ListMemosV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StartDeviceAuthResponse{
+	response := &ListMemosV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeviceAuthStartResponse
+		var dest []Memo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2379,6 +8344,20 @@ func ParseStartDeviceAuthResponse(rsp *http.Response) (*StartDeviceAuthResponse,
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2391,26 +8370,33 @@ func ParseStartDeviceAuthResponse(rsp *http.Response) (*StartDeviceAuthResponse,
 	return response, nil
 }
 
-// ParseGetUserInfoResponse parses an HTTP response from a GetUserInfoWithResponse call
-func ParseGetUserInfoResponse(rsp *http.Response) (*GetUserInfoResponse, error) {
+// ParseCreateMemoV2Response parses an HTTP response from a CreateMemoV2WithResponse call
+func ParseCreateMemoV2Response(rsp *http.Response) (*CreateMemoV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUserInfoResponse{
+	response := &CreateMemoV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UserInfoResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Memo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
@@ -2419,6 +8405,13 @@ func ParseGetUserInfoResponse(rsp *http.Response) (*GetUserInfoResponse, error)
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2431,69 +8424,54 @@ func ParseGetUserInfoResponse(rsp *http.Response) (*GetUserInfoResponse, error)
 	return response, nil
 }
 
-// ParseHealthCheckResponse parses an HTTP response from a HealthCheckWithResponse call
-func ParseHealthCheckResponse(rsp *http.Response) (*HealthCheckResponse, error) {
+// ParseDeleteMemoV2Response parses an HTTP response from a DeleteMemoV2WithResponse call
+func ParseDeleteMemoV2Response(rsp *http.Response) (*DeleteMemoV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &HealthCheckResponse{
+	response := &DeleteMemoV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest struct {
-			Status    string    `json:"status"`
-			Timestamp time.Time `json:"timestamp"`
-		}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
-
-	}
-
-	return response, nil
-}
-
-// ParseCreateMemoResponse parses an HTTP response from a CreateMemoWithResponse call
-func ParseCreateMemoResponse(rsp *http.Response) (*CreateMemoResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+		response.JSON401 = &dest
 
-	response := &CreateMemoResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MemoCreateResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON412 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON429 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -2507,40 +8485,40 @@ func ParseCreateMemoResponse(rsp *http.Response) (*CreateMemoResponse, error) {
 	return response, nil
 }
 
-// ParseDeleteMemoResponse parses an HTTP response from a DeleteMemoWithResponse call
-func ParseDeleteMemoResponse(rsp *http.Response) (*DeleteMemoResponse, error) {
+// ParseGetMemoV2Response parses an HTTP response from a GetMemoV2WithResponse call
+func ParseGetMemoV2Response(rsp *http.Response) (*GetMemoV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteMemoResponse{
+	response := &GetMemoV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MemoDeleteResponse
+		var dest Memo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest NotFound
@@ -2549,6 +8527,13 @@ func ParseDeleteMemoResponse(rsp *http.Response) (*DeleteMemoResponse, error) {
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2561,22 +8546,22 @@ func ParseDeleteMemoResponse(rsp *http.Response) (*DeleteMemoResponse, error) {
 	return response, nil
 }
 
-// ParseListMemosResponse parses an HTTP response from a ListMemosWithResponse call
-func ParseListMemosResponse(rsp *http.Response) (*ListMemosResponse, error) {
+// ParseUpdateMemoV2Response parses an HTTP response from a UpdateMemoV2WithResponse call
+func ParseUpdateMemoV2Response(rsp *http.Response) (*UpdateMemoV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListMemosResponse{
+	response := &UpdateMemoV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MemoListResponse
+		var dest Memo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2596,6 +8581,34 @@ func ParseListMemosResponse(rsp *http.Response) (*ListMemosResponse, error) {
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2608,22 +8621,22 @@ func ParseListMemosResponse(rsp *http.Response) (*ListMemosResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateMemoResponse parses an HTTP response from a UpdateMemoWithResponse call
-func ParseUpdateMemoResponse(rsp *http.Response) (*UpdateMemoResponse, error) {
+// ParseSearchV2Response parses an HTTP response from a SearchV2WithResponse call
+func ParseSearchV2Response(rsp *http.Response) (*SearchV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateMemoResponse{
+	response := &SearchV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MemoUpdateResponse
+		var dest SearchResults
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2643,12 +8656,12 @@ func ParseUpdateMemoResponse(rsp *http.Response) (*UpdateMemoResponse, error) {
 		}
 		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON429 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -2662,40 +8675,40 @@ func ParseUpdateMemoResponse(rsp *http.Response) (*UpdateMemoResponse, error) {
 	return response, nil
 }
 
-// ParseSearchResponse parses an HTTP response from a SearchWithResponse call
-func ParseSearchResponse(rsp *http.Response) (*SearchResponse, error) {
+// ParseListTagsV2Response parses an HTTP response from a ListTagsV2WithResponse call
+func ParseListTagsV2Response(rsp *http.Response) (*ListTagsV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SearchResponse{
+	response := &ListTagsV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SearchResult
+		var dest []string
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON429 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -2709,22 +8722,22 @@ func ParseSearchResponse(rsp *http.Response) (*SearchResponse, error) {
 	return response, nil
 }
 
-// ParseListTagsResponse parses an HTTP response from a ListTagsWithResponse call
-func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
+// ParseListTodosV2Response parses an HTTP response from a ListTodosV2WithResponse call
+func ParseListTodosV2Response(rsp *http.Response) (*ListTodosV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListTagsResponse{
+	response := &ListTodosV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TagListResponse
+		var dest []Todo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2744,6 +8757,13 @@ func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2756,26 +8776,26 @@ func ParseListTagsResponse(rsp *http.Response) (*ListTagsResponse, error) {
 	return response, nil
 }
 
-// ParseCreateTodoResponse parses an HTTP response from a CreateTodoWithResponse call
-func ParseCreateTodoResponse(rsp *http.Response) (*CreateTodoResponse, error) {
+// ParseCreateTodoV2Response parses an HTTP response from a CreateTodoV2WithResponse call
+func ParseCreateTodoV2Response(rsp *http.Response) (*CreateTodoV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateTodoResponse{
+	response := &CreateTodoV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TodoCreateResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Todo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest BadRequest
@@ -2791,6 +8811,13 @@ func ParseCreateTodoResponse(rsp *http.Response) (*CreateTodoResponse, error) {
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2803,47 +8830,54 @@ func ParseCreateTodoResponse(rsp *http.Response) (*CreateTodoResponse, error) {
 	return response, nil
 }
 
-// ParseDeleteTodoResponse parses an HTTP response from a DeleteTodoWithResponse call
-func ParseDeleteTodoResponse(rsp *http.Response) (*DeleteTodoResponse, error) {
+// ParseDeleteTodoV2Response parses an HTTP response from a DeleteTodoV2WithResponse call
+func ParseDeleteTodoV2Response(rsp *http.Response) (*DeleteTodoV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteTodoResponse{
+	response := &DeleteTodoV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TodoDeleteResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -2857,40 +8891,54 @@ func ParseDeleteTodoResponse(rsp *http.Response) (*DeleteTodoResponse, error) {
 	return response, nil
 }
 
-// ParseListTodosResponse parses an HTTP response from a ListTodosWithResponse call
-func ParseListTodosResponse(rsp *http.Response) (*ListTodosResponse, error) {
+// ParseGetTodoV2Response parses an HTTP response from a GetTodoV2WithResponse call
+func ParseGetTodoV2Response(rsp *http.Response) (*GetTodoV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListTodosResponse{
+	response := &GetTodoV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TodoListResponse
+		var dest Todo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest BadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
-		var dest Unauthorized
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON401 = &dest
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -2904,22 +8952,22 @@ func ParseListTodosResponse(rsp *http.Response) (*ListTodosResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateTodoResponse parses an HTTP response from a UpdateTodoWithResponse call
-func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
+// ParseUpdateTodoV2Response parses an HTTP response from a UpdateTodoV2WithResponse call
+func ParseUpdateTodoV2Response(rsp *http.Response) (*UpdateTodoV2Response, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateTodoResponse{
+	response := &UpdateTodoV2Response{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TodoUpdateResponse
+		var dest Todo
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2939,6 +8987,13 @@ func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
 		}
 		response.JSON401 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2946,6 +9001,20 @@ func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest RateLimited
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -17,12 +17,92 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
 )
 
 const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AuditAction.
+const (
+	AccountDelete AuditAction = "account.delete"
+	AuthSignIn    AuditAction = "auth.sign_in"
+	AuthSignOut   AuditAction = "auth.sign_out"
+	AuthUnlink    AuditAction = "auth.unlink"
+	MemoCreate    AuditAction = "memo.create"
+	MemoDelete    AuditAction = "memo.delete"
+	MemoUpdate    AuditAction = "memo.update"
+	TodoCreate    AuditAction = "todo.create"
+	TodoDelete    AuditAction = "todo.delete"
+	TodoUpdate    AuditAction = "todo.update"
+	TokenCreate   AuditAction = "token.create"
+	TokenRevoke   AuditAction = "token.revoke"
+)
+
+// Defines values for AuditEventTargetType.
+const (
+	AuditEventTargetTypeIdentity AuditEventTargetType = "identity"
+	AuditEventTargetTypeMemo     AuditEventTargetType = "memo"
+	AuditEventTargetTypeSession  AuditEventTargetType = "session"
+	AuditEventTargetTypeTodo     AuditEventTargetType = "todo"
+	AuditEventTargetTypeToken    AuditEventTargetType = "token"
+	AuditEventTargetTypeUser     AuditEventTargetType = "user"
+)
+
+// Defines values for BatchOperationKind.
+const (
+	BatchOperationKindMemo BatchOperationKind = "memo"
+	BatchOperationKindTodo BatchOperationKind = "todo"
+)
+
+// Defines values for BatchOperationOp.
+const (
+	Create BatchOperationOp = "create"
+	Delete BatchOperationOp = "delete"
+	Update BatchOperationOp = "update"
+)
+
+// Defines values for BatchOperationPriority.
+const (
+	BatchOperationPriorityHigh   BatchOperationPriority = "high"
+	BatchOperationPriorityNormal BatchOperationPriority = "normal"
+)
+
+// Defines values for BatchOperationStatus.
+const (
+	BatchOperationStatusBacklog    BatchOperationStatus = "backlog"
+	BatchOperationStatusDone       BatchOperationStatus = "done"
+	BatchOperationStatusInProgress BatchOperationStatus = "in_progress"
+	BatchOperationStatusTodo       BatchOperationStatus = "todo"
+)
+
+// Defines values for BatchOperationResultStatus.
+const (
+	Applied BatchOperationResultStatus = "applied"
+	Failed  BatchOperationResultStatus = "failed"
+	Skipped BatchOperationResultStatus = "skipped"
+)
+
+// Defines values for ChangeKind.
+const (
+	ChangeKindMemo ChangeKind = "memo"
+	ChangeKindTodo ChangeKind = "todo"
+)
+
+// Defines values for ChangeOp.
+const (
+	Created ChangeOp = "created"
+	Deleted ChangeOp = "deleted"
+	Updated ChangeOp = "updated"
+)
+
+// Defines values for CompleteRequestRefType.
+const (
+	Refprompt   CompleteRequestRefType = "ref/prompt"
+	Refresource CompleteRequestRefType = "ref/resource"
+)
+
 // Defines values for DeviceAuthPollResponseDataStatus.
 const (
 	Completed DeviceAuthPollResponseDataStatus = "completed"
@@ -31,6 +111,41 @@ const (
 	Pending   DeviceAuthPollResponseDataStatus = "pending"
 )
 
+// Defines values for JWKAlg.
+const (
+	EdDSA JWKAlg = "EdDSA"
+	RS256 JWKAlg = "RS256"
+)
+
+// Defines values for JWKKty.
+const (
+	OKP JWKKty = "OKP"
+	RSA JWKKty = "RSA"
+)
+
+// Defines values for PromptGetRequestName.
+const (
+	BrainstormFromMemos PromptGetRequestName = "brainstorm_from_memos"
+	PlanMyDay           PromptGetRequestName = "plan_my_day"
+	TriageBacklog       PromptGetRequestName = "triage_backlog"
+	WeeklyReview        PromptGetRequestName = "weekly_review"
+)
+
+// Defines values for PromptMessageRole.
+const (
+	PromptMessageRoleAssistant PromptMessageRole = "assistant"
+	PromptMessageRoleUser      PromptMessageRole = "user"
+)
+
+// Defines values for Scope.
+const (
+	Admin      Scope = "admin"
+	MemosRead  Scope = "memos:read"
+	MemosWrite Scope = "memos:write"
+	TodosRead  Scope = "todos:read"
+	TodosWrite Scope = "todos:write"
+)
+
 // Defines values for SearchRequestType.
 const (
 	SearchRequestTypeAll  SearchRequestType = "all"
@@ -80,6 +195,20 @@ const (
 	TodoListRequestStatusTodo       TodoListRequestStatus = "todo"
 )
 
+// Defines values for TodoPatchPriority.
+const (
+	TodoPatchPriorityHigh   TodoPatchPriority = "high"
+	TodoPatchPriorityNormal TodoPatchPriority = "normal"
+)
+
+// Defines values for TodoPatchStatus.
+const (
+	TodoPatchStatusBacklog    TodoPatchStatus = "backlog"
+	TodoPatchStatusDone       TodoPatchStatus = "done"
+	TodoPatchStatusInProgress TodoPatchStatus = "in_progress"
+	TodoPatchStatusTodo       TodoPatchStatus = "todo"
+)
+
 // Defines values for TodoUpdateRequestPriority.
 const (
 	TodoUpdateRequestPriorityHigh   TodoUpdateRequestPriority = "high"
@@ -94,6 +223,47 @@ const (
 	TodoUpdateRequestStatusTodo       TodoUpdateRequestStatus = "todo"
 )
 
+// Defines values for ListActivityV2ParamsTargetType.
+const (
+	ListActivityV2ParamsTargetTypeIdentity ListActivityV2ParamsTargetType = "identity"
+	ListActivityV2ParamsTargetTypeMemo     ListActivityV2ParamsTargetType = "memo"
+	ListActivityV2ParamsTargetTypeSession  ListActivityV2ParamsTargetType = "session"
+	ListActivityV2ParamsTargetTypeTodo     ListActivityV2ParamsTargetType = "todo"
+	ListActivityV2ParamsTargetTypeToken    ListActivityV2ParamsTargetType = "token"
+	ListActivityV2ParamsTargetTypeUser     ListActivityV2ParamsTargetType = "user"
+)
+
+// Defines values for SearchV2ParamsType.
+const (
+	SearchV2ParamsTypeAll  SearchV2ParamsType = "all"
+	SearchV2ParamsTypeMemo SearchV2ParamsType = "memo"
+	SearchV2ParamsTypeTodo SearchV2ParamsType = "todo"
+)
+
+// Defines values for ListTodosV2ParamsStatus.
+const (
+	ListTodosV2ParamsStatusBacklog    ListTodosV2ParamsStatus = "backlog"
+	ListTodosV2ParamsStatusDone       ListTodosV2ParamsStatus = "done"
+	ListTodosV2ParamsStatusInProgress ListTodosV2ParamsStatus = "in_progress"
+	ListTodosV2ParamsStatusTodo       ListTodosV2ParamsStatus = "todo"
+)
+
+// Defines values for ListTodosV2ParamsPriority.
+const (
+	High   ListTodosV2ParamsPriority = "high"
+	Normal ListTodosV2ParamsPriority = "normal"
+)
+
+// AccessToken defines model for AccessToken.
+type AccessToken struct {
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	Id         *string    `json:"id,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Name       *string    `json:"name,omitempty"`
+	Scopes     *[]Scope   `json:"scopes,omitempty"`
+}
+
 // AccountDeleteRequest defines model for AccountDeleteRequest.
 type AccountDeleteRequest struct {
 	// Confirm Confirmation flag for deletion
@@ -106,6 +276,180 @@ type AccountDeleteResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	Action  *AuditAction   `json:"action,omitempty"`
+	Changes *[]FieldChange `json:"changes,omitempty"`
+
+	// Client User-Agent of the request
+	Client    *string    `json:"client,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+	RequestId *string    `json:"request_id,omitempty"`
+
+	// SessionId Sign-in session the change was made in
+	SessionId  *string               `json:"session_id,omitempty"`
+	TargetId   *string               `json:"target_id,omitempty"`
+	TargetType *AuditEventTargetType `json:"target_type,omitempty"`
+
+	// TokenId Personal access token the change was made with
+	TokenId *string `json:"token_id,omitempty"`
+
+	// Tool Tool that made the change, if any
+	Tool *string `json:"tool,omitempty"`
+}
+
+// AuditEventTargetType defines model for AuditEvent.TargetType.
+type AuditEventTargetType string
+
+// BatchError Why an operation failed, with the codes of error responses
+type BatchError struct {
+	Code    *string       `json:"code,omitempty"`
+	Fields  *[]FieldError `json:"fields,omitempty"`
+	Message *string       `json:"message,omitempty"`
+}
+
+// BatchOperation One change, taking the fields of the corresponding todo or memo
+// endpoint. Updates and deletes need an id; fields the operation does
+// not take are an error.
+type BatchOperation struct {
+	Description *string `json:"description,omitempty"`
+
+	// Id ID of the item to update or delete, or "$" followed by a ref
+	Id          *string            `json:"id,omitempty"`
+	Kind        BatchOperationKind `json:"kind"`
+	LinkedTodos *[]string          `json:"linked_todos,omitempty"`
+	Op          BatchOperationOp   `json:"op"`
+
+	// ParentId Parent of a created todo
+	ParentId *string                 `json:"parent_id,omitempty"`
+	Priority *BatchOperationPriority `json:"priority,omitempty"`
+
+	// Ref Name later operations refer to the created item by, as "$" followed by it
+	Ref    *string               `json:"ref,omitempty"`
+	Status *BatchOperationStatus `json:"status,omitempty"`
+	Tags   *[]string             `json:"tags,omitempty"`
+	Title  *string               `json:"title,omitempty"`
+}
+
+// BatchOperationKind defines model for BatchOperation.Kind.
+type BatchOperationKind string
+
+// BatchOperationOp defines model for BatchOperation.Op.
+type BatchOperationOp string
+
+// BatchOperationPriority defines model for BatchOperation.Priority.
+type BatchOperationPriority string
+
+// BatchOperationStatus defines model for BatchOperation.Status.
+type BatchOperationStatus string
+
+// BatchOperationResult defines model for BatchOperationResult.
+type BatchOperationResult struct {
+	// Error Why an operation failed, with the codes of error responses
+	Error *BatchError `json:"error,omitempty"`
+
+	// Id ID of the item, omitted for creates not applied
+	Id *string `json:"id,omitempty"`
+
+	// Index Position of the operation in the request
+	Index *int    `json:"index,omitempty"`
+	Kind  *string `json:"kind,omitempty"`
+	Memo  *Memo   `json:"memo,omitempty"`
+	Op    *string `json:"op,omitempty"`
+	Ref   *string `json:"ref,omitempty"`
+
+	// Status skipped operations were not applied because another failed
+	Status *BatchOperationResultStatus `json:"status,omitempty"`
+	Todo   *Todo                       `json:"todo,omitempty"`
+}
+
+// BatchOperationResultStatus skipped operations were not applied because another failed
+type BatchOperationResultStatus string
+
+// BatchRequest defines model for BatchRequest.
+type BatchRequest struct {
+	// AllOrNothing Apply no operation unless all of them succeed
+	AllOrNothing *bool            `json:"all_or_nothing,omitempty"`
+	Operations   []BatchOperation `json:"operations"`
+}
+
+// BatchResponse defines model for BatchResponse.
+type BatchResponse struct {
+	Applied *int                    `json:"applied,omitempty"`
+	Failed  *int                    `json:"failed,omitempty"`
+	Message *string                 `json:"message,omitempty"`
+	Results *[]BatchOperationResult `json:"results,omitempty"`
+
+	// Success Whether every operation was applied
+	Success *bool `json:"success,omitempty"`
+}
+
+// Change defines model for Change.
+type Change struct {
+	At   *time.Time  `json:"at,omitempty"`
+	Id   *string     `json:"id,omitempty"`
+	Kind *ChangeKind `json:"kind,omitempty"`
+	Op   *ChangeOp   `json:"op,omitempty"`
+}
+
+// ChangeKind defines model for Change.Kind.
+type ChangeKind string
+
+// ChangeOp defines model for Change.Op.
+type ChangeOp string
+
+// ChangesRequest defines model for ChangesRequest.
+type ChangesRequest struct {
+	// Cursor Cursor returned by the previous call
+	Cursor *string `json:"cursor,omitempty"`
+
+	// TimeoutSeconds How long to wait for a change
+	TimeoutSeconds *int `json:"timeout_seconds,omitempty"`
+}
+
+// ChangesResponse defines model for ChangesResponse.
+type ChangesResponse struct {
+	Changes *[]Change `json:"changes,omitempty"`
+	Cursor  *string   `json:"cursor,omitempty"`
+	Reset   *bool     `json:"reset,omitempty"`
+	Success *bool     `json:"success,omitempty"`
+}
+
+// CompleteRequest defines model for CompleteRequest.
+type CompleteRequest struct {
+	Argument struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"argument"`
+	Context *struct {
+		Arguments *map[string]string `json:"arguments,omitempty"`
+	} `json:"context,omitempty"`
+	Ref struct {
+		// Name Prompt name (ref/prompt)
+		Name *string                `json:"name,omitempty"`
+		Type CompleteRequestRefType `json:"type"`
+
+		// Uri Resource URI template (ref/resource)
+		Uri *string `json:"uri,omitempty"`
+	} `json:"ref"`
+}
+
+// CompleteRequestRefType defines model for CompleteRequest.Ref.Type.
+type CompleteRequestRefType string
+
+// CompleteResponse defines model for CompleteResponse.
+type CompleteResponse struct {
+	Completion *struct {
+		HasMore *bool     `json:"hasMore,omitempty"`
+		Total   *int      `json:"total,omitempty"`
+		Values  *[]string `json:"values,omitempty"`
+	} `json:"completion,omitempty"`
+}
+
 // DeviceAuthPollRequest defines model for DeviceAuthPollRequest.
 type DeviceAuthPollRequest struct {
 	// DeviceCode Device code from start request
@@ -118,8 +462,15 @@ type DeviceAuthPollResponse struct {
 		// AccessToken JWT access token (only when authenticated)
 		AccessToken *string `json:"access_token,omitempty"`
 
+		// ExpiresIn Lifetime of the access token in seconds
+		ExpiresIn *int `json:"expires_in,omitempty"`
+
+		// RefreshToken Refresh token used to renew the access token (only when authenticated)
+		RefreshToken *string `json:"refresh_token,omitempty"`
+
 		// Status Authentication status
-		Status *DeviceAuthPollResponseDataStatus `json:"status,omitempty"`
+		Status    *DeviceAuthPollResponseDataStatus `json:"status,omitempty"`
+		TokenType *string                           `json:"token_type,omitempty"`
 	} `json:"data,omitempty"`
 	Message *string `json:"message,omitempty"`
 	Success *bool   `json:"success,omitempty"`
@@ -132,6 +483,12 @@ type DeviceAuthPollResponseDataStatus string
 type DeviceAuthStartRequest struct {
 	// ClientId OAuth client ID
 	ClientId *string `json:"client_id,omitempty"`
+
+	// Link Link the identity to the calling user instead of signing in
+	Link *bool `json:"link,omitempty"`
+
+	// Provider Identity provider to sign in with (default the server's first provider)
+	Provider *string `json:"provider,omitempty"`
 }
 
 // DeviceAuthStartResponse defines model for DeviceAuthStartResponse.
@@ -146,6 +503,9 @@ type DeviceAuthStartResponse struct {
 		// Interval Polling interval in seconds
 		Interval *int `json:"interval,omitempty"`
 
+		// Provider Identity provider the user signs in with
+		Provider *string `json:"provider,omitempty"`
+
 		// UserCode User code to enter on verification URL
 		UserCode *string `json:"user_code,omitempty"`
 
@@ -161,14 +521,94 @@ type DeviceAuthStartResponse struct {
 
 // Error defines model for Error.
 type Error struct {
-	// Code Error code
+	// Code Stable error code: BAD_REQUEST, VALIDATION_ERROR, UNAUTHORIZED,
+	// FORBIDDEN, NOT_FOUND, CONFLICT, AUTHORIZATION_PENDING,
+	// RATE_LIMITED, QUOTA_EXCEEDED, PRECONDITION_FAILED,
+	// CONFIRMATION_REQUIRED or INTERNAL_ERROR
 	Code *string `json:"code,omitempty"`
 
 	// Error Error message
-	Error   *string `json:"error,omitempty"`
+	Error *string `json:"error,omitempty"`
+
+	// Fields The invalid arguments of a VALIDATION_ERROR, if known
+	Fields  *[]FieldError `json:"fields,omitempty"`
+	Success *bool         `json:"success,omitempty"`
+}
+
+// FieldChange defines model for FieldChange.
+type FieldChange struct {
+	Field *string `json:"field,omitempty"`
+
+	// From Previous value, truncated to 100 characters; omitted if unset
+	From *string `json:"from,omitempty"`
+
+	// To New value, truncated to 100 characters; omitted if cleared
+	To *string `json:"to,omitempty"`
+}
+
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field JSON name of the argument, with the index for list items
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Identity defines model for Identity.
+type Identity struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+	Provider  *string    `json:"provider,omitempty"`
+
+	// Subject Account ID at the provider
+	Subject *string `json:"subject,omitempty"`
+}
+
+// IdentityListResponse defines model for IdentityListResponse.
+type IdentityListResponse struct {
+	Data    *[]Identity `json:"data,omitempty"`
+	Message *string     `json:"message,omitempty"`
+	Success *bool       `json:"success,omitempty"`
+}
+
+// IdentityUnlinkResponse defines model for IdentityUnlinkResponse.
+type IdentityUnlinkResponse struct {
+	Message *string `json:"message,omitempty"`
 	Success *bool   `json:"success,omitempty"`
 }
 
+// JWK defines model for JWK.
+type JWK struct {
+	Alg JWKAlg `json:"alg"`
+
+	// Crv Curve of OKP keys
+	Crv *string `json:"crv,omitempty"`
+
+	// E Exponent of RSA keys (base64url)
+	E *string `json:"e,omitempty"`
+
+	// Kid Key ID; the RFC 7638 thumbprint unless set explicitly
+	Kid string `json:"kid"`
+	Kty JWKKty `json:"kty"`
+
+	// N Modulus of RSA keys (base64url)
+	N   *string `json:"n,omitempty"`
+	Use *string `json:"use,omitempty"`
+
+	// X Public key of OKP keys (base64url)
+	X *string `json:"x,omitempty"`
+}
+
+// JWKAlg defines model for JWK.Alg.
+type JWKAlg string
+
+// JWKKty defines model for JWK.Kty.
+type JWKKty string
+
+// JWKS defines model for JWKS.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
 // Memo defines model for Memo.
 type Memo struct {
 	ClosedAt     *time.Time `json:"closed_at"`
@@ -177,8 +617,11 @@ type Memo struct {
 	Id           *string    `json:"id,omitempty"`
 	LastModified *time.Time `json:"last_modified,omitempty"`
 	LinkedTodos  *[]string  `json:"linked_todos,omitempty"`
-	Tags         *[]string  `json:"tags,omitempty"`
-	Title        *string    `json:"title,omitempty"`
+
+	// SourceTodoId Todo the memo was demoted from
+	SourceTodoId *string   `json:"source_todo_id,omitempty"`
+	Tags         *[]string `json:"tags,omitempty"`
+	Title        *string   `json:"title,omitempty"`
 }
 
 // MemoCreateRequest defines model for MemoCreateRequest.
@@ -215,6 +658,22 @@ type MemoDeleteResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// MemoGetRequest defines model for MemoGetRequest.
+type MemoGetRequest struct {
+	// Id Memo ID to retrieve
+	Id string `json:"id"`
+}
+
+// MemoGetResponse defines model for MemoGetResponse.
+type MemoGetResponse struct {
+	Memo    *Memo   `json:"memo,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+
+	// Todos Todos the memo links
+	Todos *[]Todo `json:"todos,omitempty"`
+}
+
 // MemoListRequest defines model for MemoListRequest.
 type MemoListRequest struct {
 	// Tags Filter by tags
@@ -228,6 +687,14 @@ type MemoListResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// MemoPatch defines model for MemoPatch.
+type MemoPatch struct {
+	Description *string   `json:"description,omitempty"`
+	LinkedTodos *[]string `json:"linked_todos,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+	Title       *string   `json:"title,omitempty"`
+}
+
 // MemoUpdateRequest defines model for MemoUpdateRequest.
 type MemoUpdateRequest struct {
 	// Description New description
@@ -253,6 +720,62 @@ type MemoUpdateResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// PromptGetRequest defines model for PromptGetRequest.
+type PromptGetRequest struct {
+	// Arguments Prompt arguments (tags, from, to)
+	Arguments *map[string]string `json:"arguments,omitempty"`
+
+	// Name Prompt name
+	Name PromptGetRequestName `json:"name"`
+}
+
+// PromptGetRequestName Prompt name
+type PromptGetRequestName string
+
+// PromptGetResponse defines model for PromptGetResponse.
+type PromptGetResponse struct {
+	Description *string          `json:"description,omitempty"`
+	Messages    *[]PromptMessage `json:"messages,omitempty"`
+}
+
+// PromptMessage defines model for PromptMessage.
+type PromptMessage struct {
+	Content *struct {
+		Text *string `json:"text,omitempty"`
+		Type *string `json:"type,omitempty"`
+	} `json:"content,omitempty"`
+	Role *PromptMessageRole `json:"role,omitempty"`
+}
+
+// PromptMessageRole defines model for PromptMessage.Role.
+type PromptMessageRole string
+
+// RefreshRequest defines model for RefreshRequest.
+type RefreshRequest struct {
+	// RefreshToken Refresh token from the last sign-in or refresh
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefreshResponse defines model for RefreshResponse.
+type RefreshResponse struct {
+	Data *struct {
+		// AccessToken New JWT access token
+		AccessToken string `json:"access_token"`
+
+		// ExpiresIn Lifetime of the access token in seconds
+		ExpiresIn int `json:"expires_in"`
+
+		// RefreshToken New refresh token; the presented one is no longer valid
+		RefreshToken string  `json:"refresh_token"`
+		TokenType    *string `json:"token_type,omitempty"`
+	} `json:"data,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+}
+
+// Scope Permission granted to a token; admin grants all
+type Scope string
+
 // SearchRequest defines model for SearchRequest.
 type SearchRequest struct {
 	// Query Search query string
@@ -283,6 +806,47 @@ type SearchResults struct {
 	Todos *[]Todo `json:"todos,omitempty"`
 }
 
+// Session defines model for Session.
+type Session struct {
+	// Client User-Agent of the sign-in request
+	Client    *string    `json:"client,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Current Whether this is the session of the calling token
+	Current   *bool      `json:"current,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+
+	// LastUsedAt Last sign-in or token refresh
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// SessionListResponse defines model for SessionListResponse.
+type SessionListResponse struct {
+	Data    *[]Session `json:"data,omitempty"`
+	Message *string    `json:"message,omitempty"`
+	Success *bool      `json:"success,omitempty"`
+}
+
+// SessionRevokeRequest defines model for SessionRevokeRequest.
+type SessionRevokeRequest struct {
+	// SessionId Session to sign out (default the calling one)
+	SessionId *string `json:"session_id,omitempty"`
+}
+
+// SessionRevokeResponse defines model for SessionRevokeResponse.
+type SessionRevokeResponse struct {
+	Data *struct {
+		// Current Whether the calling session was signed out
+		Current *bool `json:"current,omitempty"`
+
+		// Revoked Number of sessions signed out
+		Revoked *int `json:"revoked,omitempty"`
+	} `json:"data,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+}
+
 // TagListRequest Empty request body for tag listing
 type TagListRequest = map[string]interface{}
 
@@ -303,9 +867,12 @@ type Todo struct {
 	LastModified *time.Time    `json:"last_modified,omitempty"`
 	ParentId     *string       `json:"parent_id,omitempty"`
 	Priority     *TodoPriority `json:"priority,omitempty"`
-	Status       *TodoStatus   `json:"status,omitempty"`
-	Tags         *[]string     `json:"tags,omitempty"`
-	Title        *string       `json:"title,omitempty"`
+
+	// SourceMemoId Memo the todo was promoted from
+	SourceMemoId *string     `json:"source_memo_id,omitempty"`
+	Status       *TodoStatus `json:"status,omitempty"`
+	Tags         *[]string   `json:"tags,omitempty"`
+	Title        *string     `json:"title,omitempty"`
 }
 
 // TodoPriority defines model for Todo.Priority.
@@ -358,6 +925,24 @@ type TodoDeleteRequest struct {
 type TodoDeleteResponse struct {
 	Message *string `json:"message,omitempty"`
 	Success *bool   `json:"success,omitempty"`
+
+	// UnlinkedMemos Memos the deleted todo was removed from
+	UnlinkedMemos *[]string `json:"unlinked_memos,omitempty"`
+}
+
+// TodoGetRequest defines model for TodoGetRequest.
+type TodoGetRequest struct {
+	// Id Todo ID to retrieve
+	Id string `json:"id"`
+}
+
+// TodoGetResponse defines model for TodoGetResponse.
+type TodoGetResponse struct {
+	// Backlinks Memos linking the todo
+	Backlinks *[]Memo `json:"backlinks,omitempty"`
+	Message   *string `json:"message,omitempty"`
+	Success   *bool   `json:"success,omitempty"`
+	Todo      *Todo   `json:"todo,omitempty"`
 }
 
 // TodoListRequest defines model for TodoListRequest.
@@ -385,6 +970,21 @@ type TodoListResponse struct {
 	Todos   *[]Todo `json:"todos,omitempty"`
 }
 
+// TodoPatch defines model for TodoPatch.
+type TodoPatch struct {
+	Description *string            `json:"description,omitempty"`
+	Priority    *TodoPatchPriority `json:"priority,omitempty"`
+	Status      *TodoPatchStatus   `json:"status,omitempty"`
+	Tags        *[]string          `json:"tags,omitempty"`
+	Title       *string            `json:"title,omitempty"`
+}
+
+// TodoPatchPriority defines model for TodoPatch.Priority.
+type TodoPatchPriority string
+
+// TodoPatchStatus defines model for TodoPatch.Status.
+type TodoPatchStatus string
+
 // TodoUpdateRequest defines model for TodoUpdateRequest.
 type TodoUpdateRequest struct {
 	// Description New description
@@ -419,6 +1019,37 @@ type TodoUpdateResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// TokenCreateRequest defines model for TokenCreateRequest.
+type TokenCreateRequest struct {
+	// ExpiresInDays Days until the token expires (default 0, never)
+	ExpiresInDays *int    `json:"expires_in_days,omitempty"`
+	Name          string  `json:"name"`
+	Scopes        []Scope `json:"scopes"`
+}
+
+// TokenCreateResponse defines model for TokenCreateResponse.
+type TokenCreateResponse struct {
+	AccessToken *AccessToken `json:"access_token,omitempty"`
+	Message     *string      `json:"message,omitempty"`
+	Success     *bool        `json:"success,omitempty"`
+
+	// Token The token; shown only once
+	Token *string `json:"token,omitempty"`
+}
+
+// TokenListResponse defines model for TokenListResponse.
+type TokenListResponse struct {
+	Message *string        `json:"message,omitempty"`
+	Success *bool          `json:"success,omitempty"`
+	Tokens  *[]AccessToken `json:"tokens,omitempty"`
+}
+
+// TokenRevokeResponse defines model for TokenRevokeResponse.
+type TokenRevokeResponse struct {
+	Message *string `json:"message,omitempty"`
+	Success *bool   `json:"success,omitempty"`
+}
+
 // UserInfoResponse defines model for UserInfoResponse.
 type UserInfoResponse struct {
 	Data *struct {
@@ -432,61 +1063,265 @@ type UserInfoResponse struct {
 	Success *bool   `json:"success,omitempty"`
 }
 
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// ItemID defines model for ItemID.
+type ItemID = string
+
+// Page defines model for Page.
+type Page = int
+
+// PerPage defines model for PerPage.
+type PerPage = int
+
+// TagFilter defines model for TagFilter.
+type TagFilter = []string
+
 // BadRequest defines model for BadRequest.
 type BadRequest = Error
 
+// Forbidden defines model for Forbidden.
+type Forbidden = Error
+
 // InternalServerError defines model for InternalServerError.
 type InternalServerError = Error
 
 // NotFound defines model for NotFound.
 type NotFound = Error
 
+// PreconditionFailed defines model for PreconditionFailed.
+type PreconditionFailed = Error
+
+// RateLimited defines model for RateLimited.
+type RateLimited = Error
+
 // Unauthorized defines model for Unauthorized.
 type Unauthorized = Error
 
-// DeleteAccountJSONRequestBody defines body for DeleteAccount for application/json ContentType.
-type DeleteAccountJSONRequestBody = AccountDeleteRequest
+// ListActivityV2Params defines parameters for ListActivityV2.
+type ListActivityV2Params struct {
+	// Action Filter by action
+	Action *AuditAction `form:"action,omitempty" json:"action,omitempty"`
 
-// PollDeviceAuthJSONRequestBody defines body for PollDeviceAuth for application/json ContentType.
-type PollDeviceAuthJSONRequestBody = DeviceAuthPollRequest
+	// TargetType Filter by the kind of thing changed
+	TargetType *ListActivityV2ParamsTargetType `form:"target_type,omitempty" json:"target_type,omitempty"`
 
-// StartDeviceAuthJSONRequestBody defines body for StartDeviceAuth for application/json ContentType.
-type StartDeviceAuthJSONRequestBody = DeviceAuthStartRequest
+	// TargetId Filter by the ID of the thing changed
+	TargetId *string `form:"target_id,omitempty" json:"target_id,omitempty"`
 
-// CreateMemoJSONRequestBody defines body for CreateMemo for application/json ContentType.
-type CreateMemoJSONRequestBody = MemoCreateRequest
+	// Tool Filter by the tool that made the change
+	Tool *string `form:"tool,omitempty" json:"tool,omitempty"`
 
-// DeleteMemoJSONRequestBody defines body for DeleteMemo for application/json ContentType.
-type DeleteMemoJSONRequestBody = MemoDeleteRequest
+	// Since Only events at or after this time
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
 
-// ListMemosJSONRequestBody defines body for ListMemos for application/json ContentType.
-type ListMemosJSONRequestBody = MemoListRequest
+	// Until Only events before this time
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
 
-// UpdateMemoJSONRequestBody defines body for UpdateMemo for application/json ContentType.
-type UpdateMemoJSONRequestBody = MemoUpdateRequest
+	// Page Page number, starting at 1
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-// SearchJSONRequestBody defines body for Search for application/json ContentType.
-type SearchJSONRequestBody = SearchRequest
+	// PerPage Items per page
+	PerPage *PerPage `form:"per_page,omitempty" json:"per_page,omitempty"`
 
-// ListTagsJSONRequestBody defines body for ListTags for application/json ContentType.
-type ListTagsJSONRequestBody = TagListRequest
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
 
-// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
-type CreateTodoJSONRequestBody = TodoCreateRequest
+// ListActivityV2ParamsTargetType defines parameters for ListActivityV2.
+type ListActivityV2ParamsTargetType string
 
-// DeleteTodoJSONRequestBody defines body for DeleteTodo for application/json ContentType.
-type DeleteTodoJSONRequestBody = TodoDeleteRequest
+// ListMemosV2Params defines parameters for ListMemosV2.
+type ListMemosV2Params struct {
+	// Tag Filter by tag; repeat to require several tags
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
 
-// ListTodosJSONRequestBody defines body for ListTodos for application/json ContentType.
-type ListTodosJSONRequestBody = TodoListRequest
+	// Page Page number, starting at 1
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
 
-// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
-type UpdateTodoJSONRequestBody = TodoUpdateRequest
+	// PerPage Items per page
+	PerPage *PerPage `form:"per_page,omitempty" json:"per_page,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Delete user account and all associated data
-	// (POST /auth/delete_account)
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// DeleteMemoV2Params defines parameters for DeleteMemoV2.
+type DeleteMemoV2Params struct {
+	// IfMatch Only apply the change if the ETag still matches
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetMemoV2Params defines parameters for GetMemoV2.
+type GetMemoV2Params struct {
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// UpdateMemoV2Params defines parameters for UpdateMemoV2.
+type UpdateMemoV2Params struct {
+	// IfMatch Only apply the change if the ETag still matches
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// SearchV2Params defines parameters for SearchV2.
+type SearchV2Params struct {
+	// Q Keyword matched against titles and descriptions
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Type Filter by type
+	Type *SearchV2ParamsType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Tag Filter by tag; repeat to require several tags
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// SearchV2ParamsType defines parameters for SearchV2.
+type SearchV2ParamsType string
+
+// ListTagsV2Params defines parameters for ListTagsV2.
+type ListTagsV2Params struct {
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListTodosV2Params defines parameters for ListTodosV2.
+type ListTodosV2Params struct {
+	// Status Filter by status
+	Status *ListTodosV2ParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Priority Filter by priority
+	Priority *ListTodosV2ParamsPriority `form:"priority,omitempty" json:"priority,omitempty"`
+
+	// Tag Filter by tag; repeat to require several tags
+	Tag *TagFilter `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Page Page number, starting at 1
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Items per page
+	PerPage *PerPage `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// ListTodosV2ParamsStatus defines parameters for ListTodosV2.
+type ListTodosV2ParamsStatus string
+
+// ListTodosV2ParamsPriority defines parameters for ListTodosV2.
+type ListTodosV2ParamsPriority string
+
+// DeleteTodoV2Params defines parameters for DeleteTodoV2.
+type DeleteTodoV2Params struct {
+	// IfMatch Only apply the change if the ETag still matches
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetTodoV2Params defines parameters for GetTodoV2.
+type GetTodoV2Params struct {
+	// IfNoneMatch Return 304 Not Modified if the ETag still matches
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// UpdateTodoV2Params defines parameters for UpdateTodoV2.
+type UpdateTodoV2Params struct {
+	// IfMatch Only apply the change if the ETag still matches
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// DeleteAccountJSONRequestBody defines body for DeleteAccount for application/json ContentType.
+type DeleteAccountJSONRequestBody = AccountDeleteRequest
+
+// PollDeviceAuthJSONRequestBody defines body for PollDeviceAuth for application/json ContentType.
+type PollDeviceAuthJSONRequestBody = DeviceAuthPollRequest
+
+// StartDeviceAuthJSONRequestBody defines body for StartDeviceAuth for application/json ContentType.
+type StartDeviceAuthJSONRequestBody = DeviceAuthStartRequest
+
+// LogoutJSONRequestBody defines body for Logout for application/json ContentType.
+type LogoutJSONRequestBody = SessionRevokeRequest
+
+// RefreshAuthJSONRequestBody defines body for RefreshAuth for application/json ContentType.
+type RefreshAuthJSONRequestBody = RefreshRequest
+
+// CreateAccessTokenJSONRequestBody defines body for CreateAccessToken for application/json ContentType.
+type CreateAccessTokenJSONRequestBody = TokenCreateRequest
+
+// WaitChangesJSONRequestBody defines body for WaitChanges for application/json ContentType.
+type WaitChangesJSONRequestBody = ChangesRequest
+
+// CompleteArgumentJSONRequestBody defines body for CompleteArgument for application/json ContentType.
+type CompleteArgumentJSONRequestBody = CompleteRequest
+
+// CreateMemoJSONRequestBody defines body for CreateMemo for application/json ContentType.
+type CreateMemoJSONRequestBody = MemoCreateRequest
+
+// DeleteMemoJSONRequestBody defines body for DeleteMemo for application/json ContentType.
+type DeleteMemoJSONRequestBody = MemoDeleteRequest
+
+// GetMemoJSONRequestBody defines body for GetMemo for application/json ContentType.
+type GetMemoJSONRequestBody = MemoGetRequest
+
+// ListMemosJSONRequestBody defines body for ListMemos for application/json ContentType.
+type ListMemosJSONRequestBody = MemoListRequest
+
+// UpdateMemoJSONRequestBody defines body for UpdateMemo for application/json ContentType.
+type UpdateMemoJSONRequestBody = MemoUpdateRequest
+
+// GetPromptJSONRequestBody defines body for GetPrompt for application/json ContentType.
+type GetPromptJSONRequestBody = PromptGetRequest
+
+// SearchJSONRequestBody defines body for Search for application/json ContentType.
+type SearchJSONRequestBody = SearchRequest
+
+// ListTagsJSONRequestBody defines body for ListTags for application/json ContentType.
+type ListTagsJSONRequestBody = TagListRequest
+
+// CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
+type CreateTodoJSONRequestBody = TodoCreateRequest
+
+// DeleteTodoJSONRequestBody defines body for DeleteTodo for application/json ContentType.
+type DeleteTodoJSONRequestBody = TodoDeleteRequest
+
+// GetTodoJSONRequestBody defines body for GetTodo for application/json ContentType.
+type GetTodoJSONRequestBody = TodoGetRequest
+
+// ListTodosJSONRequestBody defines body for ListTodos for application/json ContentType.
+type ListTodosJSONRequestBody = TodoListRequest
+
+// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
+type UpdateTodoJSONRequestBody = TodoUpdateRequest
+
+// BatchV2JSONRequestBody defines body for BatchV2 for application/json ContentType.
+type BatchV2JSONRequestBody = BatchRequest
+
+// CreateMemoV2JSONRequestBody defines body for CreateMemoV2 for application/json ContentType.
+type CreateMemoV2JSONRequestBody = MemoCreateRequest
+
+// UpdateMemoV2JSONRequestBody defines body for UpdateMemoV2 for application/json ContentType.
+type UpdateMemoV2JSONRequestBody = MemoPatch
+
+// CreateTodoV2JSONRequestBody defines body for CreateTodoV2 for application/json ContentType.
+type CreateTodoV2JSONRequestBody = TodoCreateRequest
+
+// UpdateTodoV2JSONRequestBody defines body for UpdateTodoV2 for application/json ContentType.
+type UpdateTodoV2JSONRequestBody = TodoPatch
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Public keys verifying access tokens
+	// (GET /.well-known/jwks.json)
+	GetJWKS(w http.ResponseWriter, r *http.Request)
+	// Delete user account and all associated data
+	// (POST /auth/delete_account)
 	DeleteAccount(w http.ResponseWriter, r *http.Request)
 	// Poll for device authentication completion
 	// (POST /auth/device_poll)
@@ -494,24 +1329,63 @@ type ServerInterface interface {
 	// Start device authentication flow
 	// (POST /auth/device_start)
 	StartDeviceAuth(w http.ResponseWriter, r *http.Request)
+	// List linked identities
+	// (GET /auth/identities)
+	ListIdentities(w http.ResponseWriter, r *http.Request)
+	// Unlink an identity
+	// (DELETE /auth/identities/{id})
+	UnlinkIdentity(w http.ResponseWriter, r *http.Request, id string)
+	// Sign out a session
+	// (POST /auth/logout)
+	Logout(w http.ResponseWriter, r *http.Request)
+	// Sign out every session
+	// (POST /auth/logout_all)
+	LogoutAll(w http.ResponseWriter, r *http.Request)
+	// Exchange a refresh token for a new access token
+	// (POST /auth/refresh)
+	RefreshAuth(w http.ResponseWriter, r *http.Request)
+	// List sign-in sessions
+	// (GET /auth/sessions)
+	ListSessions(w http.ResponseWriter, r *http.Request)
+	// List personal access tokens
+	// (GET /auth/tokens)
+	ListAccessTokens(w http.ResponseWriter, r *http.Request)
+	// Create a personal access token
+	// (POST /auth/tokens)
+	CreateAccessToken(w http.ResponseWriter, r *http.Request)
+	// Revoke a personal access token
+	// (DELETE /auth/tokens/{id})
+	RevokeAccessToken(w http.ResponseWriter, r *http.Request, id string)
 	// Get current user information
 	// (GET /auth/user)
 	GetUserInfo(w http.ResponseWriter, r *http.Request)
 	// Health check endpoint
 	// (GET /health)
 	HealthCheck(w http.ResponseWriter, r *http.Request)
+	// Wait for changes to the user's todos and memos
+	// (POST /mcp/changes)
+	WaitChanges(w http.ResponseWriter, r *http.Request)
+	// Complete an argument value
+	// (POST /mcp/complete)
+	CompleteArgument(w http.ResponseWriter, r *http.Request)
 	// Create a new memo
 	// (POST /mcp/memo_create)
 	CreateMemo(w http.ResponseWriter, r *http.Request)
 	// Delete a memo
 	// (POST /mcp/memo_delete)
 	DeleteMemo(w http.ResponseWriter, r *http.Request)
+	// Get a single memo by ID
+	// (POST /mcp/memo_get)
+	GetMemo(w http.ResponseWriter, r *http.Request)
 	// List memos with optional filters
 	// (POST /mcp/memo_list)
 	ListMemos(w http.ResponseWriter, r *http.Request)
 	// Update an existing memo
 	// (POST /mcp/memo_update)
 	UpdateMemo(w http.ResponseWriter, r *http.Request)
+	// Render a prompt from the user's todos and memos
+	// (POST /mcp/prompt_get)
+	GetPrompt(w http.ResponseWriter, r *http.Request)
 	// Search across memos and todos
 	// (POST /mcp/search)
 	Search(w http.ResponseWriter, r *http.Request)
@@ -524,18 +1398,69 @@ type ServerInterface interface {
 	// Delete a todo
 	// (POST /mcp/todo_delete)
 	DeleteTodo(w http.ResponseWriter, r *http.Request)
+	// Get a single todo by ID
+	// (POST /mcp/todo_get)
+	GetTodo(w http.ResponseWriter, r *http.Request)
 	// List todos with optional filters
 	// (POST /mcp/todo_list)
 	ListTodos(w http.ResponseWriter, r *http.Request)
 	// Update an existing todo
 	// (POST /mcp/todo_update)
 	UpdateTodo(w http.ResponseWriter, r *http.Request)
+	// List audit events
+	// (GET /v2/activity)
+	ListActivityV2(w http.ResponseWriter, r *http.Request, params ListActivityV2Params)
+	// Apply a batch of operations
+	// (POST /v2/batch)
+	BatchV2(w http.ResponseWriter, r *http.Request)
+	// List memos
+	// (GET /v2/memos)
+	ListMemosV2(w http.ResponseWriter, r *http.Request, params ListMemosV2Params)
+	// Create a memo
+	// (POST /v2/memos)
+	CreateMemoV2(w http.ResponseWriter, r *http.Request)
+	// Delete a memo
+	// (DELETE /v2/memos/{id})
+	DeleteMemoV2(w http.ResponseWriter, r *http.Request, id ItemID, params DeleteMemoV2Params)
+	// Get a memo
+	// (GET /v2/memos/{id})
+	GetMemoV2(w http.ResponseWriter, r *http.Request, id ItemID, params GetMemoV2Params)
+	// Update a memo
+	// (PATCH /v2/memos/{id})
+	UpdateMemoV2(w http.ResponseWriter, r *http.Request, id ItemID, params UpdateMemoV2Params)
+	// Search todos and memos
+	// (GET /v2/search)
+	SearchV2(w http.ResponseWriter, r *http.Request, params SearchV2Params)
+	// List all unique tags
+	// (GET /v2/tags)
+	ListTagsV2(w http.ResponseWriter, r *http.Request, params ListTagsV2Params)
+	// List todos
+	// (GET /v2/todos)
+	ListTodosV2(w http.ResponseWriter, r *http.Request, params ListTodosV2Params)
+	// Create a todo
+	// (POST /v2/todos)
+	CreateTodoV2(w http.ResponseWriter, r *http.Request)
+	// Delete a todo
+	// (DELETE /v2/todos/{id})
+	DeleteTodoV2(w http.ResponseWriter, r *http.Request, id ItemID, params DeleteTodoV2Params)
+	// Get a todo
+	// (GET /v2/todos/{id})
+	GetTodoV2(w http.ResponseWriter, r *http.Request, id ItemID, params GetTodoV2Params)
+	// Update a todo
+	// (PATCH /v2/todos/{id})
+	UpdateTodoV2(w http.ResponseWriter, r *http.Request, id ItemID, params UpdateTodoV2Params)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Public keys verifying access tokens
+// (GET /.well-known/jwks.json)
+func (_ Unimplemented) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete user account and all associated data
 // (POST /auth/delete_account)
 func (_ Unimplemented) DeleteAccount(w http.ResponseWriter, r *http.Request) {
@@ -554,6 +1479,60 @@ func (_ Unimplemented) StartDeviceAuth(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List linked identities
+// (GET /auth/identities)
+func (_ Unimplemented) ListIdentities(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unlink an identity
+// (DELETE /auth/identities/{id})
+func (_ Unimplemented) UnlinkIdentity(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Sign out a session
+// (POST /auth/logout)
+func (_ Unimplemented) Logout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Sign out every session
+// (POST /auth/logout_all)
+func (_ Unimplemented) LogoutAll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Exchange a refresh token for a new access token
+// (POST /auth/refresh)
+func (_ Unimplemented) RefreshAuth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List sign-in sessions
+// (GET /auth/sessions)
+func (_ Unimplemented) ListSessions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List personal access tokens
+// (GET /auth/tokens)
+func (_ Unimplemented) ListAccessTokens(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a personal access token
+// (POST /auth/tokens)
+func (_ Unimplemented) CreateAccessToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke a personal access token
+// (DELETE /auth/tokens/{id})
+func (_ Unimplemented) RevokeAccessToken(w http.ResponseWriter, r *http.Request, id string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get current user information
 // (GET /auth/user)
 func (_ Unimplemented) GetUserInfo(w http.ResponseWriter, r *http.Request) {
@@ -566,6 +1545,18 @@ func (_ Unimplemented) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Wait for changes to the user's todos and memos
+// (POST /mcp/changes)
+func (_ Unimplemented) WaitChanges(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete an argument value
+// (POST /mcp/complete)
+func (_ Unimplemented) CompleteArgument(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Create a new memo
 // (POST /mcp/memo_create)
 func (_ Unimplemented) CreateMemo(w http.ResponseWriter, r *http.Request) {
@@ -578,6 +1569,12 @@ func (_ Unimplemented) DeleteMemo(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get a single memo by ID
+// (POST /mcp/memo_get)
+func (_ Unimplemented) GetMemo(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List memos with optional filters
 // (POST /mcp/memo_list)
 func (_ Unimplemented) ListMemos(w http.ResponseWriter, r *http.Request) {
@@ -590,6 +1587,12 @@ func (_ Unimplemented) UpdateMemo(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Render a prompt from the user's todos and memos
+// (POST /mcp/prompt_get)
+func (_ Unimplemented) GetPrompt(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Search across memos and todos
 // (POST /mcp/search)
 func (_ Unimplemented) Search(w http.ResponseWriter, r *http.Request) {
@@ -614,6 +1617,12 @@ func (_ Unimplemented) DeleteTodo(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get a single todo by ID
+// (POST /mcp/todo_get)
+func (_ Unimplemented) GetTodo(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List todos with optional filters
 // (POST /mcp/todo_list)
 func (_ Unimplemented) ListTodos(w http.ResponseWriter, r *http.Request) {
@@ -626,6 +1635,90 @@ func (_ Unimplemented) UpdateTodo(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List audit events
+// (GET /v2/activity)
+func (_ Unimplemented) ListActivityV2(w http.ResponseWriter, r *http.Request, params ListActivityV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Apply a batch of operations
+// (POST /v2/batch)
+func (_ Unimplemented) BatchV2(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List memos
+// (GET /v2/memos)
+func (_ Unimplemented) ListMemosV2(w http.ResponseWriter, r *http.Request, params ListMemosV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a memo
+// (POST /v2/memos)
+func (_ Unimplemented) CreateMemoV2(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a memo
+// (DELETE /v2/memos/{id})
+func (_ Unimplemented) DeleteMemoV2(w http.ResponseWriter, r *http.Request, id ItemID, params DeleteMemoV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a memo
+// (GET /v2/memos/{id})
+func (_ Unimplemented) GetMemoV2(w http.ResponseWriter, r *http.Request, id ItemID, params GetMemoV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a memo
+// (PATCH /v2/memos/{id})
+func (_ Unimplemented) UpdateMemoV2(w http.ResponseWriter, r *http.Request, id ItemID, params UpdateMemoV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search todos and memos
+// (GET /v2/search)
+func (_ Unimplemented) SearchV2(w http.ResponseWriter, r *http.Request, params SearchV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List all unique tags
+// (GET /v2/tags)
+func (_ Unimplemented) ListTagsV2(w http.ResponseWriter, r *http.Request, params ListTagsV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List todos
+// (GET /v2/todos)
+func (_ Unimplemented) ListTodosV2(w http.ResponseWriter, r *http.Request, params ListTodosV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a todo
+// (POST /v2/todos)
+func (_ Unimplemented) CreateTodoV2(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a todo
+// (DELETE /v2/todos/{id})
+func (_ Unimplemented) DeleteTodoV2(w http.ResponseWriter, r *http.Request, id ItemID, params DeleteTodoV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a todo
+// (GET /v2/todos/{id})
+func (_ Unimplemented) GetTodoV2(w http.ResponseWriter, r *http.Request, id ItemID, params GetTodoV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a todo
+// (PATCH /v2/todos/{id})
+func (_ Unimplemented) UpdateTodoV2(w http.ResponseWriter, r *http.Request, id ItemID, params UpdateTodoV2Params) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -635,6 +1728,20 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GetJWKS operation middleware
+func (siw *ServerInterfaceWrapper) GetJWKS(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetJWKS(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // DeleteAccount operation middleware
 func (siw *ServerInterfaceWrapper) DeleteAccount(w http.ResponseWriter, r *http.Request) {
 
@@ -683,8 +1790,8 @@ func (siw *ServerInterfaceWrapper) StartDeviceAuth(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetUserInfo operation middleware
-func (siw *ServerInterfaceWrapper) GetUserInfo(w http.ResponseWriter, r *http.Request) {
+// ListIdentities operation middleware
+func (siw *ServerInterfaceWrapper) ListIdentities(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -693,7 +1800,7 @@ func (siw *ServerInterfaceWrapper) GetUserInfo(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserInfo(w, r)
+		siw.Handler.ListIdentities(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -703,22 +1810,19 @@ func (siw *ServerInterfaceWrapper) GetUserInfo(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// HealthCheck operation middleware
-func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HealthCheck(w, r)
-	}))
+// UnlinkIdentity operation middleware
+func (siw *ServerInterfaceWrapper) UnlinkIdentity(w http.ResponseWriter, r *http.Request) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	var err error
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-// CreateMemo operation middleware
-func (siw *ServerInterfaceWrapper) CreateMemo(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -727,7 +1831,7 @@ func (siw *ServerInterfaceWrapper) CreateMemo(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateMemo(w, r)
+		siw.Handler.UnlinkIdentity(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -737,8 +1841,8 @@ func (siw *ServerInterfaceWrapper) CreateMemo(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteMemo operation middleware
-func (siw *ServerInterfaceWrapper) DeleteMemo(w http.ResponseWriter, r *http.Request) {
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -747,7 +1851,7 @@ func (siw *ServerInterfaceWrapper) DeleteMemo(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteMemo(w, r)
+		siw.Handler.Logout(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -757,8 +1861,8 @@ func (siw *ServerInterfaceWrapper) DeleteMemo(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ListMemos operation middleware
-func (siw *ServerInterfaceWrapper) ListMemos(w http.ResponseWriter, r *http.Request) {
+// LogoutAll operation middleware
+func (siw *ServerInterfaceWrapper) LogoutAll(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -767,7 +1871,7 @@ func (siw *ServerInterfaceWrapper) ListMemos(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListMemos(w, r)
+		siw.Handler.LogoutAll(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -777,17 +1881,11 @@ func (siw *ServerInterfaceWrapper) ListMemos(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateMemo operation middleware
-func (siw *ServerInterfaceWrapper) UpdateMemo(w http.ResponseWriter, r *http.Request) {
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
+// RefreshAuth operation middleware
+func (siw *ServerInterfaceWrapper) RefreshAuth(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateMemo(w, r)
+		siw.Handler.RefreshAuth(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -797,8 +1895,8 @@ func (siw *ServerInterfaceWrapper) UpdateMemo(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// Search operation middleware
-func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
+// ListSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListSessions(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -807,7 +1905,7 @@ func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Search(w, r)
+		siw.Handler.ListSessions(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -817,8 +1915,8 @@ func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r)
 }
 
-// ListTags operation middleware
-func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Request) {
+// ListAccessTokens operation middleware
+func (siw *ServerInterfaceWrapper) ListAccessTokens(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -827,7 +1925,7 @@ func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Reque
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTags(w, r)
+		siw.Handler.ListAccessTokens(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -837,8 +1935,8 @@ func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTodo operation middleware
-func (siw *ServerInterfaceWrapper) CreateTodo(w http.ResponseWriter, r *http.Request) {
+// CreateAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateAccessToken(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -847,7 +1945,7 @@ func (siw *ServerInterfaceWrapper) CreateTodo(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTodo(w, r)
+		siw.Handler.CreateAccessToken(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -857,8 +1955,19 @@ func (siw *ServerInterfaceWrapper) CreateTodo(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTodo operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTodo(w http.ResponseWriter, r *http.Request) {
+// RevokeAccessToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAccessToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -867,7 +1976,7 @@ func (siw *ServerInterfaceWrapper) DeleteTodo(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTodo(w, r)
+		siw.Handler.RevokeAccessToken(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -877,8 +1986,8 @@ func (siw *ServerInterfaceWrapper) DeleteTodo(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ListTodos operation middleware
-func (siw *ServerInterfaceWrapper) ListTodos(w http.ResponseWriter, r *http.Request) {
+// GetUserInfo operation middleware
+func (siw *ServerInterfaceWrapper) GetUserInfo(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -887,7 +1996,7 @@ func (siw *ServerInterfaceWrapper) ListTodos(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTodos(w, r)
+		siw.Handler.GetUserInfo(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -897,17 +2006,1083 @@ func (siw *ServerInterfaceWrapper) ListTodos(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateTodo operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTodo(w http.ResponseWriter, r *http.Request) {
-
+// HealthCheck operation middleware
+func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HealthCheck(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WaitChanges operation middleware
+func (siw *ServerInterfaceWrapper) WaitChanges(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WaitChanges(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CompleteArgument operation middleware
+func (siw *ServerInterfaceWrapper) CompleteArgument(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompleteArgument(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateMemo operation middleware
+func (siw *ServerInterfaceWrapper) CreateMemo(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMemo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMemo operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMemo(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMemo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMemo operation middleware
+func (siw *ServerInterfaceWrapper) GetMemo(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMemo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMemos operation middleware
+func (siw *ServerInterfaceWrapper) ListMemos(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMemos(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMemo operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMemo(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMemo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPrompt operation middleware
+func (siw *ServerInterfaceWrapper) GetPrompt(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPrompt(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Search operation middleware
+func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Search(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTags operation middleware
+func (siw *ServerInterfaceWrapper) ListTags(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTags(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTodo operation middleware
+func (siw *ServerInterfaceWrapper) CreateTodo(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTodo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTodo operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTodo(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTodo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTodo operation middleware
+func (siw *ServerInterfaceWrapper) GetTodo(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTodo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTodos operation middleware
+func (siw *ServerInterfaceWrapper) ListTodos(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTodos(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTodo operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTodo(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTodo(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListActivityV2 operation middleware
+func (siw *ServerInterfaceWrapper) ListActivityV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListActivityV2Params
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "target_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target_type", r.URL.Query(), &params.TargetType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "target_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "target_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "target_id", r.URL.Query(), &params.TargetId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "target_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tool" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tool", r.URL.Query(), &params.Tool)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tool", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListActivityV2(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BatchV2 operation middleware
+func (siw *ServerInterfaceWrapper) BatchV2(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BatchV2(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMemosV2 operation middleware
+func (siw *ServerInterfaceWrapper) ListMemosV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMemosV2Params
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMemosV2(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateMemoV2 operation middleware
+func (siw *ServerInterfaceWrapper) CreateMemoV2(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMemoV2(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMemoV2 operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMemoV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ItemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteMemoV2Params
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMemoV2(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMemoV2 operation middleware
+func (siw *ServerInterfaceWrapper) GetMemoV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ItemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMemoV2Params
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMemoV2(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMemoV2 operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMemoV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ItemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateMemoV2Params
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMemoV2(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchV2 operation middleware
+func (siw *ServerInterfaceWrapper) SearchV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchV2Params
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchV2(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTagsV2 operation middleware
+func (siw *ServerInterfaceWrapper) ListTagsV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTagsV2Params
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTodo(w, r)
+		siw.Handler.ListTagsV2(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTodosV2 operation middleware
+func (siw *ServerInterfaceWrapper) ListTodosV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTodosV2Params
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "per_page", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTodosV2(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTodoV2 operation middleware
+func (siw *ServerInterfaceWrapper) CreateTodoV2(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTodoV2(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTodoV2 operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTodoV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ItemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTodoV2Params
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTodoV2(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTodoV2 operation middleware
+func (siw *ServerInterfaceWrapper) GetTodoV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ItemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTodoV2Params
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTodoV2(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTodoV2 operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTodoV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id ItemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTodoV2Params
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTodoV2(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1030,6 +3205,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/.well-known/jwks.json", wrapper.GetJWKS)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/delete_account", wrapper.DeleteAccount)
 	})
@@ -1039,24 +3217,63 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/device_start", wrapper.StartDeviceAuth)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/identities", wrapper.ListIdentities)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/auth/identities/{id}", wrapper.UnlinkIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/logout", wrapper.Logout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/logout_all", wrapper.LogoutAll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/refresh", wrapper.RefreshAuth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/sessions", wrapper.ListSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/tokens", wrapper.ListAccessTokens)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/tokens", wrapper.CreateAccessToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/auth/tokens/{id}", wrapper.RevokeAccessToken)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/auth/user", wrapper.GetUserInfo)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.HealthCheck)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/mcp/changes", wrapper.WaitChanges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/mcp/complete", wrapper.CompleteArgument)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/mcp/memo_create", wrapper.CreateMemo)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/mcp/memo_delete", wrapper.DeleteMemo)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/mcp/memo_get", wrapper.GetMemo)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/mcp/memo_list", wrapper.ListMemos)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/mcp/memo_update", wrapper.UpdateMemo)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/mcp/prompt_get", wrapper.GetPrompt)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/mcp/search", wrapper.Search)
 	})
@@ -1069,12 +3286,57 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/mcp/todo_delete", wrapper.DeleteTodo)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/mcp/todo_get", wrapper.GetTodo)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/mcp/todo_list", wrapper.ListTodos)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/mcp/todo_update", wrapper.UpdateTodo)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/activity", wrapper.ListActivityV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/batch", wrapper.BatchV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/memos", wrapper.ListMemosV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/memos", wrapper.CreateMemoV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/memos/{id}", wrapper.DeleteMemoV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/memos/{id}", wrapper.GetMemoV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/memos/{id}", wrapper.UpdateMemoV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/search", wrapper.SearchV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/tags", wrapper.ListTagsV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/todos", wrapper.ListTodosV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/todos", wrapper.CreateTodoV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/todos/{id}", wrapper.DeleteTodoV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/todos/{id}", wrapper.GetTodoV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/todos/{id}", wrapper.UpdateTodoV2)
+	})
 
 	return r
 }
@@ -1082,58 +3344,178 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xcfW8bN9L/KsQ+zx93wFqSU6dNBRwOjp20ChzH58htr6kh0LsjiTWX3JJcu2qh734g",
-	"ue/LlVayJN+lKQrEFt+Gw98MZ4Y/+U8v4FHMGTAlveGfngAZcybB/PIah9fwWwJS6d8CzhQw8yOOY0oC",
-	"rAhn/V8lZ/oz+B1HMQXbMwRv6L0+PZ9cv/nXzZuPY8/3QAguvKE3Yg+YkhAJOzOachFh5fmeTIIApPSG",
-	"U0wlLH1PBnOIsJ7w/wVMvaH3f/1C2L5tlf03Zt7lcul7IchAkFiLpZfH+SLe0vdGTIFgmH4E8QDCjtpm",
-	"V6PL8Zvry9OLyZvr6w/XlY3ZBZA0KyD7+e735V5n6XuXXL3lCQu32tblh/Hk7Yeby/PSjq5B8kQEgBjX",
-	"56Sn3v12HIssfe+G4UTNuSB/wHb7ubk8vRl//+F69POb8pZOEzUHptLxBh9EwD72Vd4BOkIkhT0XKCJS",
-	"EjZDuCKLt8zXNMZ3GgQ8YeocKCgomWEseAxCEWuiAWdTIiL9Y3X5M9tgtzmleKYNDYV6Nt3BL1SmRAK+",
-	"pxaxVtsd5xSwFSb9iN/9CoExoZpI1lM0ZYpASjyDyrlkYxFmIcKUohArbMWBEKW6nyaULrx8YakEYTO9",
-	"cH42f24j9jk8kAD0yV9xSltVGZpuE4ufujrtHEg3oqngEZIKC5X7F7+809dn50fHL746ae5k6Xs54oaf",
-	"KivedhC8TeFal81PsdHZRPF7YM0NvftxjGwPZHqgv3FGF+hxDqwMTAj/XtkcLN7N774LyAfybnTzx+j4",
-	"kozkiF2/DM5GX4/u459+OHv3ba/Xcx6iwiqRTUlqJpl28z1gSaS1FAML9RS+uaoMYIxIcWq4ITACoVZg",
-	"IWYxpnkCDTW78VqVqnXC3YHzo0ZUu6FTAkxNSNhU4Ac9GtkOaHReOa8IIr7AR7axmzoaEm0Gu+5mxAWK",
-	"OaVWrZ3sJzt2OSFs9eSmnz06RSJAhCEJAWehLK91/GowyBchTMEMzE2qfxQPmDbXuLICo6xHy8QvXbMm",
-	"EkSLXm4kCCu44gj03Igz9ACCTDME3lxfVNT040///vno5dffvHKpqTxykgjiWPH6Qhu7AKTFsmtKpOZW",
-	"f5WV5krFctjvY+vCZW/G+YxCL+BR3552FxEmmfW67irb0tgweiQa2ZsL9M9c1/9YoafOziBFVnah545K",
-	"WF+0Y5eQx6b1q96FHNO5qaLR5Q+nF6Ny/N00pWwZ14SZGipztkXunfZvwqpuCngPEXd5QC4hnGDjHNO1",
-	"h9oJwZE2cc/3WEIpvmsou5ArEKCvtHSOYmcvBi9OjgbHR4Pj8fFgOND//+z57kUak1bUV0ENkUEipcYK",
-	"vuOJQrHgeotIcBxGOHZNZv171X9rZ+jqS7FUk4iHZEog3OGGKGH3EE4UD3n1CD95+jMjzq3vEQWRaW9M",
-	"kH6AhcAL8zue1Sd65OLe09YGSg/abDqiaM1A39t50CVXILsZugbZmcHDioiwcrJVO9HjUZaW+E889rrK",
-	"axnfuUR8imwnZDv5zmPx7Y8nL7/e7oSq647xTJprWgeCs9zzVdfeyUk6VGvb/I0OuRxc2/G3a05+XWyz",
-	"KvUzbqrtyogMPKy/2XN+o+VYkya6wkaj5dG5jjhsHtYIHN2Op6ZnErYqeatE0SjuMImhlvGCyPag220W",
-	"bwnVAdrdApl2lzUkYqbdwgbGsFq+dg1G1mHky3QBbN0MnedgSknoJbJL7EX3N3G4tfu9hEdU/qSMXTtv",
-	"iMLCEzO3z/DXWUZipupoGev8uJa55MTR6Nzlx7959e0unLderB2fVkM78dZmoYazzs5gM6e9wplkYNmv",
-	"x04Vs2fH8xGwCOatwP8tAbFoKtqOQqYVpaJUgWlV3Yr1Ld3ZZiAxv69YQ7cXxR1MqQkeIp7GLtU6jm3u",
-	"EM1lGpUJVR0vmszBCTPIqbD8IDZQcjbdGiiWBZabIapQ8hMVJfd1oeT+r9MsY33snS7EMZ7V7uta7hrF",
-	"apHnp3c8XJjwVeEZokSqSgHPMWubazFVhoq+T1z1nRUoO0EJI78lkJnYE/zJqnQqBiE5w7SIQHzPAvWJ",
-	"kYg5ov/9jPw0DBGDRzRNWKBbMSVqoa95NQeE404ZeTnXOlRGHmNRFH9LVW7z8dEqiWJBuCDKOrHU587J",
-	"bG6AISJMq/42bVpRu88mucPBPeWzzGv7HmGTWPCZ0Cj2vZAzqE6ddmu9lEpoDuEBKI8jC+DNY2lniWCk",
-	"/9VToilglQhAP3XzmBr7TykU6PGtoeo2iKygoVaeNk1ZdGmc35yA0C6fBJjqqCEJ9OYrQmyII8f28mZ/",
-	"NyhzLNF4F9o5ArcofuwQqY4tN6PqriDuVggpI/spYXV2gTsvQIPFwxRCtBxbFELGqbW4CyHtVtEtdykL",
-	"tVEhRFm/cYhCiJZxZSGk3fyLyH6/PqBY59CO4GDlnuIUNsKJjTC/yuvCT4st9xW583Dv5R6F5X2tU6dy",
-	"T8n+HeWe7W5FLe9mBpF+toFJ6DWebgzV9o424S4u1W7Dg9WYsruQZLcjdh9/d4e9i2LT+lvxMMWmGwli",
-	"xKZ8UyLHXlIzl/0Z8kONt5JIEG1WR+QEB4o8wJYKcR6IEYIwuwtLDVSCwMPuH/j1cAgS7Rk+aqhYbd8B",
-	"FiBOEzUvfnubqfTdj2PPdxC4LHOL3ylMmLYDwSMUFlyFEn9pSvmjl/ILjXxmgWJrc6Viy2LUOsgYlzgw",
-	"Z89wBGlZfIHR6dUIfUzimAvVSLazPu/PrjJ6qu4+NYyCiBv2n4F+hBmeGVvt/cLGcyJNv1jwBxKCRMDC",
-	"mBOmJFJzrFDAhSUm69FmcsU5lf4vDFPKHwmbmQ8ty0nqPoafgwNlGSQ6mUol0/4RWIgeCEbfj8dXvV+0",
-	"o6AkgNQ0ss2OxiV/VN7X6dXIM/QWabd83Bv0Brovj4HhmHhD76veoKehG2M1N6fb18fRt8HcJKWtGIvj",
-	"9ibUdmcOahQauonulzImPeu0QKrXPFx04MJ246066aXLqovUiLYVzYIT/mIw2JcMqXtycGkz8qgzGl76",
-	"3okVyrVWLny/xGY3Q47XD6mwkJe+97LLOi6iednoveGnqrl/ul3eapcSRVgs8uO3vCxco81iKXlA7OuW",
-	"9trZBf2pRlj0bvWSGewMIy/mlLZj7opTWjD/9gQ6NxP3wKhrYdW6YOdippYuhu2Q9zQQ5SjRwqecbpfD",
-	"T9l2aRzUFSOG09YOEkMIPSBKKpTYZ4NJlQbrwMm58wRSfuAunNWOIGM2sjpAWI8U7Za0MDNwAOQ7UFm4",
-	"6e3xbBohrev7F60BneNE/mvvgu9AoSARpqqb1Ha07rjmgKkNJ51n9b1pPptDcP/Us6omDqXHgjy85vfO",
-	"5JJEIBWO4tYHpNUZXJ79FhM1M7omNPRpaBMgElkdLWqGYlWDAq2bPBYtqdu2p2qOgrivg9uJTZjavact",
-	"tb63b937cJxNSuOBfaaDWedQ//tWatxnF8pZZSBs3ngylkMKIgOEGoTS+vOazGDPEHrWnMDBG2yD0HNn",
-	"AyeDk/WD8i9lHjp9wF3wRolcEe5dEKnep/S/fYGt/OzwDFCr1NtbgCZXhg6fk7vS2rCET1s34bF9k0ZT",
-	"8wYi16AprZ634slWVffsvaqvDM8AqVrtuM17OYu/X7yX1R7CDMHvlrK1xo9JQ2hbkbPa9v0ArkrkPDDY",
-	"KpxHZ5hr+KL5t2Y/c+eVbhcHgkuZerGs3lz2XCkgCgQpPOtwEY7tc9c+UFSjNR4YRnX6owNJhgvzl7oE",
-	"MaV1ymYKnzGelbHDw66J39i+x+4FQA2K2qEx1GQSuWDUSgX6vBO/jG+SIcjQ3KsQ6pb47RlCz5r4OXhS",
-	"bRD6kvitTvw64K3DfZdem/sC23PeeHWiVQvQ/mJ3ngmU1iZ+LjR1S/z27L2eNfFzkIbavNeXxK9r4tfi",
-	"x8ysehVpJq39LRHKkxBdJwzFgodJYJ/iTHdD1KSlvx+S/nEc23r0u/7vKAl6uCcS1sNx7C39+vQXPMAU",
-	"lYhurrmH/T7V/eZcquGrwauBp7eabqM+Y+WtITcbbXEpEyV9bGjKYl64as94hieQcgYKjk0xWe2hqDmp",
-	"qU0UI50SpV/7cvIo1wxNSXEtX210jUjTNcdyeLZ2NTzzlrfL/wQAAP//u7PmneVQAAA=",
+	"H4sIAAAAAAAC/+x9DU8bOfr4V7Hmf9K10gABSl+oTj9RoN3stsABvfZ2gyIn4yRzTOys7YHmVnz3v57H",
+	"9rzFk0wCod09VqfbJTPjl8fP+5v/CPpiPBGcca2C/T+CEaMRk/ifx5d0CP+OmOrLeKJjwYP94JjrWE+J",
+	"pkMiBkSPGJFMp5KziEg2kUwxrim+GwaqP2JjCmOwb3Q8SViwH3SC3cFO/w3dZq96L6K9/stWJwjCQE8n",
+	"8FRpGfNhcHcXBh9jfj07/fn7Q/J65/VrksT8WhEtcAmDWCodkolkNyHh7JsmlEckoUqTCR0yVbeWtNXa",
+	"7W/d7GxpEQn1f/DuP3bg152XEya75u8WvsbeEsmSf3QCGL4ThMT78d6Cj2FJdfsVfQO3mT1/Pv/oYN2X",
+	"jGoEtRKp7LOajWWrwv/f2N7Z9U55zrScHgw0k7OTXrC+4BFC+JbGmvTYQEg8bDmF770T72azxFyzIZM4",
+	"zaXQNDkUKdez05yk4x6TsL1Ys7EighOaJPNO7fUrzyR3YTChko6ZtsjbHnyiuj+anfCUJ1NCJ5NkagA6",
+	"onzISGzACyhPlI6ThIzhc1xDDJ8ZugjCgNMxTN0ebJgJimuchXB7cCI4q1nKORIO2W29ICdCk08iigcx",
+	"i1ZaDEzTbEWajdtHs4u5FJEgQpIxGwvSPnJTTage5RPFURAGkv2expJFwb6WKZs/2xkdstm54FfC8eRD",
+	"ojSVOuZDQjXZdtP+njI5zecFdCjtK2IDmiY62N8Og3HM43E6xv/2YN8Zk/5VtBHhJkwSO7x3ZkvJ/tl3",
+	"WmEwpt/s9K3WwsVc0uH7OPESnPmd9JC3Ar+YMKqB/Cy8iWI3TNIEHqsgDNi3SSIi5k7Bt3hNy3SKJOY5",
+	"qGypVEo6hb+VniIjGQg5xoVLpiaCK4afv6PROfs9ZQoJui+4Zoa2ga5iw8a2/qMELxEuvAnrDd4dHHXP",
+	"j//5+fjiEvYhpZBwHPyGJnGE22VKE5iaalh/2u8zpYL9AU0Uuytu6G+SDYL94P9t5UJsyzxVW8c4LrKG",
+	"MqDf0WwS2Op7IXtxFDG+0l7en56/ax8dHZ8UdkJxvSRiPGbRPgEWTHosEXyI7DSKBwMmGdckVUyuYYMH",
+	"xfnJM7Y53LSC2giN4mJoZTnPASRtrpnkNLlg8oZJM88qwGmfXB6fnxx87B6fn5+el87aTEAUzkDM7w8P",
+	"Cf88d2FwIvR7kfJopW2dnF52359+Pjkq7OjcwZYLQF0Y+uG345nE7MWJDg9bH7GKXmaECTDcktggI6pw",
+	"XCMRceQziUpADJ+9p3HCVoPX2fnx4enJUfuyfXrSfX/Q/nhchBySB0zeY4yTsdvJGpDBimwSCWa2ipAw",
+	"akAqkQRQ5wXNiGr2MR7HesUtnx9cHnc/tj+1L0t7lVQzksC4oPFs77QcIzJyaBzzVDPCvvUZi9YCg+K6",
+	"yO2I8XwBFEUM12RAFQgiPaLcsI3iojscfgJOEYK24NTSJIYv22eERpFkSgH3xic01SPGtYVYhzMeTUTM",
+	"tXpLUPvcQPWTKDpVZj0o8LScbpJ/fj69POgefz08Pj6yq+1wVIBRW+CoMZJbkSaRBRlxa/u7IkoLCWrG",
+	"76nQdLPDg7Bo2xTmroOlfXuroCQjQD9z2JOQ8X9XxI3PJwefL386PW//WsKNgxKkSKZlPTwSFHdANkhs",
+	"BS8of7FSCNzSWlABsKPCpEa+XIprIzMnUkyY1LHRDayF0qUIESvE94OIarah4zGbNURQlYklU/O+4WmS",
+	"0F6SqTszY8RRCdrBXm+7vxPtso0Xgz268bL3qr/xOnrDNlqDbbrT2+2/iPa8awELrZsqFt1rNUYBK67n",
+	"sE16tH+dTog5Ct/cqi8mBoqZpjbvgC/g9VntLf9B9P7D+qjkHPT7YH8dsYRpVtDdKmcn+CCW41kpcmge",
+	"GMwcJHSI9B3BaMbSz/ZZgkdPiIRR3mhJRr2cXdOYKWXV9xyY9lu08sFcjKimZjksIpZcBmmSTL1QduT0",
+	"x0rLTqNYH/Sdpc44KPu/oRTbNLgfhOavdBIV/jKrC8IADKz8TfwrexP/yt7UQGHFQeFPyW7ENfwJNLqp",
+	"4iHvxrz0p0i1+zvl4CaBvwzA3NhXhROrLrYKLtzw8Y1lceXDoRkc5uFpEWR3YWAUjOZo/j5mSXSIH/lM",
+	"FSN7PF4TxeTGwRDkUuaoMnhf3DxAfEo3xv3Jhhloa3uz5QPDKnytypNe9rYHrf4O23gTvaAbL9ir3sbr",
+	"/u5gY4fuRa/Ym8F2b7fvG8cuvGvGm3msmFKx4PZxxY0TD/lGzIl9p+jzuKWKjGnECOLPzKiayiHT3eoe",
+	"6h1K2Sfm9zJtWOR2aBxkqw4ATAydikEYoEU0g53euWAY75bPmFQClH5rh+Gb3o3fxnrkH1skPg+JSEAr",
+	"0ubjfMAQPDaUT4Pqurt1VOVjLO9AGc1srfLUX0ZTUHiA9CwPRm08xB2YlYiIKUB0VCdIbq6HMzw+qjDT",
+	"ojkzA4oBEN+StHrsrKwqqXp5Oer/DqtK5lNToJ06sPi8ffkhaXoNuo3xF8OuMu1VSAOvCJ8XHGG5vrpJ",
+	"PuNZKpQ5ho0qwhmLUBON3roxYcD8nCLBVIfDpjS9ZqhjU27OyOik5cMpLf6POoZSMWuO3D5QH9aCGKwj",
+	"TkIz1NQ7wd86ARmIJBG3LAI3EyVwkEWc/ZtkCaPKy8uuYx7VEXUjggVJxKIuPFbLOaPEpDhxJg8z4vIJ",
+	"teytmdEnVDKu/ZwDHwE4aeZpr9vORMZCAtMqLG0UD9FZCsIhCa48XyHpzPjA6ZiRhGomc8xRcDhMugCH",
+	"Ww0ecW8aEqo8JxqXZduc01Sa6lQV1w7KaSKGVl1Bb2h3IsVQMqUAxoIz747QG7nUcepYJxUecG5WSm52",
+	"vGSfe5x/A2SwyHi1kB+cM5UmHrWFOS47j5UV+HEjyguJGMcaDgl0Y3Ngxs2AZiFacs3EaMwj9s2DnUKh",
+	"J8ZNmnOZmNfpN61ZL3SBlBfSLFL3Ajh9gndyKm1AgZYI5iBleePqOp5MWFQkjlsmWRG2pMf6NFXAXYUe",
+	"MWkFZBBm+J0fQvbIjltmHPl7HrUgWggNCKPMEVW1phdNkq6QXVg9zFYMMqDVP+PjxQgWFwUsSHnClEJ7",
+	"yGDI2JhCxc1kpk0YZB82l+4VaXuHwY+2+RKjHzOGaJlyswmv6uFTZwe6cyni2I4PuweZo3I+FfgNS4tO",
+	"OwVk86MwcJZVIWf5ki/uktumVQ2QIV5DDGhaOHTQZT38pbk9aw2rWXjfw86Zx9zurUf4tIEoUweiTB+I",
+	"fApBQ7XSAEXVO0pSqXx6+iH+nmdE9EyQGfISYpEq0qdJUhID269etl692HvZsv942U48ZiLVXWUi8uUA",
+	"5F6VMfwkbglEd7LAPUgjapXgoBCu3FscrayHSx2VLmvbzzHrMxB7oLVTDy3JFNOlzyz/nOWA93MEHYrx",
+	"xLiu6li6HKZjr9tk1jtoY7ozu7mhSVp59VYs1JFwfPexj9eiz/rbnDWbPyIT+qHJWemlOg3Pje+DlpX5",
+	"fjhU9BwpxhNN4CF5Jtlga4I/PC8Rzi1j18m0C4TFbr1UU3FC5AMFuJqtLIXGp9WmMvbla5gvyOfzNtFs",
+	"PAGdnTwrDvbc41za38IsnK0/4uhu4dHh0ysfSItvGcMtQ7CruehZS6vmDWtrlp+NqPokJGtGRxryexYL",
+	"ZkTHMrX9FtwKCf5J+JcaiQlspakl0QzxjthN3GcQXDkTSVJLrBG+1nXekfLBmzHQx0IGUoxNzorXn3jw",
+	"7vAIRN+LhSddnPGqwcLrjhF83z7PbJ8p1dUuSFPe0M9fLsvesWcC0qIwAFeI/bCojM9s+vOo96Efn8Y/",
+	"tz//t719ErdVm5/v9Q/bL9vXk6//Ovz5zebm5rwQT+xZzcd4wEDIOcOmtDL0XRrBV1jK7suWV6mTbCCZ",
+	"GtXt+9w8tkOnCu17Ihlnt7MzN4PJWOru7u87G69ujw8a/DPfGK/o+OWIoH0tN2kmDD1WQehI2eqAExs3",
+	"NAkgZTUo/6bGo5rxzeyTd4xKJpspTn6luryPOUu4n0TOKeYC6LNee0viOg/QKXydhbOPfKEC87DOw7XY",
+	"coO8VuM0sG7vzMdDkwQckKliksRcaUYjIAkI6sDvRTd9gftOpLiJI19GWdtN4F6BmWA0ICr0HD+zS8X5",
+	"TY7M35XJps0+KqP7MNajtNcMGWbOYzkW1pwlC0kmAoHXlBfPZ0jFwfE9g7jIo/z8aPu1nx/Bf8obmvi8",
+	"Oea03Rs1A+/5Rl3qyG1KBB68cidfPlMhhonXsIMPaw4AwmsGQloQxtF5yckNk/HAEfrn84+leb58/fev",
+	"G3svX732arqFL7te9QvSoG9HTNr94JwqC36UZhppPVH7W1s27Kk2zRY3+2K8ZdCqyRK6jq36AuLmycyG",
+	"DV2tsKD/y2D9jzlwasxzLQq7RI9MgkjNoofnvFnYyh9rqoQkNaRO2EAVvLJPChmhIfnXwcf20QEmi2He",
+	"YEiKGTNhh2c5lyHJYlchOTw9ef+xfXgZEveyGePs+OSoffIh7PBi8lNYSS8KiSdLLexwGLV9/skMBUts",
+	"nx8fQTylnNvYKSVBBNUteBmQP9SHoCTuTIuD1ibIzgnazSYDumyfzNIz4Y5ZoMcDcs3FLQ8KGvnqoT8f",
+	"ftUYFD4EK+YAzKAZbrc0cpDpSrOwkWLsszmthwaNlJBomfK+jf+Q7VYL/CeS9jWT6m3m548HJOWK6aBZ",
+	"vNoT+GG3y07YTxiVlVgCxmYasYrCGdVDsWIqXJyeGFvcaecWcQrBZwxYoBhOYqVNJUcZKHSoftu+8ocY",
+	"PNxrnCqN7v0eI72E8uvZLyvWlFl9PpzPonLC8WGS1qpOT6MZ7e+93t3Z3fZHDHOpvVihApIxK5+1C2z2",
+	"U/uIUG0djHboItDrVnI3BzQfY9VAT2vEDzJoN00EwIRssuP0YpjzwQWVW9RnTIxaMuvMfUxMWtU6BOnP",
+	"X37xxYaGRU/W+cXO3ssgDI6jo4sDr/eqL2+8vukbJOLTX87INZuWKfQ42tnb237j25FHhB9/M6cNw51f",
+	"HOBw5FmPKvbyRSqTsslw8M+Dd/5IgIfd/MKmpH30FrEaav1evdx9TfQoHfcmMubaBbkU06CZJ3E/1kk5",
+	"8ebkv72P6uvodXp02I82Xn46uf36/sWX7isuvnx9/+vB4Kfrb7+q8w+Hb7568eu6HNI/vwCT/fSXMy+g",
+	"PbbDJxGlSarmQManZVekV+y1jn0x4bSXxH2YpXiy8yescM5rTLy6xtIuwLQrP1pezOIlTNWYHQBm3y2I",
+	"EOKAvvk/2Th01Y4X983TLTP+/AR2WjsvNlrbG63ty+3Wfgv+92sQNpQMlSyeglIeq35q8vBoT6RoY8MW",
+	"iRQ0GtNJEzEzZuPa2BrmLo8LtSAPtKFq6k7Bf5uF+pZw3IaBcZbjgF43DBYjAguA3WKMM2JjgbkVoL7N",
+	"JFK8QIZYm5sy628eMwZVBMut2pO48smMA/WbPnF1V4PLh4h2c5zRJQSqMBgAiSs6CO+JXdWTrSa4IB8z",
+	"LxHzUug9/TA/h+VASoeeeS/p0FSQ9Klmw8x+DcIHP0kPaM2zcKlDLkVw8PurBSe/SMVqknHjV50RPWy6",
+	"2HpT4WEdCyoKfMT9yVQYYymkS3Vvwt8qcI6j4GrBopbS7hBwj1NDAGv8wPSqUJNMy5jdPDzccE31QGue",
+	"C1YPYLf2hwSxYT7KL0ZULkeAj6mm7gyTyNWktgY2beymmuP0M7lSvbfy8rZUDm14d/WYaHF98462uSbn",
+	"DnkJo26PmCnWQklnrrvCXCGaL8kkckckyuUl93P2xZrPq9dvVhN4nsM2y7q3SuK2t6JqYj5fTTUBf1bx",
+	"l3BFqC/gf1nid0OteK6OA2suKDikfeTTcVY+59nJ6qn9XgjgmWhGkWmOGg0FhkOW9WozFjBrFsom7Wie",
+	"WF4tOcqb3JQNRZ4BPoRo14REi5Lr5A/rrQbrbQ+tNzRzELUc1mhReL69E/i2tjDPqphUkFDeHU+7EQUY",
+	"V9OsepLGXGkhx11YWjdj6zKmQ9Z1RQRXS6Rq+XLXruafTy261bH8c5zbebAnTMYimuOMbi4LzaI+mc+a",
+	"iePyJ74aXO1NGnQJe/WZbtl28dVGHF+KpJQlZzuSUKVipanRPfJx7dMG49pEm1pSWipPB1Ou4OCwoZey",
+	"FY2YZ4uvPWw+zmyqXWGlV/P2+pAZWsDAq1laf61cLNihLJ7zW5crrRgHdi84I7GCGgfIZ2aSYOBy5rAb",
+	"HnRd8tUKWU9F9CidYnXPJVBfNQ3dY2MF5WCzdrlnugj4qmixG4TgZCgpt5FJ6o6KRuPYPsGSk4IEQUVr",
+	"XzIaWbeQ2r+VcVbfnj0yf7hHOKDXyX7BqJxTOWN6Xnn618FXBJ8SO1hZYzQ6UK0SuqLVtpz2Np2wuXPA",
+	"8xyyBs5ZHXVUqZgwjxtwZwdRf3XcXDvOFb54AJYdxBJALtTRzO10UViwWto5MEPbKwFKrctunq2IfRi/",
+	"xIUtsK/Jf2zSMcHJ2kfvnGD7MdXXQelRrEA64CrNRt2iXR6l48GzGNGg38zCOExrsM12ot3+xose9Jd5",
+	"8+r1xmv6prfR6m9HO2x38ILu9Zr0l6mI34qGY+Ruruc0WescXHjA8L4dcenovj2sNfiC7IrOsUFKrbiY",
+	"2yzDPMvSZCGIUkqRdaglOHu+FODdopZTERtQQb4mRwUQLYPVgwaV6sVFgWFgWspE85qzulOrGblh+ZgX",
+	"MS6KIz4wSlzSYcUzW8ljGE/0NEuo64loahqX0SFmMpWy1D2j1lfW2F632ZJfNK5ANXTygqQ8/j1lpK4w",
+	"bCkBWOtznNhWKbmvOQyMrL6nz/nSlkr/yQP2B1FEoDZkkPK+cfYU8vXpZHLfYth1BexLPS7yMc3PG/NW",
+	"1KitRT6ifTSLnibADzpCt9abC0CEtSDLgto8f4R/nm935T4WDRI2Z6kmYjcsEZOxIZTlozNef30b/j3G",
+	"xouM6lQy8rWZaAEau08iAXxf665fBfMXd1axHnZksqOYSdCu4z5NwEBL+7D50iKWxFfP9rLH4b2wuaZA",
+	"C6eYKct6cAxcITniATHVs+XZyEJTJG6WKFHE7PuEFpytVN936nESJWAdKyRKXIpoTqLE3M7+DeI3xUUt",
+	"lSihDd946ESJMHBptd3Mrp4VGsbic7Nn0kOysbjJhcd9VZdlkzMKJ+VNzniIs5ob+UCeg+kNNVCDh677",
+	"mWNLDx7sx+NYX4bH6r134MncFI16MZI749YrS/J5HlugPFoiSn4KS/EbYxHtZvmHD5Mp9MDONnhz1UQU",
+	"TdV1RRG7r15uf1uPxlx+voLi/MCZLk7liJ0SQv1QrDu3dae8NDnfBVLFk/KymlYM612OkTVBpdk57s/E",
+	"GmJZkwSbh0S/ZfJslkDMhkrAQyTcLNaKHyfhBuOcCwzYPH4KCSmeAz+iU0VSruPEqjbgL7df5b7bVkg4",
+	"u6n0MnhTbIDljWDft4t7JYusEvnEP67CJXu9j2PuOu4tqKaxiT12OVeLTqAOpap5CvNWWbwZoIxh9xTa",
+	"3gyCS3fgb4kaiVtOsGGL4P1KbuCE6u4/d0aj3jhK2h+2k/ZP5zftn05uel/+1aIfkvTX6bvpv7/sXfd2",
+	"Wk2FxjXjK2syO6WkigfQaHCUpipN5YiaaDbXbGH8oq5Lf7ZN4oIMD85GIGrZ5gOxdHBlHZ5knxiHBVZ7",
+	"yaQQaq1r/6q6tK/jG7YiQLxn8dk0lTG7MPeLWDPtoU8EPmf9FBSMC8A5a6BiOg30gsn/eu9A+vOXyyD0",
+	"tKgSPU1jbs16a/hnbS0KHYUGibjFJteUuIhGh5d7OSET2NzcfG5usjHZLLFWxLDHTnZdJe6ukvsz0npi",
+	"LlIBCLosPWpqs42QQDN7SsnBWZtcpJOJkHo2+dO+8+nwzF1IBa8P3K17lFuPxphyOkSFYbPDLyHSDe/Z",
+	"Im9Fsjt0TDf6vH057AkG10IkKuxwCq2hweqHH02QXuG+uWaS9nVewW9XBrKN8YjcxJT8dHl5ZnqUJ3Gf",
+	"WcJym21fFpSi4r4OztoBdlIxqQfB9mZrs2XbzXI6iYP9YHeztQmID1cLIm5sbd6yJNnAbhNb/7m9Vpvu",
+	"8pwh0zUdCb6wHoFa4QuWJStg5WuJtZo7jUx8Eba6SQ7Mkw6HjShEAKidtQ2Ur+OImGt/NmFwQI4Ri9LE",
+	"YAt2cBc3tpVOzIcdDuNPoAQX87TsFZl6xKY4c6pYFOKpSqZBKpslKk2nucrS4Xat+Jld7Ije2M5DLDJn",
+	"kHV8bUfBfvCBaSzJrdzLt9NqNbiRqNntQTi+5/Kgy5Hdct+BwRWTF65XOqT9Eds4FFxLkVRiUPhtSMb0",
+	"2wbckPoG23jW3yGJS1DpeEzltFTwrEzvHbiMdFagonHwW6XzWHAFY20B89gyHsSu7ceD0kEYBbQMaeMh",
+	"tR0Xguwqjncimj4YqL2X89yV1TngvndrPG7/bTz+ywXhRb8D+C4MXrRadXNli98qXCCJn2wv/qR07RZ8",
+	"tPNm8UfFa9zuwmCvydp8lx8WhVqw/1tZnP12dXdVRFEDQ9OkilYuKqJKiX5sCmGopg1RFfugQYezIp5W",
+	"nHh4DYAqGkFWSmAnArg1+eXOa6IYRvPI7ubeJjm0EgHbBCMrypqRFTsY2/lNz8se07eMcey3pt66tmv2",
+	"zrgOh+bbyoiVi4+nX7pHp19OzOajSJE9lz7sAoluPh+PgwZpeQe5NZGevzvoI9NeTadPH/H5OkIWVLmc",
+	"/pa+lc7bNKt4qWmpk1jew3GNt7Nu+jt5kdtRnLC8sZ29uLLDBzE3gjjvmEimTL8l14xNHKoCqiGYdlcD",
+	"0+Hh8cVF9+j4pH18VAsee/Vqb7q2K16Ly7AXJzp4RKyfoNZcyOB8a1vWovqiRYdrOSV0SGOewePFYtaY",
+	"3ZqKH7x5MAKo3eZlsU1mDInmktFomhfsZfc9IJxhWdurof/x1zPo7Na9PP2ldJdvweDIMuzyDqsPfayl",
+	"VeTHGlX7UZaUTdx7h1vVceasycxR77xZCUQZQy+Ax/F/LQTKgH0jTLZbGacvCYw1gCwXM7cjOw2LrDzK",
+	"7zDNBE3x1tEO71MpY2akJmS7FF6buSi1dM9ph7uLThUz8gyttmnVMrWcTC26gLTR3fsO2HiYPqW5cB/+",
+	"vbWdXOMWSWKve/TZ3oUu4ksoM4ih9doM9otVRT6OKgXetFVpcLpJvsAj4AhEMR0aRcbCnWAjuR4rN3A2",
+	"hnaRtcw0Se1wnDBWBVZT7dEbktuRIH2DYbzcWDfWYNcJQDfUIgyKmaIZ9DgYnHJRebO7zHlh7RlrGvqU",
+	"IwTQI2pHpYbK3009KrcR9jCDIy+K2ran389Uae0u/ii/hv67GDcZuSOM5/jZGlF5oYVfnRcH3OeqTIWO",
+	"pJ3VpHK67NMygYUdLpIIu6DGEjRF7KrtlAHBzd2O5Oz04pLMMh5gAB3uWMYiIgWaZ1GVRssORh+Nwg7b",
+	"xV6Ga6MQb/fGGnXKXo9dOKG/Mlo3tdkBco7Vl/tPLoHr5oYPxHR/1+hzzFJTJTmWubbhYDbJpStzzl7o",
+	"U246oYJUg++jdSGsaYrZzm9jnVBJx0yjyvJbbatxDG3EHPN1URRZJzHW6pbFxDxH39UjEEil7aeHRGZ7",
+	"fP7YgmJps+2HJUFzNkXaaER+iRiKdJ4qiRodVHItKhXECBL8OoxvWHZjcoffjvK/unEESiFKDe89w8r4",
+	"7rkoTNXhesRiSSBEDaqn6W6MLZU580oOs6f1KHXeMrk7q9Otif78VXA+a84CrVBm9kR/j0N/F67ikZL8",
+	"au6m9Nel85zTOQ2a6wsrVGiMqZj3kzRyOcqFSsvNDq8hNeouAR0MWF+z6K3NMjCXX4L86/Cj44/Hl8dW",
+	"CTTfoZyup7sDrA7/YWhBzRDD/7iuliFqCZkaIasrpK7F1FLjGUWk0HiTNrdzpYrt26t2zXaI8yBR8B+B",
+	"hlb4PvMzlHuaKC0mikBmNbijyZl5iI4da/GbuP4tzR2eZiGRxW9ll2NejpVKCzkSHa5AtFjHrw/N7SbX",
+	"6DiotP95ZIdBtSGPzxKa22PlLx3GzOjo+Ju5rZNQBwfXbwkzacAfWuk+tJjAsur+xWa/yXHKK8tLwmAs",
+	"lCaS9RnXCZJdZA39Dj9G1LcOCvBIOFQ3fh6Vy6+3mN9h92DSMHCbWcoFUKIjRMwbJLEmcZHh1ptaVi/0",
+	"21qkiW/gIu+EsG5hs4RjIDvBJ1Fj3QIZeuXntZgQ8qxMSwaz51/IxFwrDsxmq87HAEsczwCnnd2Ev5m7",
+	"atTzJ9RwqOGl97kIEtYoHiYLG5gXNDvbSOIbFlmoAzs2rxouhlEeg0sqLKQzdnjBeMWsRuNOclqCSY/O",
+	"Mjsw5y1WmSrz1ioPCBBgjdImyI2oGi3lcurwxnzQbLtACWtSSDy1Bo+slPhy7esUE1eW/BSkWI02DZjr",
+	"5PES/Nvj0a2q0qCPl/F3rte0lBP/J/Cc+rL/a9HW5fg/+V9WQFoD5HshLQjwWpXjA9OuVGKd2sZMOYYH",
+	"W+qLETx22F8qN/QD08R29SJpBQqLjnjEaKJHtef7Ez4+HLH+9X3Pt9IxLa8YzrK4xbW3JjMeM6XpeNK0",
+	"rV6lYi2/tTEbyHMVvsdRJtEYjBUxMJpWLF0DGtIH2GR5sQVwm+cWzOP+ZMsYxqreT/QRlDRMacqM1r8r",
+	"Yj4jAwYxukPI9zVXKTtNmsLZK2zrR4ZMG4XNoYN5FJpkkglVyjqarLpmv8xcUaBxbXa4uRPTuEIlo4VS",
+	"GZpGsSaJGIZEQZ7c1NWcxFxpCsZun3LwWKl0zLKlbZIvI+TkiqEpDLKH2GUqvK81TSJ3NaP5NurwZ8Zu",
+	"HtMETp1FJpTicqIsNMktk4yMacSIinmfPUdtFnejR1hOoUY4Oo68AbvxqYxfaKztrtekLNrRv5OimM1e",
+	"zz+zQx9oJoun84xhQ7ssX81kdsdj48x4/pSfP7UIZBooWTBqUSRirNQ1cTrX3N6yCQP2Ipso3A9dE/lI",
+	"h0OmtL1X1vRtmtgrANAhZRqlEc3GkwR1VncxQIfjzQBEUn7tUonpkIW4vq2xuQ0DSE73R+Y5VmORiWSD",
+	"+FvY4YaZMrMVW/IfM/Xca4bZjRzY2ddFWHaa70VZ2fRzSCtLZ7RH9kQz0wKCQHjeoagBUJE+MtgVaARb",
+	"ABp7tr7Kyhhsn0xj63Vg3uyle4+Me5673zzY96n28rYnLCza9RCbcG3QLfIh8lTQLrfa5xX3rRntvmtZ",
+	"n+c2vDq0++4Fff/Lxr85I0KbYLW1/vwo/YHpNeNzoTfed0DmYhe8Okye5014wuVH8XFQMPGGib14sWeT",
+	"ROdhdRKrOWgN0ZZPVh1fF2IXGwR+B8xeFKHD7f8IuP1jh+TQaDOBKDExnYvJADscqgUYaHus1eKg6b21",
+	"Zu5a7kX3HdCw0mGsjsd6W4Q9cdhHSpVG4IMtxr6ZawoW6A3G5bBQczBX060Ju2fuV3xk5J69P9CD2+Yl",
+	"IhmPmHxist4wFccSLefGKtWvzHWfWezKsVLh7Ur1GGluX1pbGnzxVrFHxsXSBVzeiAY8d7W1T3joywI2",
+	"IKJ9KZSyUt810CpinXmvgHWaDhsom9Blf125KOVLaR47D6VyeY0vmE+HT4rmQkUTwnuVS3osyl3SYRHf",
+	"RNTUAXppOuOuJwEqEt85/ykSTdKfoicHaEMHqOvy7rAO/qygXTMH6JrR7rs6QD23XNSh3ZMD9EdwgDbA",
+	"6kVmzJrx+fuZMNVrQOow+ckB+uM4QAFjZxygPqxuoJNa1XZdiP09tdLq1SA1qP2kly7US40BvsgB6sPA",
+	"Zg7QNXPX7+oA9VyxUMdjnxygP5YDtF5vuNnZwlpDex/KorJElzZZLEjMcjtNshhQWGh8DmG5V5cK84JG",
+	"W/0bZ/2MQlDbCz2LsNeLGJouYwhBGAUnTaiGF7dbrZZJ8YItshuA4yY5oy7j03assdWH0AOpw02TOTc7",
+	"0ULThOD8JFZ5PmnMydeNS3i4cQgP66oUDyzo/rWzqLQhv0eKYpNZV9tg7oXPihuyhw0bE8N5HJhv7u7C",
+	"+klNA28emXMDeJkji2rWoakcMt21V+zni3EX1liEym7bN0n4ebV5oUsJoEhw5clunr/a9pHDsSXWG0el",
+	"1S45pRYiMdXlmIcLP5lZ6yYVIlluvlMoMjOYSqjGpvw2SzVWxGaB+2bClODSVM0SyOfNn/XInD819mJf",
+	"aWof4uY0sgWEGjR5j8mmr7YHJ4KzT8ASgnsX/jS7MAQI8BgA6rkvZLYxLZnQIQO8NozUnES59+UxOMhq",
+	"ZrWvbeE7d2EAPG3Ru/jOXRiU+Nmij/BV8yZuY7ehdPzkbmt+0jgzT2j5pJ0IPj++uMxFcC+7HG9e/Wto",
+	"9SojPY1HRhEFRQI0qcaWQIYJDjcoSMqVkSsh/iixMSjek5R5E0EucZIJOGwpiJUGAyalFb29KUpemb+m",
+	"CFWkE/ytE5ABtlg3I5nSjIHj39nrIfRQJ3EUkuwyZFyvvdgUN7BJTvPRkRdD13RbwTEREvUAcyNFLLHG",
+	"ItFvzTR6xKSR/EjYLII2ZdjdIEm6Qna5MJLEtqiCy0MYQcjDTymPBGewIcELa/ZJ/nfwDYr8dej5OPp3",
+	"UvHt3PMr4UWq+2KMbMxU22TQeSrJXY1RHEwmyZRQi4xiUKCwWpaRXQO8QGW3GrrVx8s6diNd+ZlktlQr",
+	"JBPJbmCQb6ZCI6FKP69Rpjt8aW0ak7h8qvQCsX9Jh0aRC/5X1A7/xcPzFA6Xb/Ckafy1k/tm+UW4sKxk",
+	"baJsxcKS7QddwKJSknsQhTCLWkgY7r27uydsLQZqK3l5HgG3sMdEXqWyguBoD2qZ9gv/XfEuDvpDeya3",
+	"dxZ/cCbxGoUY9vaexgn7k9aAZEyurq3DyqjxgDJ9FRYFmrbd60rsaXVh+uRvXyWiWYeXS6KdZuP2EWLc",
+	"xPkEPC68vJfVIGZJZHR46yHdnG1dnuXm35tHrkdHODMzPH4+f22zNxu/ui8B/pXiXX9FqeJCZfM1kTwV",
+	"2ytkTBLt4hDQL2x6K2SUdSXAm4qUNq0JnE8v+0DV+ON/XznCYWI5vjFnwjz2avhgP6DY79mFfcxfWdQH",
+	"ooiruP6XMtgfTRIX886V12pwscbZNP5HFtFP+e1wVHPKKcoEbH6d020Ukrq/t5KY9Y/6LeBCM9iNa3YW",
+	"hAE04gViy9xDs/2lFviCYI/giqPJZER7TMd9mphgwJ9DxfyzJrxXUBFQtrnb1mZT/NBuW0w9WyYDIutk",
+	"5g11u4ezSQdwAXsihk70wPfdiRRDyRR8EAnOlkw0sJ1/pjVrKTyeXc0oHmJXSoiGJ48gB/8SjmvAleUc",
+	"165k6clx/ddOylzWcQ2YtDbH9YoFQdsPuoBFJUBPjuvv5riu5FN6RHxDx3WGxet0XBcLeJ5cDD9I7c5C",
+	"x/XKqPFI5nItixoxt9cnx/WfwHFdh5c/jOP6QXjkenSE7+K4nkd4znF9XwJ8clz/SRzXNZoIjgVj+yzx",
+	"w0SkETlPOZlIEaWYGmr7XwdhkMok2A9GWk/U/hZG4ad0wzzd+Ab/bKT9TbopU75JJ5Ng1rwGrTGBO6hY",
+	"IibYC9Uz9v7WVgLvjYTS+69br1tIp3Yb1RFLLcpLuXHWUDcveNaCzfQrF3WD48MWnZAx5XTIbFdfO1il",
+	"v/zsoJgIkH/pXZHN0PKqYgs+tTbyH/62J74vzCPfdHS4cDY69Hx4bnsvbwgZm8v5ALPIwVmbPLvZeZ5/",
+	"Dj8Hd1d3/38AznorhSsDAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
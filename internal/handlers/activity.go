@@ -0,0 +1,191 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/audit"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+	"github.com/pankona/memoya/internal/validation"
+)
+
+const (
+	defaultActivityLimit = 50
+	// MaxActivityLimit is the most audit events returned at once
+	MaxActivityLimit = 1000
+)
+
+// auditTargetTypes are the kinds of things audit events are about
+var auditTargetTypes = []string{"todo", "memo", "token", "session", "identity", "user"}
+
+// ActivityHandler serves the audit log of the user
+type ActivityHandler struct {
+	storage storage.Storage
+}
+
+func NewActivityHandler(storage storage.Storage) *ActivityHandler {
+	return &ActivityHandler{
+		storage: storage,
+	}
+}
+
+// ActivityLogArgs represents arguments for listing audit events
+type ActivityLogArgs struct {
+	Action     string `json:"action,omitempty"`
+	TargetType string `json:"target_type,omitempty"`
+	TargetID   string `json:"target_id,omitempty"`
+	Tool       string `json:"tool,omitempty"`
+	Since      string `json:"since,omitempty"`
+	Until      string `json:"until,omitempty"`
+	Limit      int    `json:"limit,omitempty"`
+}
+
+// ActivityLogResult represents the result of the activity_log tool
+type ActivityLogResult struct {
+	Success bool                 `json:"success"`
+	Events  []*models.AuditEvent `json:"events"`
+	Message string               `json:"message"`
+}
+
+// Log lists the user's audit events, newest first
+func (h *ActivityHandler) Log(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[ActivityLogArgs]) (*mcp.CallToolResultFor[ActivityLogResult], error) {
+	args := params.Arguments
+
+	if h.storage == nil {
+		return nil, fmt.Errorf("storage not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	v := validation.New(validation.Options{})
+	filters := storage.AuditFilters{
+		UserID:     userID,
+		Action:     models.AuditAction(args.Action),
+		TargetType: args.TargetType,
+		TargetID:   args.TargetID,
+		Tool:       args.Tool,
+		Since:      parseTime(v, "since", args.Since),
+		Until:      parseTime(v, "until", args.Until),
+		Limit:      args.Limit,
+	}
+	v.OneOf("action", args.Action, enumStrings(models.AuditActions)...)
+	v.OneOf("target_type", args.TargetType, auditTargetTypes...)
+	if filters.Limit == 0 {
+		filters.Limit = defaultActivityLimit
+	}
+	if filters.Limit < 1 || filters.Limit > MaxActivityLimit {
+		v.Add("limit", "must be between 1 and %d", MaxActivityLimit)
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	events, err := h.storage.ListAuditEvents(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit events: %w", err)
+	}
+	if events == nil {
+		events = []*models.AuditEvent{}
+	}
+
+	result := ActivityLogResult{
+		Success: true,
+		Events:  events,
+		Message: fmt.Sprintf("Found %d events", len(events)),
+	}
+
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return &mcp.CallToolResultFor[ActivityLogResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
+
+// parseTime parses an RFC 3339 time argument, or returns nil if it is empty
+func parseTime(v *validation.Validator, field, value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		v.Add(field, "must be an RFC 3339 time such as 2026-01-02T15:04:05Z")
+		return nil
+	}
+	return &t
+}
+
+// todoEvent returns the audit event of a todo changing from before to after.
+// A nil before is a created todo, a nil after a deleted one.
+func todoEvent(userID string, before, after *models.Todo) *models.AuditEvent {
+	event := &models.AuditEvent{UserID: userID, Action: models.AuditTodoUpdate, TargetType: "todo", Changes: todoChanges(before, after)}
+	switch {
+	case before == nil:
+		event.Action, event.TargetID = models.AuditTodoCreate, after.ID
+	case after == nil:
+		event.Action, event.TargetID = models.AuditTodoDelete, before.ID
+	default:
+		event.TargetID = after.ID
+	}
+	return event
+}
+
+// memoEvent returns the audit event of a memo changing from before to after.
+// A nil before is a created memo, a nil after a deleted one.
+func memoEvent(userID string, before, after *models.Memo) *models.AuditEvent {
+	event := &models.AuditEvent{UserID: userID, Action: models.AuditMemoUpdate, TargetType: "memo", Changes: memoChanges(before, after)}
+	switch {
+	case before == nil:
+		event.Action, event.TargetID = models.AuditMemoCreate, after.ID
+	case after == nil:
+		event.Action, event.TargetID = models.AuditMemoDelete, before.ID
+	default:
+		event.TargetID = after.ID
+	}
+	return event
+}
+
+// todoChanges summarizes how a todo changed. A nil before is a created todo,
+// a nil after a deleted one.
+func todoChanges(before, after *models.Todo) []models.FieldChange {
+	fields := func(t *models.Todo) []string {
+		if t == nil {
+			return make([]string, 7)
+		}
+		return []string{t.Title, t.Description, string(t.Status), string(t.Priority), audit.List(t.Tags), t.ParentID, t.SourceMemoID}
+	}
+	return fieldChanges([]string{"title", "description", "status", "priority", "tags", "parent_id", "source_memo_id"}, fields(before), fields(after))
+}
+
+// memoChanges summarizes how a memo changed. A nil before is a created memo,
+// a nil after a deleted one.
+func memoChanges(before, after *models.Memo) []models.FieldChange {
+	fields := func(m *models.Memo) []string {
+		if m == nil {
+			return make([]string, 5)
+		}
+		return []string{m.Title, m.Description, audit.List(m.Tags), audit.List(m.LinkedTodos), m.SourceTodoID}
+	}
+	return fieldChanges([]string{"title", "description", "tags", "linked_todos", "source_todo_id"}, fields(before), fields(after))
+}
+
+func fieldChanges(names, before, after []string) []models.FieldChange {
+	var changes audit.Changes
+	for i, name := range names {
+		changes.Add(name, before[i], after[i])
+	}
+	return changes
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/audit"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+)
+
+func TestActivityHandler_RecordsChanges(t *testing.T) {
+	mockStorage := NewMockStorage()
+	recorder := audit.NewRecorder(mockStorage)
+	todo := NewTodoHandlerWithStorage(mockStorage)
+	todo.SetAudit(recorder)
+	h := &Handlers{Todo: todo, Activity: NewActivityHandler(mockStorage)}
+
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	ctx = audit.WithRequest(ctx, audit.Request{TokenID: "token-1", Client: "test-client/1.0", RequestID: "req-1"})
+
+	result, err := LookupTool("todo_create").Call(ctx, h, []byte(`{"title":"Write report","tags":["work"]}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var created TodoResult
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &created); err != nil {
+		t.Fatalf("Failed to unmarshal result: %v", err)
+	}
+	id := created.Todo.ID
+
+	if _, err := LookupTool("todo_update").Call(ctx, h, []byte(`{"id":"`+id+`","status":"done"}`)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := LookupTool("todo_delete").Call(ctx, h, []byte(`{"id":"`+id+`"}`)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	events := activityLog(t, h.Activity, ctx, ActivityLogArgs{TargetID: id})
+	if len(events) != 3 {
+		t.Fatalf("Expected 3 events, got %d", len(events))
+	}
+
+	// Newest first
+	deleted, updated, createdEvent := events[0], events[1], events[2]
+	if createdEvent.Action != models.AuditTodoCreate || updated.Action != models.AuditTodoUpdate || deleted.Action != models.AuditTodoDelete {
+		t.Errorf("Unexpected actions: %s, %s, %s", createdEvent.Action, updated.Action, deleted.Action)
+	}
+	if updated.Tool != "todo_update" || updated.TokenID != "token-1" || updated.Client != "test-client/1.0" || updated.RequestID != "req-1" {
+		t.Errorf("Unexpected request of the event: %+v", updated)
+	}
+	if len(updated.Changes) != 1 || updated.Changes[0] != (models.FieldChange{Field: "status", From: "backlog", To: "done"}) {
+		t.Errorf("Unexpected update changes: %+v", updated.Changes)
+	}
+	if !hasChange(createdEvent.Changes, models.FieldChange{Field: "tags", To: "work"}) {
+		t.Errorf("Expected the tags to be recorded as set, got %+v", createdEvent.Changes)
+	}
+	if !hasChange(deleted.Changes, models.FieldChange{Field: "title", From: "Write report"}) {
+		t.Errorf("Expected the title to be recorded as cleared, got %+v", deleted.Changes)
+	}
+
+	// Filters
+	if events := activityLog(t, h.Activity, ctx, ActivityLogArgs{Action: string(models.AuditTodoUpdate)}); len(events) != 1 {
+		t.Errorf("Expected 1 update event, got %d", len(events))
+	}
+	if events := activityLog(t, h.Activity, ctx, ActivityLogArgs{Tool: "todo_create", Limit: 1}); len(events) != 1 || events[0].Action != models.AuditTodoCreate {
+		t.Errorf("Expected the create event, got %+v", events)
+	}
+
+	// Other users do not see the events
+	otherCtx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-2")
+	if events := activityLog(t, h.Activity, otherCtx, ActivityLogArgs{}); len(events) != 0 {
+		t.Errorf("Expected no events for another user, got %d", len(events))
+	}
+}
+
+func TestActivityHandler_InvalidArguments(t *testing.T) {
+	handler := NewActivityHandler(NewMockStorage())
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	tests := []struct {
+		name string
+		args ActivityLogArgs
+	}{
+		{"unknown action", ActivityLogArgs{Action: "todo.rename"}},
+		{"unknown target type", ActivityLogArgs{TargetType: "tag"}},
+		{"malformed time", ActivityLogArgs{Since: "yesterday"}},
+		{"limit too large", ActivityLogArgs{Limit: MaxActivityLimit + 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.Log(ctx, nil, &mcp.CallToolParamsFor[ActivityLogArgs]{Arguments: tt.args})
+			if !apperr.Is(err, apperr.Validation) {
+				t.Errorf("Expected a validation error, got %v", err)
+			}
+		})
+	}
+}
+
+func activityLog(t *testing.T, handler *ActivityHandler, ctx context.Context, args ActivityLogArgs) []*models.AuditEvent {
+	t.Helper()
+	result, err := handler.Log(ctx, nil, &mcp.CallToolParamsFor[ActivityLogArgs]{Arguments: args})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var log ActivityLogResult
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &log); err != nil {
+		t.Fatalf("Failed to unmarshal result: %v", err)
+	}
+	return log.Events
+}
+
+func hasChange(changes []models.FieldChange, want models.FieldChange) bool {
+	for _, change := range changes {
+		if change == want {
+			return true
+		}
+	}
+	return false
+}
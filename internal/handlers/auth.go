@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
-	"strings"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
 )
 
 // HTTPAuthClient interface for making authentication requests
@@ -28,20 +30,25 @@ func NewAuthHandler(httpClient HTTPAuthClient) *AuthHandler {
 	}
 }
 
-type AuthStartArgs struct{}
+type AuthStartArgs struct {
+	Provider string `json:"provider,omitempty"`
+	Link     bool   `json:"link,omitempty"`
+}
 
 type AuthStartResult struct {
 	Success         bool   `json:"success"`
+	Provider        string `json:"provider,omitempty"`
 	DeviceCode      string `json:"device_code,omitempty"`
 	UserCode        string `json:"user_code,omitempty"`
 	VerificationURL string `json:"verification_url,omitempty"`
 	ExpiresIn       int    `json:"expires_in,omitempty"`
+	Interval        int    `json:"interval,omitempty"` // Seconds to wait between auth_status calls
 	Message         string `json:"message"`
 }
 
 func (h *AuthHandler) Start(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[AuthStartArgs]) (*mcp.CallToolResultFor[AuthStartResult], error) {
 	// Call server's device auth start endpoint
-	respData, err := h.httpClient.CallTool(ctx, "device_auth_start", struct{}{})
+	respData, err := h.httpClient.CallTool(ctx, "device_auth_start", params.Arguments)
 	if err != nil {
 		result := AuthStartResult{
 			Success: false,
@@ -63,7 +70,9 @@ func (h *AuthHandler) Start(ctx context.Context, ss *mcp.ServerSession, params *
 			DeviceCode      string `json:"device_code"`
 			UserCode        string `json:"user_code"`
 			VerificationURI string `json:"verification_uri"`
+			Provider        string `json:"provider"`
 			ExpiresIn       int    `json:"expires_in"`
+			Interval        int    `json:"interval"`
 		} `json:"data"`
 		Message string `json:"message"`
 	}
@@ -102,10 +111,17 @@ func (h *AuthHandler) Start(ctx context.Context, ss *mcp.ServerSession, params *
 		config = &Config{}
 	}
 
+	interval := serverResp.Data.Interval
+	if interval <= 0 {
+		interval = auth.DefaultPollInterval
+	}
 	config.PendingAuth = &PendingAuth{
-		DeviceCode: serverResp.Data.DeviceCode,
-		StartedAt:  time.Now(),
-		ExpiresAt:  time.Now().Add(time.Duration(serverResp.Data.ExpiresIn) * time.Second),
+		DeviceCode:      serverResp.Data.DeviceCode,
+		UserCode:        serverResp.Data.UserCode,
+		VerificationURL: serverResp.Data.VerificationURI,
+		Interval:        interval,
+		StartedAt:       time.Now(),
+		ExpiresAt:       time.Now().Add(time.Duration(serverResp.Data.ExpiresIn) * time.Second),
 	}
 
 	if err := h.configManager.Save(config); err != nil {
@@ -115,11 +131,13 @@ func (h *AuthHandler) Start(ctx context.Context, ss *mcp.ServerSession, params *
 
 	result := AuthStartResult{
 		Success:         true,
+		Provider:        serverResp.Data.Provider,
 		DeviceCode:      serverResp.Data.DeviceCode,
 		UserCode:        serverResp.Data.UserCode,
 		VerificationURL: serverResp.Data.VerificationURI,
 		ExpiresIn:       serverResp.Data.ExpiresIn,
-		Message:         fmt.Sprintf("Please visit %s and enter code: %s", serverResp.Data.VerificationURI, serverResp.Data.UserCode),
+		Interval:        interval,
+		Message:         fmt.Sprintf("Please visit %s and enter code: %s. Then call auth_status, waiting %d seconds between calls.", serverResp.Data.VerificationURI, serverResp.Data.UserCode, interval),
 	}
 
 	jsonBytes, _ := json.Marshal(result)
@@ -132,9 +150,22 @@ func (h *AuthHandler) Start(ctx context.Context, ss *mcp.ServerSession, params *
 
 type AuthStatusArgs struct{}
 
+// Authentication states reported by auth_status
+const (
+	AuthStateAuthenticated    = "authenticated"     // Signed in
+	AuthStatePending          = "pending"           // Waiting for the user to sign in; check again after retry_in
+	AuthStateSlowDown         = "slow_down"         // Checked too often; wait retry_in, which grew
+	AuthStateDenied           = "denied"            // The user declined; start over
+	AuthStateExpired          = "expired"           // The code expired before the user signed in; start over
+	AuthStateNotAuthenticated = "not_authenticated" // No sign-in in progress
+	AuthStateError            = "error"             // Checking failed
+)
+
 type AuthStatusResult struct {
 	Success       bool   `json:"success"`
 	Authenticated bool   `json:"authenticated"`
+	State         string `json:"state"`
+	RetryIn       int    `json:"retry_in,omitempty"` // Seconds to wait before calling auth_status again
 	Token         string `json:"token,omitempty"`
 	ExpiresAt     string `json:"expires_at,omitempty"`
 	Message       string `json:"message"`
@@ -146,6 +177,7 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 		result := AuthStatusResult{
 			Success:       false,
 			Authenticated: false,
+			State:         AuthStateNotAuthenticated,
 			Message:       "No authentication information found",
 		}
 
@@ -157,23 +189,91 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 		}, nil
 	}
 
+	// A code that expired locally is not worth polling for
+	pendingExpired := config.PendingAuth != nil && !config.PendingAuth.ExpiresAt.After(time.Now())
+	if pendingExpired {
+		config.PendingAuth = nil
+		h.configManager.Save(config)
+	}
+
 	// Check if there's a pending auth first (prioritize new authentication)
-	if config.PendingAuth != nil && config.PendingAuth.ExpiresAt.After(time.Now()) {
+	if config.PendingAuth != nil {
+		pending := config.PendingAuth
+		if pending.Interval <= 0 {
+			pending.Interval = auth.DefaultPollInterval
+		}
+
+		// Polling before the interval passed would only slow the flow down
+		if pending.LastPolledAt != nil {
+			if wait := time.Until(pending.LastPolledAt.Add(time.Duration(pending.Interval) * time.Second)); wait > 0 {
+				result := pendingStatus(pending, AuthStatePending, wait)
+
+				jsonBytes, _ := json.Marshal(result)
+				return &mcp.CallToolResultFor[AuthStatusResult]{
+					Content: []mcp.Content{
+						&mcp.TextContent{Text: string(jsonBytes)},
+					},
+				}, nil
+			}
+		}
+
 		// Poll server for the result
 		pollArgs := struct {
 			DeviceCode string `json:"device_code"`
 		}{
-			DeviceCode: config.PendingAuth.DeviceCode,
+			DeviceCode: pending.DeviceCode,
 		}
 
 		respData, err := h.httpClient.CallTool(ctx, "device_auth_poll", pollArgs)
 		if err != nil {
+			now := time.Now()
+			pending.LastPolledAt = &now
+
 			result := AuthStatusResult{
 				Success:       false,
 				Authenticated: false,
+				State:         AuthStateError,
 				Message:       fmt.Sprintf("Failed to check authentication status: %v", err),
 			}
 
+			switch apperr.KindOf(err) {
+			case apperr.AuthPending:
+				h.configManager.Save(config)
+				result = pendingStatus(pending, AuthStatePending, time.Duration(pending.Interval)*time.Second)
+			case apperr.SlowDown, apperr.RateLimited:
+				// The server says how long to wait from now on
+				pending.Interval += auth.SlowDownStep
+				if retryAfter := apperr.RetryAfterOf(err); retryAfter > 0 {
+					pending.Interval = int(retryAfter / time.Second)
+				}
+				h.configManager.Save(config)
+				result = pendingStatus(pending, AuthStateSlowDown, time.Duration(pending.Interval)*time.Second)
+			case apperr.AccessDenied, apperr.Forbidden:
+				config.PendingAuth = nil
+				h.configManager.Save(config)
+				result = AuthStatusResult{
+					Success:       true,
+					Authenticated: false,
+					State:         AuthStateDenied,
+					Message:       "The user declined the sign-in. Run auth_start to try again.",
+				}
+			case apperr.ExpiredToken, apperr.Validation:
+				config.PendingAuth = nil
+				h.configManager.Save(config)
+				result = AuthStatusResult{
+					Success:       true,
+					Authenticated: false,
+					State:         AuthStateExpired,
+					Message:       "The sign-in code expired before the user finished signing in. Run auth_start to get a new code.",
+				}
+			case apperr.NotFound, apperr.Conflict:
+				// Unknown or linked to another user: this device code is
+				// done for
+				config.PendingAuth = nil
+				h.configManager.Save(config)
+				result.Message = fmt.Sprintf("Authentication failed: %v. Please run auth_start again.", err)
+			}
+
 			jsonBytes, _ := json.Marshal(result)
 			return &mcp.CallToolResultFor[AuthStatusResult]{
 				Content: []mcp.Content{
@@ -186,8 +286,10 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 		var serverResp struct {
 			Success bool `json:"success"`
 			Data    *struct {
-				AccessToken string `json:"access_token"`
-				User        struct {
+				AccessToken  string `json:"access_token"`
+				RefreshToken string `json:"refresh_token"`
+				ExpiresIn    int    `json:"expires_in"`
+				User         struct {
 					ID       string `json:"id"`
 					GoogleID string `json:"google_id"`
 				} `json:"user"`
@@ -199,6 +301,7 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 			result := AuthStatusResult{
 				Success:       false,
 				Authenticated: false,
+				State:         AuthStateError,
 				Message:       "Failed to parse server response",
 			}
 
@@ -212,22 +315,6 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 
 		// Check if authentication failed
 		if !serverResp.Success {
-			// Check for specific error messages
-			if strings.Contains(serverResp.Message, "authorization pending") {
-				result := AuthStatusResult{
-					Success:       true,
-					Authenticated: false,
-					Message:       "Waiting for user authorization. Please complete the authentication in your browser.",
-				}
-
-				jsonBytes, _ := json.Marshal(result)
-				return &mcp.CallToolResultFor[AuthStatusResult]{
-					Content: []mcp.Content{
-						&mcp.TextContent{Text: string(jsonBytes)},
-					},
-				}, nil
-			}
-
 			// Clear the pending auth
 			config.PendingAuth = nil
 			h.configManager.Save(config)
@@ -235,6 +322,7 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 			result := AuthStatusResult{
 				Success:       false,
 				Authenticated: false,
+				State:         AuthStateError,
 				Message:       serverResp.Message,
 			}
 
@@ -251,6 +339,7 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 			result := AuthStatusResult{
 				Success:       false,
 				Authenticated: false,
+				State:         AuthStateError,
 				Message:       "Poll completed successfully",
 			}
 
@@ -264,7 +353,13 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 
 		// Success! Save the token
 		config.AuthToken = serverResp.Data.AccessToken
-		expiresAt := time.Now().Add(7 * 24 * time.Hour) // JWT tokens expire in 7 days (1 week)
+		config.RefreshToken = serverResp.Data.RefreshToken
+		lifetime := time.Duration(serverResp.Data.ExpiresIn) * time.Second
+		if lifetime <= 0 {
+			// Servers predating refresh tokens issued 7-day tokens
+			lifetime = 7 * 24 * time.Hour
+		}
+		expiresAt := time.Now().Add(lifetime)
 		config.TokenExpiresAt = &expiresAt
 		config.PendingAuth = nil
 
@@ -272,6 +367,7 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 			result := AuthStatusResult{
 				Success:       false,
 				Authenticated: false,
+				State:         AuthStateError,
 				Message:       fmt.Sprintf("Failed to save authentication token: %v", err),
 			}
 
@@ -286,6 +382,7 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 		result := AuthStatusResult{
 			Success:       true,
 			Authenticated: true,
+			State:         AuthStateAuthenticated,
 			Token:         serverResp.Data.AccessToken,
 			ExpiresAt:     expiresAt.Format(time.RFC3339),
 			Message:       "Authentication successful!",
@@ -299,11 +396,13 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 		}, nil
 	}
 
-	// Check if we have a valid existing token (fallback)
-	if config.AuthToken != "" && config.TokenExpiresAt != nil && config.TokenExpiresAt.After(time.Now()) {
+	// Check if we have a valid existing token (fallback). An expired access
+	// token is renewed with the refresh token on the next request.
+	if config.AuthToken != "" && config.TokenExpiresAt != nil && (config.TokenExpiresAt.After(time.Now()) || config.RefreshToken != "") {
 		result := AuthStatusResult{
 			Success:       true,
 			Authenticated: true,
+			State:         AuthStateAuthenticated,
 			Token:         config.AuthToken,
 			ExpiresAt:     config.TokenExpiresAt.Format(time.RFC3339),
 			Message:       "Authenticated",
@@ -321,8 +420,13 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 	result := AuthStatusResult{
 		Success:       true,
 		Authenticated: false,
+		State:         AuthStateNotAuthenticated,
 		Message:       "Not authenticated. Use auth_start to begin authentication.",
 	}
+	if pendingExpired {
+		result.State = AuthStateExpired
+		result.Message = "The sign-in code expired before the user finished signing in. Run auth_start to get a new code."
+	}
 
 	jsonBytes, _ := json.Marshal(result)
 	return &mcp.CallToolResultFor[AuthStatusResult]{
@@ -332,6 +436,161 @@ func (h *AuthHandler) Status(ctx context.Context, ss *mcp.ServerSession, params
 	}, nil
 }
 
+// pendingStatus reports a sign-in the user has not finished yet, to be
+// checked again after wait
+func pendingStatus(pending *PendingAuth, state string, wait time.Duration) AuthStatusResult {
+	retryIn := int((wait + time.Second - 1) / time.Second)
+	message := fmt.Sprintf("Waiting for the user to sign in at %s with code %s. Call auth_status again in %d seconds.", pending.VerificationURL, pending.UserCode, retryIn)
+	if state == AuthStateSlowDown {
+		message = fmt.Sprintf("auth_status was called too often. Wait %d seconds before calling it again; the user still needs to sign in at %s with code %s.", retryIn, pending.VerificationURL, pending.UserCode)
+	}
+	return AuthStatusResult{
+		Success:       true,
+		Authenticated: false,
+		State:         state,
+		RetryIn:       retryIn,
+		Message:       message,
+	}
+}
+
+type AuthLogoutArgs struct {
+	All       bool   `json:"all,omitempty"`
+	SessionID string `json:"session_id,omitempty"`
+}
+
+type AuthLogoutResult struct {
+	Success bool   `json:"success"`
+	Revoked int    `json:"revoked"`
+	Message string `json:"message"`
+}
+
+// Logout signs out on the server and, when this client's session is among
+// those signed out, removes the saved tokens
+func (h *AuthHandler) Logout(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[AuthLogoutArgs]) (*mcp.CallToolResultFor[AuthLogoutResult], error) {
+	var respData []byte
+	var err error
+	if params.Arguments.All {
+		respData, err = h.httpClient.CallTool(ctx, "logout_all", struct{}{})
+	} else {
+		respData, err = h.httpClient.CallTool(ctx, "logout", struct {
+			SessionID string `json:"session_id,omitempty"`
+		}{SessionID: params.Arguments.SessionID})
+	}
+
+	var result AuthLogoutResult
+	switch {
+	case apperr.Is(err, apperr.Unauthorized) && params.Arguments.SessionID == "":
+		// The server no longer accepts this client's tokens: only the local
+		// copy is left to remove
+		result = AuthLogoutResult{Success: true, Message: "Signed out locally; the session had already ended on the server"}
+		if err := h.configManager.Clear(); err != nil {
+			result = AuthLogoutResult{Success: false, Message: err.Error()}
+		}
+	case err != nil:
+		result = AuthLogoutResult{Success: false, Message: fmt.Sprintf("Failed to sign out: %v", err)}
+	default:
+		var serverResp struct {
+			Data struct {
+				Revoked int  `json:"revoked"`
+				Current bool `json:"current"`
+			} `json:"data"`
+			Message string `json:"message"`
+		}
+		if err := json.Unmarshal(respData, &serverResp); err != nil {
+			result = AuthLogoutResult{Success: false, Message: "Failed to parse server response"}
+			break
+		}
+
+		result = AuthLogoutResult{Success: true, Revoked: serverResp.Data.Revoked, Message: serverResp.Message}
+		if serverResp.Data.Current {
+			if err := h.configManager.Clear(); err != nil {
+				result.Message = fmt.Sprintf("%s, but failed to remove the saved tokens: %v", serverResp.Message, err)
+			}
+		}
+	}
+
+	jsonBytes, _ := json.Marshal(result)
+	return &mcp.CallToolResultFor[AuthLogoutResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
+
+type AuthSessionsArgs struct{}
+
+// AuthSession is a sign-in listed by auth_sessions
+type AuthSession struct {
+	models.Session
+	Current bool `json:"current"` // Session of the calling token
+}
+
+type AuthSessionsResult struct {
+	Success  bool          `json:"success"`
+	Sessions []AuthSession `json:"sessions"`
+	Message  string        `json:"message"`
+}
+
+// Sessions lists the sign-ins of the user
+func (h *AuthHandler) Sessions(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[AuthSessionsArgs]) (*mcp.CallToolResultFor[AuthSessionsResult], error) {
+	var result AuthSessionsResult
+	respData, err := h.httpClient.CallTool(ctx, "sessions", nil)
+	if err != nil {
+		result = AuthSessionsResult{Success: false, Message: fmt.Sprintf("Failed to list sessions: %v", err)}
+	} else {
+		var serverResp struct {
+			Data    []AuthSession `json:"data"`
+			Message string        `json:"message"`
+		}
+		if err := json.Unmarshal(respData, &serverResp); err != nil {
+			result = AuthSessionsResult{Success: false, Message: "Failed to parse server response"}
+		} else {
+			result = AuthSessionsResult{Success: true, Sessions: serverResp.Data, Message: serverResp.Message}
+		}
+	}
+
+	jsonBytes, _ := json.Marshal(result)
+	return &mcp.CallToolResultFor[AuthSessionsResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
+
+type AuthIdentitiesArgs struct{}
+
+type AuthIdentitiesResult struct {
+	Success    bool              `json:"success"`
+	Identities []models.Identity `json:"identities"`
+	Message    string            `json:"message"`
+}
+
+// Identities lists the identity provider accounts the user signs in with
+func (h *AuthHandler) Identities(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[AuthIdentitiesArgs]) (*mcp.CallToolResultFor[AuthIdentitiesResult], error) {
+	var result AuthIdentitiesResult
+	respData, err := h.httpClient.CallTool(ctx, "identities", nil)
+	if err != nil {
+		result = AuthIdentitiesResult{Success: false, Message: fmt.Sprintf("Failed to list identities: %v", err)}
+	} else {
+		var serverResp struct {
+			Data    []models.Identity `json:"data"`
+			Message string            `json:"message"`
+		}
+		if err := json.Unmarshal(respData, &serverResp); err != nil {
+			result = AuthIdentitiesResult{Success: false, Message: "Failed to parse server response"}
+		} else {
+			result = AuthIdentitiesResult{Success: true, Identities: serverResp.Data, Message: serverResp.Message}
+		}
+	}
+
+	jsonBytes, _ := json.Marshal(result)
+	return &mcp.CallToolResultFor[AuthIdentitiesResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
+
 // ConfigManager handles reading and writing configuration to XDG config directory
 type ConfigManager struct {
 	configPath string
@@ -339,14 +598,19 @@ type ConfigManager struct {
 
 type Config struct {
 	AuthToken      string       `json:"auth_token,omitempty"`
+	RefreshToken   string       `json:"refresh_token,omitempty"`
 	TokenExpiresAt *time.Time   `json:"token_expires_at,omitempty"`
 	PendingAuth    *PendingAuth `json:"pending_auth,omitempty"`
 }
 
 type PendingAuth struct {
-	DeviceCode string    `json:"device_code"`
-	StartedAt  time.Time `json:"started_at"`
-	ExpiresAt  time.Time `json:"expires_at"`
+	DeviceCode      string     `json:"device_code"`
+	UserCode        string     `json:"user_code,omitempty"`
+	VerificationURL string     `json:"verification_url,omitempty"`
+	Interval        int        `json:"interval,omitempty"` // Seconds between polls
+	StartedAt       time.Time  `json:"started_at"`
+	ExpiresAt       time.Time  `json:"expires_at"`
+	LastPolledAt    *time.Time `json:"last_polled_at,omitempty"`
 }
 
 func NewConfigManager() *ConfigManager {
@@ -401,10 +665,19 @@ func (c *ConfigManager) Save(config *Config) error {
 	return nil
 }
 
+// Clear removes the saved configuration, signing the client out
+func (c *ConfigManager) Clear() error {
+	if err := removeFile(c.getConfigPath()); err != nil {
+		return fmt.Errorf("failed to remove config: %w", err)
+	}
+	return nil
+}
+
 // Platform-specific functions (will be implemented in separate files)
 var (
 	getXDGConfigDir = getXDGConfigDirDefault
 	readFile        = readFileDefault
 	writeFile       = writeFileDefault
 	createDir       = createDirDefault
+	removeFile      = removeFileDefault
 )
@@ -67,6 +67,13 @@ func createDirDefault(path string) error {
 	return os.MkdirAll(path, 0700)
 }
 
+func removeFileDefault(path string) error {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
 // GetAuthToken is a helper function to retrieve the saved auth token
 func GetAuthToken() (string, error) {
 	cm := NewConfigManager()
@@ -0,0 +1,520 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/audit"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+	"github.com/pankona/memoya/internal/validation"
+)
+
+// MaxBatchOperations is the most operations one batch may have
+const MaxBatchOperations = 100
+
+// Outcomes of batch operations
+const (
+	BatchApplied = "applied"
+	BatchFailed  = "failed"
+	BatchSkipped = "skipped" // Not applied because another operation failed
+)
+
+// BatchHandler serves the batch tool, which creates, updates and deletes
+// several todos and memos in one transaction
+type BatchHandler struct {
+	storage storage.Storage
+	quota   *Quota
+	opts    validation.Options
+	audit   *audit.Recorder
+	journal *Journal
+}
+
+func NewBatchHandler(storage storage.Storage) *BatchHandler {
+	return &BatchHandler{
+		storage: storage,
+	}
+}
+
+// SetQuota makes the handler enforce quota on the items it creates and
+// updates
+func (h *BatchHandler) SetQuota(quota *Quota) {
+	h.quota = quota
+}
+
+// SetValidation sets how the handler normalizes arguments
+func (h *BatchHandler) SetValidation(opts validation.Options) {
+	h.opts = opts
+}
+
+// SetAudit makes the handler record the changes it makes in the audit log
+func (h *BatchHandler) SetAudit(recorder *audit.Recorder) {
+	h.audit = recorder
+}
+
+// SetJournal makes the handler record the changes it makes in journal, so
+// they can be undone. A batch is undone as a whole.
+func (h *BatchHandler) SetJournal(journal *Journal) {
+	h.journal = journal
+}
+
+// BatchArgs represents arguments for running a batch of operations
+type BatchArgs struct {
+	Operations   []BatchOperation `json:"operations"`
+	AllOrNothing bool             `json:"all_or_nothing,omitempty"`
+}
+
+// BatchOperation creates, updates or deletes one todo or memo. Fields take
+// the values of the corresponding todo and memo tools. Items created earlier
+// in the batch are referred to as "$" followed by their ref, in id,
+// parent_id and linked_todos.
+type BatchOperation struct {
+	Op          string   `json:"op"`            // "create", "update" or "delete"
+	Kind        string   `json:"kind"`          // "todo" or "memo"
+	Ref         string   `json:"ref,omitempty"` // Name to refer to the created item by
+	ID          string   `json:"id,omitempty"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Status      string   `json:"status,omitempty"`
+	Priority    string   `json:"priority,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	ParentID    string   `json:"parent_id,omitempty"`
+	LinkedTodos []string `json:"linked_todos,omitempty"`
+}
+
+// BatchOperationResult is the outcome of one operation
+type BatchOperationResult struct {
+	Index  int          `json:"index"`
+	Op     string       `json:"op"`
+	Kind   string       `json:"kind"`
+	Ref    string       `json:"ref,omitempty"`
+	ID     string       `json:"id,omitempty"`
+	Status string       `json:"status"`
+	Todo   *models.Todo `json:"todo,omitempty"` // The todo as the operation left it
+	Memo   *models.Memo `json:"memo,omitempty"` // The memo as the operation left it
+	Error  *BatchError  `json:"error,omitempty"`
+}
+
+// BatchError is why an operation failed, like the error responses of the
+// HTTP API
+type BatchError struct {
+	Code    string                  `json:"code"`
+	Message string                  `json:"message"`
+	Fields  []validation.FieldError `json:"fields,omitempty"`
+}
+
+// BatchResult represents the result of the batch tool
+type BatchResult struct {
+	Success bool                   `json:"success"` // Whether every operation was applied
+	Applied int                    `json:"applied"`
+	Failed  int                    `json:"failed"`
+	Results []BatchOperationResult `json:"results"`
+	Message string                 `json:"message"`
+}
+
+// madeChanges makes a batch applied in part a success over MCP, so its
+// results reach the caller
+func (r *BatchResult) madeChanges() bool {
+	return r.Applied > 0
+}
+
+// Batch applies a list of operations in one transaction. Operations failing
+// are reported in their result, and the others are applied unless
+// all_or_nothing is set.
+func (h *BatchHandler) Batch(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[BatchArgs]) (*mcp.CallToolResultFor[BatchResult], error) {
+	args := params.Arguments
+
+	if h.storage == nil {
+		return nil, fmt.Errorf("storage not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	if len(args.Operations) == 0 || len(args.Operations) > MaxBatchOperations {
+		v := validation.New(h.opts)
+		v.Add("operations", "must have between 1 and %d operations", MaxBatchOperations)
+		return nil, v.Err()
+	}
+
+	// Check the operations on their own before reading anything
+	ops := args.Operations
+	invalid := make([]error, len(ops))
+	refs := make(map[string]string) // Kinds of the items created for refs
+	failed := false
+	var newTodos, newMemos int
+	for i := range ops {
+		if invalid[i] = h.normalize(&ops[i], refs); invalid[i] != nil {
+			failed = true
+			continue
+		}
+		if ops[i].Ref != "" {
+			refs[ops[i].Ref] = ops[i].Kind
+		}
+		switch {
+		case ops[i].Op != "create":
+		case ops[i].Kind == "todo":
+			newTodos++
+		default:
+			newMemos++
+		}
+	}
+	if failed && args.AllOrNothing {
+		results := newBatchResults(ops, invalid)
+		skipApplied(results)
+		return batchResult(results)
+	}
+
+	// Enforce the user's quota on all items created, even if some fail
+	if err := h.quota.CheckNewItems(ctx, userID, newTodos, newMemos); err != nil {
+		return nil, err
+	}
+
+	// Transactions cannot query, so the memos linking the todos deleted are
+	// looked up first. Todos created by the batch are only linked by memos
+	// it changes.
+	var deleted []string
+	for i, op := range ops {
+		if invalid[i] == nil && op.Op == "delete" && op.Kind == "todo" && !strings.HasPrefix(op.ID, "$") {
+			deleted = append(deleted, op.ID)
+		}
+	}
+	linking, err := linkingMemos(ctx, h.storage, userID, deleted...)
+	if err != nil {
+		return nil, err
+	}
+
+	var items *txItems
+	var results []BatchOperationResult
+	wrote := false
+	err = h.storage.RunTransaction(ctx, userID, func(tx storage.Tx) error {
+		// The function may be retried, so it starts over each time
+		items = newTxItems(tx)
+		results = newBatchResults(ops, invalid)
+		wrote = false
+		created := make(map[string]string) // IDs of the items created for refs
+
+		for i := range ops {
+			if invalid[i] != nil {
+				continue
+			}
+			err := h.apply(items, userID, ops[i], created, linking, &results[i])
+			if err == nil {
+				if ops[i].Ref != "" {
+					created[ops[i].Ref] = results[i].ID
+				}
+				continue
+			}
+			if apperr.KindOf(err) == apperr.Internal {
+				return err
+			}
+			results[i] = failedResult(i, ops[i], err)
+			if args.AllOrNothing {
+				// Leave everything as is
+				skipApplied(results)
+				return nil
+			}
+		}
+
+		wrote = true
+		return items.write()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if wrote {
+		steps := items.steps()
+		items.recordAudit(ctx, h.audit, userID)
+		if missed := unlinkDeleted(ctx, h.storage, userID, deleted...); missed != nil {
+			steps = append(steps, missed.steps()...)
+			missed.recordAudit(ctx, h.audit, userID)
+		}
+
+		// The batch is one operation, undone as a whole
+		h.journal.Record(ctx, userID, steps...)
+	}
+	return batchResult(results)
+}
+
+// normalize checks op and normalizes its fields like the todo and memo tools
+// do. The refs op uses must be in refs, created by earlier operations.
+func (h *BatchHandler) normalize(op *BatchOperation, refs map[string]string) error {
+	v := validation.New(h.opts)
+	v.OneOf("op", op.Op, "create", "update", "delete")
+	v.OneOf("kind", op.Kind, "todo", "memo")
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	// Fields the operation does not take
+	fields := []struct {
+		name           string
+		given, allowed bool
+	}{
+		{"ref", op.Ref != "", op.Op == "create"},
+		{"id", op.ID != "", op.Op != "create"},
+		{"title", op.Title != "", op.Op != "delete"},
+		{"description", op.Description != "", op.Op != "delete"},
+		{"status", op.Status != "", op.Op != "delete" && op.Kind == "todo"},
+		{"priority", op.Priority != "", op.Op != "delete" && op.Kind == "todo"},
+		{"tags", op.Tags != nil, op.Op != "delete"},
+		{"parent_id", op.ParentID != "", op.Op == "create" && op.Kind == "todo"},
+		{"linked_todos", op.LinkedTodos != nil, op.Op != "delete" && op.Kind == "memo"},
+	}
+	for _, field := range fields {
+		if field.given && !field.allowed {
+			v.Add(field.name, "is not allowed when the op is %s and the kind is %s", op.Op, op.Kind)
+		}
+	}
+
+	switch {
+	case op.Op == "create" && op.Kind == "todo":
+		args := op.todoCreateArgs()
+		normalizeTodoCreate(v, &args)
+		op.Title, op.Description, op.Tags, op.ParentID = args.Title, args.Description, args.Tags, args.ParentID
+	case op.Op == "create":
+		args := op.memoCreateArgs()
+		normalizeMemoCreate(v, &args)
+		op.Title, op.Description, op.Tags, op.LinkedTodos = args.Title, args.Description, args.Tags, args.LinkedTodos
+	case op.Op == "update" && op.Kind == "todo":
+		args := op.todoUpdateArgs()
+		normalizeTodoUpdate(v, &args)
+		op.Title, op.Description, op.Tags = args.Title, args.Description, args.Tags
+	case op.Op == "update":
+		args := op.memoUpdateArgs()
+		normalizeMemoUpdate(v, &args)
+		op.Title, op.Description, op.Tags, op.LinkedTodos = args.Title, args.Description, args.Tags, args.LinkedTodos
+	}
+
+	op.ID = strings.TrimSpace(op.ID)
+	if op.Op != "create" && op.ID == "" {
+		v.Add("id", "is required when the op is %s", op.Op)
+	}
+	op.Ref = strings.TrimSpace(op.Ref)
+	if _, ok := refs[op.Ref]; ok {
+		v.Add("ref", "%s is already the ref of an earlier operation", op.Ref)
+	}
+
+	// References to items created earlier in the batch
+	checkRef := func(field, id, kind string) {
+		name, ok := strings.CutPrefix(id, "$")
+		if !ok {
+			return
+		}
+		refKind, ok := refs[name]
+		switch {
+		case !ok:
+			v.Add(field, "%s is not the ref of an earlier create operation", id)
+		case refKind != kind:
+			v.Add(field, "%s refers to a %s, not a %s", id, refKind, kind)
+		}
+	}
+	checkRef("id", op.ID, op.Kind)
+	checkRef("parent_id", op.ParentID, "todo")
+	for i, id := range op.LinkedTodos {
+		checkRef(fmt.Sprintf("linked_todos[%d]", i), id, "todo")
+	}
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	return h.quota.CheckItem(op.Description, op.Tags)
+}
+
+// apply makes the change of op to items, recording the outcome in result.
+// Deleted todos are removed from the memos linking them, out of linking and
+// the memos changed so far. Errors other than Internal ones are problems with
+// the operation.
+func (h *BatchHandler) apply(items *txItems, userID string, op BatchOperation, created map[string]string, linking []string, result *BatchOperationResult) error {
+	v := validation.New(h.opts)
+	resolve := func(field, id string) string {
+		name, ok := strings.CutPrefix(id, "$")
+		if !ok {
+			return id
+		}
+		if id, ok := created[name]; ok {
+			return id
+		}
+		v.Add(field, "the operation creating %s failed", id)
+		return ""
+	}
+	checkTodo := func(field, id string) error {
+		if id == "" {
+			return nil
+		}
+		item, err := items.get(itemKey{targetType: "todo", id: id})
+		if err == nil && item.todo == nil {
+			v.Add(field, "todo %s does not exist", id)
+		}
+		return err
+	}
+
+	op.ID = resolve("id", op.ID)
+	op.ParentID = resolve("parent_id", op.ParentID)
+	if op.LinkedTodos != nil {
+		linked := make([]string, len(op.LinkedTodos))
+		for i, id := range op.LinkedTodos {
+			linked[i] = resolve(fmt.Sprintf("linked_todos[%d]", i), id)
+		}
+		op.LinkedTodos = linked
+	}
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	// The item the operation changes, as changed by earlier operations
+	key := itemKey{targetType: op.Kind, id: op.ID}
+	var current journalItem
+	if op.Op != "create" {
+		var err error
+		if current, err = items.get(key); err != nil {
+			return err
+		}
+		if current == (journalItem{}) {
+			return apperr.Errorf(apperr.NotFound, "%s %s not found", op.Kind, op.ID)
+		}
+	}
+
+	// Referenced todos must exist by now
+	if err := checkTodo("parent_id", op.ParentID); err != nil {
+		return err
+	}
+	for i, id := range op.LinkedTodos {
+		if err := checkTodo(fmt.Sprintf("linked_todos[%d]", i), id); err != nil {
+			return err
+		}
+	}
+	if err := v.Err(); err != nil {
+		return err
+	}
+
+	// Items are copied before changing them, as items keeps their earlier
+	// versions
+	var changed journalItem
+	switch {
+	case op.Op == "create" && op.Kind == "todo":
+		changed.todo = newTodo(userID, op.todoCreateArgs())
+		key.id = changed.todo.ID
+	case op.Op == "create":
+		changed.memo = newMemo(userID, op.memoCreateArgs())
+		key.id = changed.memo.ID
+	case op.Op == "update" && op.Kind == "todo":
+		todo := *current.todo
+		updateTodo(&todo, op.todoUpdateArgs())
+		changed.todo = &todo
+	case op.Op == "update":
+		memo := *current.memo
+		updateMemo(&memo, op.memoUpdateArgs())
+		changed.memo = &memo
+	case op.Kind == "todo":
+		if _, err := items.unlinkTodo(op.ID, linking); err != nil {
+			return err
+		}
+	}
+	items.set(key, changed)
+
+	result.ID = key.id
+	result.Status = BatchApplied
+	result.Todo, result.Memo = changed.todo, changed.memo
+	return nil
+}
+
+func (op BatchOperation) todoCreateArgs() TodoCreateArgs {
+	return TodoCreateArgs{Title: op.Title, Description: op.Description, Status: op.Status, Priority: op.Priority, Tags: op.Tags, ParentID: op.ParentID}
+}
+
+func (op BatchOperation) todoUpdateArgs() TodoUpdateArgs {
+	return TodoUpdateArgs{ID: op.ID, Title: op.Title, Description: op.Description, Status: op.Status, Priority: op.Priority, Tags: op.Tags}
+}
+
+func (op BatchOperation) memoCreateArgs() MemoCreateArgs {
+	return MemoCreateArgs{Title: op.Title, Description: op.Description, Tags: op.Tags, LinkedTodos: op.LinkedTodos}
+}
+
+func (op BatchOperation) memoUpdateArgs() MemoUpdateArgs {
+	return MemoUpdateArgs{ID: op.ID, Title: op.Title, Description: op.Description, Tags: op.Tags, LinkedTodos: op.LinkedTodos}
+}
+
+// newBatchResults returns the results of ops before applying them, with the
+// operations in invalid failed
+func newBatchResults(ops []BatchOperation, invalid []error) []BatchOperationResult {
+	results := make([]BatchOperationResult, len(ops))
+	for i, op := range ops {
+		if invalid[i] != nil {
+			results[i] = failedResult(i, op, invalid[i])
+			continue
+		}
+		results[i] = BatchOperationResult{Index: i, Op: op.Op, Kind: op.Kind, Ref: op.Ref, ID: op.ID}
+	}
+	return results
+}
+
+func failedResult(index int, op BatchOperation, err error) BatchOperationResult {
+	batchErr := &BatchError{
+		Code:    string(apperr.KindOf(err)),
+		Message: err.Error(),
+	}
+	var fieldErrors validation.Errors
+	if errors.As(err, &fieldErrors) {
+		batchErr.Fields = fieldErrors
+	}
+	return BatchOperationResult{Index: index, Op: op.Op, Kind: op.Kind, Ref: op.Ref, ID: op.ID, Status: BatchFailed, Error: batchErr}
+}
+
+// skipApplied marks the operations that have not failed as skipped, undoing
+// what applying them reported
+func skipApplied(results []BatchOperationResult) {
+	for i, result := range results {
+		if result.Status == BatchFailed {
+			continue
+		}
+		if result.Op == "create" {
+			result.ID = ""
+		}
+		result.Status, result.Todo, result.Memo = BatchSkipped, nil, nil
+		results[i] = result
+	}
+}
+
+func batchResult(results []BatchOperationResult) (*mcp.CallToolResultFor[BatchResult], error) {
+	result := BatchResult{Results: results}
+	for _, r := range results {
+		switch r.Status {
+		case BatchApplied:
+			result.Applied++
+		case BatchFailed:
+			result.Failed++
+		}
+	}
+	result.Success = result.Failed == 0
+	switch {
+	case result.Success:
+		result.Message = fmt.Sprintf("Applied %d operations", result.Applied)
+	case result.Applied == 0:
+		result.Message = fmt.Sprintf("%d of %d operations failed; nothing was changed", result.Failed, len(results))
+	default:
+		result.Message = fmt.Sprintf("Applied %d operations; %d failed", result.Applied, result.Failed)
+	}
+
+	// Convert to JSON
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return &mcp.CallToolResultFor[BatchResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/audit"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/config"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+func newBatchTestHandlers(t *testing.T) (*Handlers, *MockStorage) {
+	t.Helper()
+	h, mockStorage := newJournalTestHandlers(t)
+	h.Batch = NewBatchHandler(mockStorage)
+	h.Batch.SetJournal(h.Todo.journal)
+	return h, mockStorage
+}
+
+func TestBatch_References(t *testing.T) {
+	h, mockStorage := newBatchTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	result, err := callTool[BatchResult](t, ctx, h, "batch", `{"operations":[
+		{"op":"create","kind":"todo","ref":"release","title":"Release v2"},
+		{"op":"create","kind":"todo","title":"Write notes","parent_id":"$release"},
+		{"op":"create","kind":"memo","title":"Release plan","linked_todos":["$release","test-todo-1"]},
+		{"op":"update","kind":"todo","id":"$release","status":"in_progress"},
+		{"op":"delete","kind":"memo","id":"test-memo-1"}
+	]}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.Success || result.Applied != 5 {
+		t.Fatalf("Expected every operation to be applied, got %+v", result)
+	}
+
+	release := result.Results[0].ID
+	if todo, err := mockStorage.GetTodo(ctx, release); err != nil || todo.Status != "in_progress" {
+		t.Errorf("Expected the created todo to be updated, got %+v, %v", todo, err)
+	}
+	if todo, _ := mockStorage.GetTodo(ctx, result.Results[1].ID); todo == nil || todo.ParentID != release {
+		t.Errorf("Expected the subtodo of the created todo, got %+v", todo)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, result.Results[2].ID); memo == nil || memo.LinkedTodos[0] != release {
+		t.Errorf("Expected the memo to link the created todo, got %+v", memo)
+	}
+	if _, err := mockStorage.GetMemo(ctx, "test-memo-1"); !apperr.Is(err, apperr.NotFound) {
+		t.Errorf("Expected the memo to be deleted, got %v", err)
+	}
+
+	// The batch is undone as a whole
+	undo, err := callTool[UndoResult](t, ctx, h, "undo", `{}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(undo.Operations) != 1 || undo.Operations[0].Tool != "batch" {
+		t.Errorf("Unexpected operations: %+v", undo.Operations)
+	}
+	if _, err := mockStorage.GetTodo(ctx, release); !apperr.Is(err, apperr.NotFound) {
+		t.Errorf("Expected the created todo to be deleted, got %v", err)
+	}
+	if _, err := mockStorage.GetMemo(ctx, "test-memo-1"); err != nil {
+		t.Errorf("Expected the memo to be restored, got %v", err)
+	}
+}
+
+func TestBatch_AllOrNothing(t *testing.T) {
+	h, mockStorage := newBatchTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	operations := `[
+		{"op":"update","kind":"todo","id":"test-todo-1","title":"Renamed"},
+		{"op":"delete","kind":"todo","id":"missing-todo"},
+		{"op":"create","kind":"memo","title":"Notes"}
+	]`
+
+	result, err := callTool[BatchResult](t, ctx, h, "batch", `{"all_or_nothing":true,"operations":`+operations+`}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Success || result.Applied != 0 || result.Failed != 1 {
+		t.Fatalf("Expected nothing to be applied, got %+v", result)
+	}
+	if got := result.Results[1]; got.Status != BatchFailed || got.Error.Code != string(apperr.NotFound) {
+		t.Errorf("Expected the delete to fail as not found, got %+v", got)
+	}
+	if result.Results[0].Status != BatchSkipped || result.Results[2].Status != BatchSkipped || result.Results[2].ID != "" {
+		t.Errorf("Expected the other operations to be skipped, got %+v", result.Results)
+	}
+	if todo, _ := mockStorage.GetTodo(ctx, "test-todo-1"); todo.Title == "Renamed" {
+		t.Errorf("Expected the todo to be left as is")
+	}
+	if _, err := callTool[UndoResult](t, ctx, h, "undo", `{}`); !apperr.Is(err, apperr.NotFound) {
+		t.Errorf("Expected nothing to undo, got %v", err)
+	}
+
+	// Without all_or_nothing the others are applied
+	result, err = callTool[BatchResult](t, ctx, h, "batch", `{"operations":`+operations+`}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Success || result.Applied != 2 || result.Failed != 1 {
+		t.Fatalf("Expected two operations to be applied, got %+v", result)
+	}
+	if todo, _ := mockStorage.GetTodo(ctx, "test-todo-1"); todo.Title != "Renamed" {
+		t.Errorf("Expected the todo to be renamed, got %q", todo.Title)
+	}
+}
+
+func TestBatch_InvalidOperations(t *testing.T) {
+	h, _ := newBatchTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	result, err := callTool[BatchResult](t, ctx, h, "batch", `{"operations":[
+		{"op":"create","kind":"memo","title":"Notes","status":"done"},
+		{"op":"update","kind":"todo","id":"$unknown","title":"Renamed"},
+		{"op":"create","kind":"memo","ref":"notes","title":"More notes"},
+		{"op":"create","kind":"todo","title":"Child","parent_id":"$notes"},
+		{"op":"update","kind":"memo","id":"test-memo-1","linked_todos":["test-todo-3"]}
+	]}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	wantFields := map[int]string{0: "status", 1: "id", 3: "parent_id", 4: "linked_todos[0]"}
+	for i, field := range wantFields {
+		got := result.Results[i]
+		if got.Status != BatchFailed || len(got.Error.Fields) != 1 || got.Error.Fields[0].Field != field {
+			t.Errorf("Expected operation %d to fail on %s, got %+v", i, field, got)
+		}
+	}
+	if result.Results[2].Status != BatchApplied {
+		t.Errorf("Expected the valid operation to be applied, got %+v", result.Results[2])
+	}
+
+	// Todos of other users cannot be changed
+	otherCtx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-2")
+	result, err = callTool[BatchResult](t, otherCtx, h, "batch", `{"operations":[{"op":"delete","kind":"todo","id":"test-todo-1"}]}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Results[0].Error == nil || result.Results[0].Error.Code != string(apperr.NotFound) {
+		t.Errorf("Expected the todo of another user to be not found, got %+v", result.Results[0])
+	}
+
+	if _, err := callTool[BatchResult](t, ctx, h, "batch", `{"operations":[]}`); !apperr.Is(err, apperr.Validation) {
+		t.Errorf("Expected a validation error for no operations, got %v", err)
+	}
+}
+
+func TestBatch_Quota(t *testing.T) {
+	h, mockStorage := newBatchTestHandlers(t)
+	h.Batch.SetQuota(NewQuota(mockStorage, config.QuotaConfig{MaxMemos: 3}))
+	h.Batch.SetAudit(audit.NewRecorder(mockStorage))
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	// test-user-1 has two memos already
+	_, err := callTool[BatchResult](t, ctx, h, "batch", `{"operations":[
+		{"op":"create","kind":"memo","title":"One"},
+		{"op":"create","kind":"memo","title":"Two"}
+	]}`)
+	if !apperr.Is(err, apperr.QuotaExceeded) {
+		t.Errorf("Expected the quota to be exceeded, got %v", err)
+	}
+	if _, err := callTool[BatchResult](t, ctx, h, "batch", `{"operations":[{"op":"create","kind":"memo","title":"One"}]}`); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestBatch_BindPartial(t *testing.T) {
+	h, mockStorage := newBatchTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	tool := LookupTool("batch").Bind(h)
+	call := func(operations ...map[string]any) *mcp.CallToolResult {
+		t.Helper()
+		ops := make([]any, len(operations))
+		for i, op := range operations {
+			ops[i] = op
+		}
+		result, err := tool.Handler(ctx, nil, &mcp.CallToolParamsFor[map[string]any]{
+			Arguments: map[string]any{"operations": ops},
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		return result
+	}
+	missing := map[string]any{"op": "delete", "kind": "todo", "id": "missing"}
+
+	// Applied in part: not an error, and the results are returned
+	result := call(map[string]any{"op": "create", "kind": "todo", "title": "Draft"}, missing)
+	structured, _ := result.StructuredContent.(map[string]any)
+	if result.IsError || structured == nil {
+		t.Fatalf("Expected a result with structured content, got isError=%v structured=%#v", result.IsError, result.StructuredContent)
+	}
+	if structured["success"] != false || structured["applied"] != float64(1) || structured["failed"] != float64(1) {
+		t.Errorf("Expected one operation applied and one failed, got %v", structured)
+	}
+	if results, _ := structured["results"].([]any); len(results) != 2 {
+		t.Errorf("Expected the result of every operation, got %v", structured["results"])
+	}
+	if todos, _ := mockStorage.ListTodos(ctx, storage.TodoFilters{UserID: "test-user-1"}); len(todos) != 3 {
+		t.Errorf("Expected the created todo to be kept, got %d todos", len(todos))
+	}
+
+	// Nothing applied: an error, still with the results
+	result = call(missing)
+	if !result.IsError || result.StructuredContent == nil {
+		t.Errorf("Expected an error with structured content, got isError=%v structured=%#v", result.IsError, result.StructuredContent)
+	}
+}
@@ -0,0 +1,397 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/audit"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+	"github.com/pankona/memoya/internal/validation"
+)
+
+const (
+	// MaxBulkUpdateItems is the most items one bulk update may change
+	MaxBulkUpdateItems = 200
+	// bulkConfirmationTTL is how long the confirmation of a preview is valid
+	bulkConfirmationTTL = 10 * time.Minute
+)
+
+// TodoBulkFilter selects todos like the arguments of todo_list
+type TodoBulkFilter struct {
+	Status   string   `json:"status,omitempty"`
+	Priority string   `json:"priority,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+}
+
+// TodoBulkPatch is the change made to every selected todo
+type TodoBulkPatch struct {
+	Status     string   `json:"status,omitempty"`
+	Priority   string   `json:"priority,omitempty"`
+	AddTags    []string `json:"add_tags,omitempty"`
+	RemoveTags []string `json:"remove_tags,omitempty"`
+}
+
+// TodoBulkUpdateArgs represents arguments for updating todos by filter
+type TodoBulkUpdateArgs struct {
+	Filter            TodoBulkFilter `json:"filter"`
+	Patch             TodoBulkPatch  `json:"patch"`
+	DryRun            bool           `json:"dry_run,omitempty"`
+	ConfirmationToken string         `json:"confirmation_token,omitempty"`
+}
+
+// MemoBulkFilter selects memos like the arguments of search limited to memos
+type MemoBulkFilter struct {
+	Query string   `json:"query,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+// MemoBulkPatch is the change made to every selected memo
+type MemoBulkPatch struct {
+	AddTags    []string `json:"add_tags,omitempty"`
+	RemoveTags []string `json:"remove_tags,omitempty"`
+}
+
+// MemoBulkUpdateArgs represents arguments for updating memos by filter
+type MemoBulkUpdateArgs struct {
+	Filter            MemoBulkFilter `json:"filter"`
+	Patch             MemoBulkPatch  `json:"patch"`
+	DryRun            bool           `json:"dry_run,omitempty"`
+	ConfirmationToken string         `json:"confirmation_token,omitempty"`
+}
+
+// BulkUpdateResult represents the result of the bulk update tools
+type BulkUpdateResult struct {
+	Success bool     `json:"success"`
+	DryRun  bool     `json:"dry_run"`
+	IDs     []string `json:"ids"` // Items the patch changes; unchanged matches are left out
+	// ConfirmationToken applies the previewed update when passed back
+	ConfirmationToken string `json:"confirmation_token,omitempty"`
+	Message           string `json:"message"`
+}
+
+// BulkUpdate changes every todo matching a filter in one transaction. A
+// dry run previews the todos changed and returns the confirmation token
+// needed to apply the update, which is refused if they changed since.
+func (h *TodoHandler) BulkUpdate(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[TodoBulkUpdateArgs]) (*mcp.CallToolResultFor[BulkUpdateResult], error) {
+	args := params.Arguments
+
+	if h.storage == nil {
+		return nil, fmt.Errorf("storage not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	v := validation.New(h.opts)
+	v.OneOf("filter.status", args.Filter.Status, todoStatuses...)
+	v.OneOf("filter.priority", args.Filter.Priority, todoPriorities...)
+	args.Filter.Tags = v.Tags("filter.tags", args.Filter.Tags)
+	v.OneOf("patch.status", args.Patch.Status, todoStatuses...)
+	v.OneOf("patch.priority", args.Patch.Priority, todoPriorities...)
+	args.Patch.AddTags = v.Tags("patch.add_tags", args.Patch.AddTags)
+	args.Patch.RemoveTags = v.Tags("patch.remove_tags", args.Patch.RemoveTags)
+	if args.Patch.Status == "" && args.Patch.Priority == "" && len(args.Patch.AddTags) == 0 && len(args.Patch.RemoveTags) == 0 {
+		v.Add("patch", "must change the status, priority or tags")
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	filters := storage.TodoFilters{UserID: userID, Tags: args.Filter.Tags}
+	if args.Filter.Status != "" {
+		status := models.TodoStatus(args.Filter.Status)
+		filters.Status = &status
+	}
+	if args.Filter.Priority != "" {
+		priority := models.TodoPriority(args.Filter.Priority)
+		filters.Priority = &priority
+	}
+	todos, err := h.storage.ListTodos(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list todos: %w", err)
+	}
+
+	update := bulkUpdate{
+		storage:  h.storage,
+		audit:    h.audit,
+		journal:  h.journal,
+		kind:     "todo",
+		userID:   userID,
+		selector: []any{args.Filter, args.Patch},
+	}
+	for _, todo := range todos {
+		after := *todo
+		if !patchTodo(&after, args.Patch) {
+			continue
+		}
+		// Enforce the user's quota on the new tags
+		if err := h.quota.CheckItem(after.Description, after.Tags); err != nil {
+			return nil, fmt.Errorf("todo %s: %w", todo.ID, err)
+		}
+		update.changes = append(update.changes, bulkChange{
+			key:    itemKey{targetType: "todo", id: todo.ID},
+			before: journalItem{todo: todo},
+			after:  journalItem{todo: &after},
+		})
+	}
+	return update.run(ctx, args.DryRun, args.ConfirmationToken)
+}
+
+// BulkUpdate changes every memo matching a filter in one transaction, with
+// a dry run like the todo bulk update
+func (h *MemoHandler) BulkUpdate(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[MemoBulkUpdateArgs]) (*mcp.CallToolResultFor[BulkUpdateResult], error) {
+	args := params.Arguments
+
+	if h.storage == nil {
+		return nil, fmt.Errorf("storage not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	v := validation.New(h.opts)
+	args.Filter.Tags = v.Tags("filter.tags", args.Filter.Tags)
+	args.Patch.AddTags = v.Tags("patch.add_tags", args.Patch.AddTags)
+	args.Patch.RemoveTags = v.Tags("patch.remove_tags", args.Patch.RemoveTags)
+	if len(args.Patch.AddTags) == 0 && len(args.Patch.RemoveTags) == 0 {
+		v.Add("patch", "must change the tags")
+	}
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	results, err := h.storage.Search(ctx, args.Filter.Query, storage.SearchFilters{UserID: userID, Type: "memo", Tags: args.Filter.Tags})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search memos: %w", err)
+	}
+	memos := results.Memos
+
+	update := bulkUpdate{
+		storage:  h.storage,
+		audit:    h.audit,
+		journal:  h.journal,
+		kind:     "memo",
+		userID:   userID,
+		selector: []any{args.Filter, args.Patch},
+	}
+	for _, memo := range memos {
+		after := *memo
+		tags := patchTags(memo.Tags, args.Patch.AddTags, args.Patch.RemoveTags)
+		if slices.Equal(tags, memo.Tags) {
+			continue
+		}
+		after.Tags, after.LastModified = tags, time.Now()
+		// Enforce the user's quota on the new tags
+		if err := h.quota.CheckItem(after.Description, after.Tags); err != nil {
+			return nil, fmt.Errorf("memo %s: %w", memo.ID, err)
+		}
+		update.changes = append(update.changes, bulkChange{
+			key:    itemKey{targetType: "memo", id: memo.ID},
+			before: journalItem{memo: memo},
+			after:  journalItem{memo: &after},
+		})
+	}
+	return update.run(ctx, args.DryRun, args.ConfirmationToken)
+}
+
+// patchTodo applies patch to todo, reporting whether it changed anything
+func patchTodo(todo *models.Todo, patch TodoBulkPatch) bool {
+	tags := patchTags(todo.Tags, patch.AddTags, patch.RemoveTags)
+	if (patch.Status == "" || models.TodoStatus(patch.Status) == todo.Status) &&
+		(patch.Priority == "" || models.TodoPriority(patch.Priority) == todo.Priority) &&
+		slices.Equal(tags, todo.Tags) {
+		return false
+	}
+	updateTodo(todo, TodoUpdateArgs{Status: patch.Status, Priority: patch.Priority})
+	todo.Tags = tags
+	return true
+}
+
+// patchTags returns tags without remove and with add appended
+func patchTags(tags, add, remove []string) []string {
+	patched := make([]string, 0, len(tags)+len(add))
+	for _, tag := range tags {
+		if !slices.Contains(remove, tag) {
+			patched = append(patched, tag)
+		}
+	}
+	for _, tag := range add {
+		if !slices.Contains(patched, tag) {
+			patched = append(patched, tag)
+		}
+	}
+	return patched
+}
+
+// bulkChange is an item a bulk update changes
+type bulkChange struct {
+	key           itemKey
+	before, after journalItem
+}
+
+// bulkUpdate previews or applies the changes of a bulk update
+type bulkUpdate struct {
+	storage storage.Storage
+	audit   *audit.Recorder
+	journal *Journal
+	kind    string // "todo" or "memo"
+	userID  string
+	// selector is the normalized filter and patch, which confirmations are
+	// bound to along with the items changed
+	selector any
+	changes  []bulkChange
+}
+
+func (u *bulkUpdate) run(ctx context.Context, dryRun bool, token string) (*mcp.CallToolResultFor[BulkUpdateResult], error) {
+	if len(u.changes) > MaxBulkUpdateItems {
+		v := validation.New(validation.Options{})
+		v.Add("filter", "matches %d %ss to change, more than the limit of %d; narrow it down", len(u.changes), u.kind, MaxBulkUpdateItems)
+		return nil, v.Err()
+	}
+
+	// Confirmations cover the items in order, whatever order storage lists
+	// them in
+	slices.SortFunc(u.changes, func(a, b bulkChange) int {
+		return strings.Compare(a.key.id, b.key.id)
+	})
+
+	result := BulkUpdateResult{
+		Success: true,
+		DryRun:  dryRun,
+		IDs:     make([]string, len(u.changes)),
+	}
+	for i, change := range u.changes {
+		result.IDs[i] = change.key.id
+	}
+
+	if dryRun {
+		result.ConfirmationToken = u.confirmation(time.Now().Add(bulkConfirmationTTL))
+		result.Message = fmt.Sprintf("%d %ss would be changed; pass the confirmation token within %s to apply the update", len(u.changes), u.kind, bulkConfirmationTTL)
+		return bulkUpdateResult(result)
+	}
+
+	if token == "" {
+		v := validation.New(validation.Options{})
+		v.Add("confirmation_token", "is required; preview the update with dry_run first")
+		return nil, v.Err()
+	}
+	if !u.confirms(token) {
+		return nil, apperr.Errorf(apperr.Conflict, "the %ss matching the filter changed since the preview, or the confirmation expired; preview the update again", u.kind)
+	}
+
+	var items *txItems
+	err := u.storage.RunTransaction(ctx, u.userID, func(tx storage.Tx) error {
+		items = newTxItems(tx)
+		for _, change := range u.changes {
+			current, err := items.get(change.key)
+			if err != nil {
+				return err
+			}
+			if !current.sameVersion(change.before) {
+				return apperr.Errorf(apperr.Conflict, "%s %s was changed during the update; preview the update again", u.kind, change.key.id)
+			}
+			items.set(change.key, change.after)
+		}
+		return items.write()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The update is one operation, undone as a whole
+	u.journal.Record(ctx, u.userID, items.steps()...)
+	items.recordAudit(ctx, u.audit, u.userID)
+
+	result.Message = fmt.Sprintf("Updated %d %ss", len(u.changes), u.kind)
+	return bulkUpdateResult(result)
+}
+
+// bulkConfirmationPurpose keys the MACs of bulk update confirmations
+const bulkConfirmationPurpose = "bulk update confirmation"
+
+// confirmation returns a token confirming the update until expires. It is a
+// MAC by the server's signing keys of the update and the versions of the
+// items it changes, so it cannot be forged and stops matching once any of
+// them changes.
+func (u *bulkUpdate) confirmation(expires time.Time) string {
+	mac := auth.SigningKeys().MAC(bulkConfirmationPurpose, u.confirmed(expires))
+	return strconv.FormatInt(expires.Unix(), 10) + "." + base64.RawURLEncoding.EncodeToString(mac)
+}
+
+// confirms reports whether token is an unexpired confirmation of the update
+func (u *bulkUpdate) confirms(token string) bool {
+	unix, encoded, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	seconds, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil {
+		return false
+	}
+	expires := time.Unix(seconds, 0)
+	if !time.Now().Before(expires) {
+		return false
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return false
+	}
+	return auth.SigningKeys().VerifyMAC(bulkConfirmationPurpose, u.confirmed(expires), mac)
+}
+
+// confirmed returns what a confirmation until expires confirms
+func (u *bulkUpdate) confirmed(expires time.Time) []byte {
+	selector, err := json.Marshal(u.selector)
+	if err != nil {
+		panic(err)
+	}
+
+	var data bytes.Buffer
+	fmt.Fprintf(&data, "%s\n%s\n%d\n%s\n", u.userID, u.kind, expires.Unix(), selector)
+	for _, change := range u.changes {
+		fmt.Fprintf(&data, "%s@%d\n", change.key.id, change.before.lastModified().UnixNano())
+	}
+	return data.Bytes()
+}
+
+// lastModified returns when the item was last changed
+func (a journalItem) lastModified() time.Time {
+	switch {
+	case a.todo != nil:
+		return a.todo.LastModified
+	case a.memo != nil:
+		return a.memo.LastModified
+	}
+	return time.Time{}
+}
+
+func bulkUpdateResult(result BulkUpdateResult) (*mcp.CallToolResultFor[BulkUpdateResult], error) {
+	// Convert to JSON
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return &mcp.CallToolResultFor[BulkUpdateResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
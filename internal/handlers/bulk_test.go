@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+)
+
+func TestTodoHandler_BulkUpdate(t *testing.T) {
+	h, mockStorage := newJournalTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	args := `{"filter":{"tags":["work"]},"patch":{"status":"done","add_tags":["archived"],"remove_tags":["urgent"]}`
+
+	preview, err := callTool[BulkUpdateResult](t, ctx, h, "todo_bulk_update", args+`,"dry_run":true}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !slices.Equal(preview.IDs, []string{"test-todo-1"}) || preview.ConfirmationToken == "" {
+		t.Fatalf("Unexpected preview: %+v", preview)
+	}
+	if todo, _ := mockStorage.GetTodo(ctx, "test-todo-1"); todo.Status != models.StatusTodo {
+		t.Errorf("Expected the dry run to change nothing, got %+v", todo)
+	}
+
+	if _, err := callTool[BulkUpdateResult](t, ctx, h, "todo_bulk_update", args+`}`); !apperr.Is(err, apperr.Validation) {
+		t.Errorf("Expected a validation error without confirmation, got %v", err)
+	}
+
+	result, err := callTool[BulkUpdateResult](t, ctx, h, "todo_bulk_update", args+`,"confirmation_token":"`+preview.ConfirmationToken+`"}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !slices.Equal(result.IDs, []string{"test-todo-1"}) {
+		t.Errorf("Unexpected result: %+v", result)
+	}
+	todo, _ := mockStorage.GetTodo(ctx, "test-todo-1")
+	if todo.Status != models.StatusDone || todo.ClosedAt == nil || !slices.Equal(todo.Tags, []string{"work", "archived"}) {
+		t.Errorf("Expected the todo to be updated, got %+v", todo)
+	}
+
+	// The confirmation does not apply once the todos changed
+	if _, err := callTool[BulkUpdateResult](t, ctx, h, "todo_bulk_update", args+`,"confirmation_token":"`+preview.ConfirmationToken+`"}`); !apperr.Is(err, apperr.Conflict) {
+		t.Errorf("Expected a conflict for a stale confirmation, got %v", err)
+	}
+
+	// The update is undone as a whole
+	if _, err := callTool[UndoResult](t, ctx, h, "undo", `{}`); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if todo, _ := mockStorage.GetTodo(ctx, "test-todo-1"); todo.Status != models.StatusTodo || !slices.Equal(todo.Tags, []string{"work", "urgent"}) {
+		t.Errorf("Expected the todo to be restored, got %+v", todo)
+	}
+}
+
+func TestTodoHandler_BulkUpdateConfirmation(t *testing.T) {
+	h, _ := newJournalTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	preview, err := callTool[BulkUpdateResult](t, ctx, h, "todo_bulk_update", `{"filter":{},"patch":{"priority":"high"},"dry_run":true}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	// test-todo-1 is already high
+	if !slices.Equal(preview.IDs, []string{"test-todo-2"}) {
+		t.Fatalf("Expected only the changed todo, got %+v", preview.IDs)
+	}
+
+	// The expiry is part of what the token authenticates
+	unix, mac, _ := strings.Cut(preview.ConfirmationToken, ".")
+	seconds, _ := strconv.ParseInt(unix, 10, 64)
+	extended := strconv.FormatInt(seconds+3600, 10) + "." + mac
+
+	args := `{"filter":{},"patch":{"priority":"high"}`
+	tests := []struct {
+		name  string
+		args  string
+		token string
+	}{
+		{"other patch", `{"filter":{},"patch":{"priority":"normal"}`, preview.ConfirmationToken},
+		{"other filter", `{"filter":{"status":"in_progress"},"patch":{"priority":"high"}`, preview.ConfirmationToken},
+		{"malformed token", args, "not-a-token"},
+		{"extended expiry", args, extended},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := callTool[BulkUpdateResult](t, ctx, h, "todo_bulk_update", tt.args+`,"confirmation_token":"`+tt.token+`"}`); !apperr.Is(err, apperr.Conflict) {
+				t.Errorf("Expected a conflict, got %v", err)
+			}
+		})
+	}
+
+	// Other users do not see the todos
+	otherCtx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-2")
+	if result, err := callTool[BulkUpdateResult](t, otherCtx, h, "todo_bulk_update", `{"filter":{},"patch":{"priority":"high"},"dry_run":true}`); err != nil || len(result.IDs) != 0 {
+		t.Errorf("Expected nothing to change for another user, got %+v, %v", result, err)
+	}
+
+	if _, err := callTool[BulkUpdateResult](t, ctx, h, "todo_bulk_update", `{"filter":{},"patch":{},"dry_run":true}`); !apperr.Is(err, apperr.Validation) {
+		t.Errorf("Expected a validation error for an empty patch, got %v", err)
+	}
+}
+
+func TestMemoHandler_BulkUpdate(t *testing.T) {
+	h, mockStorage := newJournalTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	args := `{"filter":{"tags":["work"]},"patch":{"remove_tags":["work"]}`
+
+	preview, err := callTool[BulkUpdateResult](t, ctx, h, "memo_bulk_update", args+`,"dry_run":true}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !slices.Equal(preview.IDs, []string{"test-memo-1"}) {
+		t.Fatalf("Unexpected preview: %+v", preview)
+	}
+
+	if _, err := callTool[BulkUpdateResult](t, ctx, h, "memo_bulk_update", args+`,"confirmation_token":"`+preview.ConfirmationToken+`"}`); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, "test-memo-1"); !slices.Equal(memo.Tags, []string{"notes"}) {
+		t.Errorf("Expected the tag to be removed, got %v", memo.Tags)
+	}
+}
+
+func TestMemoHandler_BulkUpdateQuery(t *testing.T) {
+	h, mockStorage := newJournalTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	mockStorage.CreateMemo(ctx, &models.Memo{ID: "sprint-memo", UserID: "test-user-1", Title: "Sprint 12 retro", Tags: []string{"work"}})
+	args := `{"filter":{"query":"Sprint","tags":["work"]},"patch":{"add_tags":["archived"]}`
+
+	// Memos are selected by keyword as well as by tags, like search
+	preview, err := callTool[BulkUpdateResult](t, ctx, h, "memo_bulk_update", args+`,"dry_run":true}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !slices.Equal(preview.IDs, []string{"sprint-memo"}) {
+		t.Fatalf("Expected only the memo matching the query, got %+v", preview.IDs)
+	}
+
+	if _, err := callTool[BulkUpdateResult](t, ctx, h, "memo_bulk_update", args+`,"confirmation_token":"`+preview.ConfirmationToken+`"}`); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, "sprint-memo"); !slices.Equal(memo.Tags, []string{"work", "archived"}) {
+		t.Errorf("Expected the tag to be added, got %v", memo.Tags)
+	}
+}
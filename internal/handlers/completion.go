@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+// maxCompletionValues is the maximum number of values allowed by the MCP spec
+const maxCompletionValues = 100
+
+type CompletionHandler struct {
+	storage storage.Storage
+}
+
+func NewCompletionHandler(storage storage.Storage) *CompletionHandler {
+	return &CompletionHandler{
+		storage: storage,
+	}
+}
+
+// Complete suggests values for prompt and resource template arguments.
+// Arguments are recognized by name, so the same names used by tools
+// (tags, status, priority, parent_id, ...) complete the same way.
+func (h *CompletionHandler) Complete(ctx context.Context, ss *mcp.ServerSession, params *mcp.CompleteParams) (*mcp.CompleteResult, error) {
+	if h.storage == nil {
+		return nil, fmt.Errorf("storage not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+	if err := auth.RequireScope(ctx, auth.ScopeTodosRead, auth.ScopeMemosRead); err != nil {
+		return nil, err
+	}
+
+	value := params.Argument.Value
+	var values []string
+
+	switch params.Argument.Name {
+	case "tag":
+		values, err = h.completeTags(ctx, userID, value)
+	case "tags":
+		// Comma-separated list: complete the last tag and keep the others
+		head, last := "", value
+		if i := strings.LastIndex(value, ","); i >= 0 {
+			head, last = value[:i+1], value[i+1:]
+		}
+		values, err = h.completeTags(ctx, userID, strings.TrimSpace(last))
+		for i, v := range values {
+			values[i] = head + v
+		}
+	case "id":
+		if params.Ref != nil && params.Ref.Type == "ref/resource" && strings.HasPrefix(params.Ref.URI, "memoya://memo/") {
+			values, err = h.completeMemoIDs(ctx, userID, value)
+		} else {
+			values, err = h.completeTodoIDs(ctx, userID, value)
+		}
+	case "parent_id", "linked_todos":
+		values, err = h.completeTodoIDs(ctx, userID, value)
+	case "status":
+		values = completeFixed(value, string(models.StatusBacklog), string(models.StatusTodo), string(models.StatusInProgress), string(models.StatusDone))
+	case "priority":
+		values = completeFixed(value, string(models.PriorityHigh), string(models.PriorityNormal))
+	case "type":
+		values = completeFixed(value, "todo", "memo", "all")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return completeResult(values), nil
+}
+
+// completeTags returns tags starting with prefix, most used first
+func (h *CompletionHandler) completeTags(ctx context.Context, userID, prefix string) ([]string, error) {
+	tags, err := h.storage.GetAllTags(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	todos, err := h.storage.ListTodos(ctx, storage.TodoFilters{UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list todos: %w", err)
+	}
+	memos, err := h.storage.ListMemos(ctx, storage.MemoFilters{UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memos: %w", err)
+	}
+
+	usage := make(map[string]int)
+	for _, todo := range todos {
+		for _, tag := range todo.Tags {
+			usage[tag]++
+		}
+	}
+	for _, memo := range memos {
+		for _, tag := range memo.Tags {
+			usage[tag]++
+		}
+	}
+
+	var values []string
+	for _, tag := range tags {
+		if hasPrefixFold(tag, prefix) {
+			values = append(values, tag)
+		}
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		if usage[values[i]] != usage[values[j]] {
+			return usage[values[i]] > usage[values[j]]
+		}
+		return values[i] < values[j]
+	})
+
+	return values, nil
+}
+
+// completeTodoIDs returns IDs of todos whose title (or ID) starts with prefix,
+// most recently modified first
+func (h *CompletionHandler) completeTodoIDs(ctx context.Context, userID, prefix string) ([]string, error) {
+	todos, err := h.storage.ListTodos(ctx, storage.TodoFilters{UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list todos: %w", err)
+	}
+
+	sort.SliceStable(todos, func(i, j int) bool {
+		return todos[i].LastModified.After(todos[j].LastModified)
+	})
+
+	var values []string
+	for _, todo := range todos {
+		if hasPrefixFold(todo.Title, prefix) || strings.HasPrefix(todo.ID, prefix) {
+			values = append(values, todo.ID)
+		}
+	}
+	return values, nil
+}
+
+// completeMemoIDs returns IDs of memos whose title (or ID) starts with prefix,
+// most recently modified first
+func (h *CompletionHandler) completeMemoIDs(ctx context.Context, userID, prefix string) ([]string, error) {
+	memos, err := h.storage.ListMemos(ctx, storage.MemoFilters{UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memos: %w", err)
+	}
+
+	sort.SliceStable(memos, func(i, j int) bool {
+		return memos[i].LastModified.After(memos[j].LastModified)
+	})
+
+	var values []string
+	for _, memo := range memos {
+		if hasPrefixFold(memo.Title, prefix) || strings.HasPrefix(memo.ID, prefix) {
+			values = append(values, memo.ID)
+		}
+	}
+	return values, nil
+}
+
+func completeFixed(prefix string, candidates ...string) []string {
+	var values []string
+	for _, c := range candidates {
+		if hasPrefixFold(c, prefix) {
+			values = append(values, c)
+		}
+	}
+	return values
+}
+
+func completeResult(values []string) *mcp.CompleteResult {
+	result := &mcp.CompleteResult{
+		Completion: mcp.CompletionResultDetails{
+			Values: []string{},
+			Total:  len(values),
+		},
+	}
+	if len(values) > maxCompletionValues {
+		values = values[:maxCompletionValues]
+		result.Completion.HasMore = true
+	}
+	result.Completion.Values = append(result.Completion.Values, values...)
+	return result
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
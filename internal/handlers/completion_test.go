@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/auth"
+)
+
+func complete(t *testing.T, handler *CompletionHandler, ref *mcp.CompleteReference, name, value string) []string {
+	t.Helper()
+
+	params := &mcp.CompleteParams{
+		Ref:      ref,
+		Argument: mcp.CompleteParamsArgument{Name: name, Value: value},
+	}
+
+	// Create context with test user ID
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	result, err := handler.Complete(ctx, nil, params)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	return result.Completion.Values
+}
+
+func TestCompletionHandler_Tags(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	handler := NewCompletionHandler(mockStorage)
+	ref := &mcp.CompleteReference{Type: "ref/prompt", Name: PromptWeeklyReview}
+
+	// "personal" and "work" are used by a todo and a memo each, so they rank first
+	values := complete(t, handler, ref, "tags", "")
+	if !reflect.DeepEqual(values, []string{"personal", "work", "ideas", "notes", "urgent"}) {
+		t.Errorf("Expected tags ranked by usage, got %v", values)
+	}
+
+	values = complete(t, handler, ref, "tags", "U")
+	if !reflect.DeepEqual(values, []string{"urgent"}) {
+		t.Errorf("Expected [urgent], got %v", values)
+	}
+
+	// Only the last tag of a comma-separated list is completed
+	values = complete(t, handler, ref, "tags", "work,no")
+	if !reflect.DeepEqual(values, []string{"work,notes"}) {
+		t.Errorf("Expected [work,notes], got %v", values)
+	}
+}
+
+func TestCompletionHandler_IDs(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	mockStorage.GetTodos()["test-todo-2"].LastModified = time.Now().Add(time.Hour)
+	handler := NewCompletionHandler(mockStorage)
+
+	values := complete(t, handler, &mcp.CompleteReference{Type: "ref/resource", URI: "memoya://todo/{id}"}, "id", "test todo")
+	if !reflect.DeepEqual(values, []string{"test-todo-2", "test-todo-1"}) {
+		t.Errorf("Expected recently modified todo first, got %v", values)
+	}
+
+	values = complete(t, handler, &mcp.CompleteReference{Type: "ref/resource", URI: "memoya://memo/{id}"}, "id", "Test Memo 2")
+	if !reflect.DeepEqual(values, []string{"test-memo-2"}) {
+		t.Errorf("Expected [test-memo-2], got %v", values)
+	}
+}
+
+func TestCompletionHandler_FixedValues(t *testing.T) {
+	handler := NewCompletionHandler(NewMockStorage())
+	ref := &mcp.CompleteReference{Type: "ref/prompt", Name: PromptPlanMyDay}
+
+	tests := []struct {
+		name     string
+		value    string
+		expected []string
+	}{
+		{"status", "in", []string{"in_progress"}},
+		{"status", "", []string{"backlog", "todo", "in_progress", "done"}},
+		{"priority", "h", []string{"high"}},
+		{"type", "m", []string{"memo"}},
+		{"unknown", "x", []string{}},
+	}
+
+	for _, tt := range tests {
+		values := complete(t, handler, ref, tt.name, tt.value)
+		if !reflect.DeepEqual(values, tt.expected) {
+			t.Errorf("%s=%q: expected %v, got %v", tt.name, tt.value, tt.expected, values)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+	"github.com/pankona/memoya/internal/validation"
+)
+
+// MemoPromoteArgs represents arguments for turning a memo into a todo
+type MemoPromoteArgs struct {
+	ID           string `json:"id"`
+	Status       string `json:"status,omitempty"`
+	Priority     string `json:"priority,omitempty"`
+	KeepOriginal bool   `json:"keep_original,omitempty"`
+	DropLinks    bool   `json:"drop_links,omitempty"`
+}
+
+// TodoDemoteArgs represents arguments for turning a todo into a memo
+type TodoDemoteArgs struct {
+	ID           string `json:"id"`
+	KeepOriginal bool   `json:"keep_original,omitempty"`
+	DropLinks    bool   `json:"drop_links,omitempty"`
+}
+
+// ConvertResult represents the result of converting between todos and
+// memos: the item created, and the original if kept
+type ConvertResult struct {
+	Success bool         `json:"success"`
+	Todo    *models.Todo `json:"todo,omitempty"`
+	Memo    *models.Memo `json:"memo,omitempty"`
+	// UnlinkedMemos are the memos the deleted original todo was removed from
+	UnlinkedMemos []string `json:"unlinked_memos,omitempty"`
+	// DroppedLinks are the todos the deleted original memo linked
+	DroppedLinks []string `json:"dropped_links,omitempty"`
+	Message      string   `json:"message"`
+}
+
+// PromoteToTodo creates a todo from a memo, with its title, description,
+// tags and creation time. The todo refers back to the memo, which is
+// deleted unless kept; a kept memo links to the todo. Todos cannot link
+// todos, so a memo linking any is only deleted if its links may be dropped.
+func (h *MemoHandler) PromoteToTodo(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[MemoPromoteArgs]) (*mcp.CallToolResultFor[ConvertResult], error) {
+	args := params.Arguments
+
+	if h.storage == nil {
+		return nil, fmt.Errorf("storage not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	v := validation.New(h.opts)
+	v.OneOf("status", args.Status, todoStatuses...)
+	v.OneOf("priority", args.Priority, todoPriorities...)
+	if err := v.Err(); err != nil {
+		return nil, err
+	}
+
+	// Enforce the user's quota
+	if err := h.quota.CheckNewTodo(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	var items *txItems
+	var result ConvertResult
+	err = h.storage.RunTransaction(ctx, userID, func(tx storage.Tx) error {
+		items = newTxItems(tx)
+		result = ConvertResult{Success: true}
+
+		key := itemKey{targetType: "memo", id: args.ID}
+		original, err := items.get(key)
+		if err != nil {
+			return err
+		}
+		if original.memo == nil {
+			return apperr.Errorf(apperr.NotFound, "memo %s not found", args.ID)
+		}
+		memo := original.memo
+
+		todo := newTodo(userID, TodoCreateArgs{
+			Title:       memo.Title,
+			Description: memo.Description,
+			Status:      args.Status,
+			Priority:    args.Priority,
+			Tags:        slices.Clone(memo.Tags),
+		})
+		todo.CreatedAt = memo.CreatedAt
+		todo.SourceMemoID = memo.ID
+		if todo.Status == models.StatusDone {
+			todo.ClosedAt = memo.ClosedAt
+			if todo.ClosedAt == nil {
+				closedAt := todo.LastModified
+				todo.ClosedAt = &closedAt
+			}
+		}
+		items.set(itemKey{targetType: "todo", id: todo.ID}, journalItem{todo: todo})
+		result.Todo = todo
+
+		switch {
+		case args.KeepOriginal:
+			kept := *memo
+			kept.LinkedTodos = append(slices.Clone(kept.LinkedTodos), todo.ID)
+			kept.LastModified = time.Now()
+			items.set(key, journalItem{memo: &kept})
+			result.Memo = &kept
+		case len(memo.LinkedTodos) > 0 && !args.DropLinks:
+			return apperr.Errorf(apperr.Conflict, "memo %s links %d todos, which the todo cannot link; keep the original memo, or drop the links", memo.ID, len(memo.LinkedTodos))
+		default:
+			items.set(key, journalItem{})
+			result.DroppedLinks = memo.LinkedTodos
+		}
+		return items.write()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The conversion is one operation, undone as a whole
+	h.journal.Record(ctx, userID, items.steps()...)
+	items.recordAudit(ctx, h.audit, userID)
+
+	result.Message = fmt.Sprintf("Memo %s promoted to todo %s", args.ID, result.Todo.ID)
+	if len(result.DroppedLinks) > 0 {
+		result.Message += fmt.Sprintf(", dropping its links to %d todos", len(result.DroppedLinks))
+	}
+	return convertResult(result)
+}
+
+// DemoteToMemo creates a memo from a todo, with its title, description,
+// tags and creation and closing times. The memo refers back to the todo,
+// which is deleted unless kept; a kept todo is linked from the memo. A todo
+// linked from memos is only deleted if the links may be dropped, and is
+// then removed from the memos.
+func (h *TodoHandler) DemoteToMemo(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[TodoDemoteArgs]) (*mcp.CallToolResultFor[ConvertResult], error) {
+	args := params.Arguments
+
+	if h.storage == nil {
+		return nil, fmt.Errorf("storage not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	// Enforce the user's quota
+	if err := h.quota.CheckNewMemo(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	// Transactions cannot query, so the memos linking the todo and its
+	// subtodos are looked up first
+	var linking []string
+	if !args.KeepOriginal {
+		children, err := h.storage.ListTodos(ctx, storage.TodoFilters{UserID: userID, ParentID: &args.ID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list subtodos: %w", err)
+		}
+		if len(children) > 0 {
+			return nil, apperr.Errorf(apperr.Conflict, "todo %s has %d subtodos; move or delete them, or keep the original todo", args.ID, len(children))
+		}
+
+		if linking, err = linkingMemos(ctx, h.storage, userID, args.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	var items *txItems
+	var result ConvertResult
+	err = h.storage.RunTransaction(ctx, userID, func(tx storage.Tx) error {
+		items = newTxItems(tx)
+		result = ConvertResult{Success: true}
+
+		key := itemKey{targetType: "todo", id: args.ID}
+		original, err := items.get(key)
+		if err != nil {
+			return err
+		}
+		if original.todo == nil {
+			return apperr.Errorf(apperr.NotFound, "todo %s not found", args.ID)
+		}
+		todo := original.todo
+
+		memo := newMemo(userID, MemoCreateArgs{
+			Title:       todo.Title,
+			Description: todo.Description,
+			Tags:        slices.Clone(todo.Tags),
+		})
+		memo.CreatedAt = todo.CreatedAt
+		memo.ClosedAt = todo.ClosedAt
+		memo.SourceTodoID = todo.ID
+		result.Memo = memo
+
+		if args.KeepOriginal {
+			memo.LinkedTodos = []string{todo.ID}
+			result.Todo = todo
+		} else {
+			memos, err := items.linkedFrom(todo.ID, linking)
+			if err != nil {
+				return err
+			}
+			if len(memos) > 0 && !args.DropLinks {
+				return apperr.Errorf(apperr.Conflict, "todo %s is linked from %d memos, which cannot link the memo; keep the original todo, or drop the links", todo.ID, len(memos))
+			}
+			items.set(key, journalItem{})
+			if result.UnlinkedMemos, err = items.unlinkTodo(todo.ID, linking); err != nil {
+				return err
+			}
+		}
+		items.set(itemKey{targetType: "memo", id: memo.ID}, journalItem{memo: memo})
+		return items.write()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	steps := items.steps()
+	items.recordAudit(ctx, h.audit, userID)
+	if !args.KeepOriginal {
+		if missed := unlinkDeleted(ctx, h.storage, userID, args.ID); missed != nil {
+			steps = append(steps, missed.steps()...)
+			missed.recordAudit(ctx, h.audit, userID)
+			result.UnlinkedMemos = append(result.UnlinkedMemos, missed.unlinkedMemos()...)
+		}
+	}
+
+	// The conversion is one operation, undone as a whole
+	h.journal.Record(ctx, userID, steps...)
+
+	result.Message = fmt.Sprintf("Todo %s demoted to memo %s", args.ID, result.Memo.ID)
+	if len(result.UnlinkedMemos) > 0 {
+		result.Message += fmt.Sprintf(", unlinking it from %d memos", len(result.UnlinkedMemos))
+	}
+	return convertResult(result)
+}
+
+func convertResult(result ConvertResult) (*mcp.CallToolResultFor[ConvertResult], error) {
+	// Convert to JSON
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return &mcp.CallToolResultFor[ConvertResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
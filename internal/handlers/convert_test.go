@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+func TestMemoHandler_PromoteToTodo(t *testing.T) {
+	h, mockStorage := newJournalTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	memo, _ := mockStorage.GetMemo(ctx, "test-memo-1")
+	original := *memo
+
+	result, err := callTool[ConvertResult](t, ctx, h, "memo_promote_to_todo", `{"id":"test-memo-1","priority":"high","drop_links":true}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	todo := result.Todo
+	if todo.Title != original.Title || todo.Description != original.Description || !slices.Equal(todo.Tags, original.Tags) {
+		t.Errorf("Expected the memo to be copied, got %+v", todo)
+	}
+	if todo.SourceMemoID != "test-memo-1" || !todo.CreatedAt.Equal(original.CreatedAt) || todo.Priority != models.PriorityHigh || todo.Status != models.StatusBacklog {
+		t.Errorf("Unexpected todo: %+v", todo)
+	}
+	if _, err := mockStorage.GetMemo(ctx, "test-memo-1"); !apperr.Is(err, apperr.NotFound) {
+		t.Errorf("Expected the memo to be deleted, got %v", err)
+	}
+	if !slices.Equal(result.DroppedLinks, []string{"test-todo-1"}) {
+		t.Errorf("Expected the link to test-todo-1 to be dropped, got %v", result.DroppedLinks)
+	}
+
+	// Undoing restores the memo and removes the todo
+	if _, err := callTool[UndoResult](t, ctx, h, "undo", `{}`); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := mockStorage.GetMemo(ctx, "test-memo-1"); err != nil {
+		t.Errorf("Expected the memo to be restored, got %v", err)
+	}
+	if _, err := mockStorage.GetTodo(ctx, todo.ID); !apperr.Is(err, apperr.NotFound) {
+		t.Errorf("Expected the todo to be deleted, got %v", err)
+	}
+
+	// A kept memo links to its todo
+	result, err = callTool[ConvertResult](t, ctx, h, "memo_promote_to_todo", `{"id":"test-memo-1","keep_original":true}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if kept, _ := mockStorage.GetMemo(ctx, "test-memo-1"); !slices.Equal(kept.LinkedTodos, []string{"test-todo-1", result.Todo.ID}) {
+		t.Errorf("Expected the kept memo to link the todo, got %v", kept.LinkedTodos)
+	}
+
+	otherCtx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-2")
+	if _, err := callTool[ConvertResult](t, otherCtx, h, "memo_promote_to_todo", `{"id":"test-memo-1"}`); !apperr.Is(err, apperr.NotFound) {
+		t.Errorf("Expected the memo of another user to be not found, got %v", err)
+	}
+}
+
+func TestMemoHandler_PromoteToTodoLinked(t *testing.T) {
+	h, mockStorage := newJournalTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	todos, _ := mockStorage.ListTodos(ctx, storage.TodoFilters{UserID: "test-user-1"})
+
+	// test-memo-1 links test-todo-1, which the todo cannot carry over
+	if _, err := callTool[ConvertResult](t, ctx, h, "memo_promote_to_todo", `{"id":"test-memo-1"}`); !apperr.Is(err, apperr.Conflict) {
+		t.Fatalf("Expected a conflict for a memo with links, got %v", err)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, "test-memo-1"); memo == nil || !slices.Equal(memo.LinkedTodos, []string{"test-todo-1"}) {
+		t.Errorf("Expected the memo and its links to be kept, got %+v", memo)
+	}
+	if after, _ := mockStorage.ListTodos(ctx, storage.TodoFilters{UserID: "test-user-1"}); len(after) != len(todos) {
+		t.Errorf("Expected no todo to be created, got %d todos instead of %d", len(after), len(todos))
+	}
+
+	// Unlinked memos need no opt-in
+	result, err := callTool[ConvertResult](t, ctx, h, "memo_promote_to_todo", `{"id":"test-memo-2"}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.DroppedLinks) != 0 {
+		t.Errorf("Expected no dropped links, got %v", result.DroppedLinks)
+	}
+}
+
+func TestTodoHandler_DemoteToMemo(t *testing.T) {
+	h, mockStorage := newJournalTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	// test-memo-1 links test-todo-1, which is only deleted if the link may be dropped
+	if _, err := callTool[ConvertResult](t, ctx, h, "todo_demote_to_memo", `{"id":"test-todo-1"}`); !apperr.Is(err, apperr.Conflict) {
+		t.Fatalf("Expected a conflict for a linked todo, got %v", err)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, "test-memo-1"); !slices.Equal(memo.LinkedTodos, []string{"test-todo-1"}) {
+		t.Errorf("Expected the link to be kept, got %v", memo.LinkedTodos)
+	}
+
+	result, err := callTool[ConvertResult](t, ctx, h, "todo_demote_to_memo", `{"id":"test-todo-1","drop_links":true}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if memo := result.Memo; memo.Title != "Test Todo 1" || memo.SourceTodoID != "test-todo-1" || len(memo.LinkedTodos) != 0 {
+		t.Errorf("Unexpected memo: %+v", memo)
+	}
+	if !slices.Equal(result.UnlinkedMemos, []string{"test-memo-1"}) {
+		t.Errorf("Expected test-memo-1 to be unlinked, got %v", result.UnlinkedMemos)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, "test-memo-1"); len(memo.LinkedTodos) != 0 {
+		t.Errorf("Expected the link to the deleted todo to be removed, got %v", memo.LinkedTodos)
+	}
+	if _, err := mockStorage.GetTodo(ctx, "test-todo-1"); !apperr.Is(err, apperr.NotFound) {
+		t.Errorf("Expected the todo to be deleted, got %v", err)
+	}
+
+	// Undoing restores the todo and the link to it
+	if _, err := callTool[UndoResult](t, ctx, h, "undo", `{}`); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, "test-memo-1"); !slices.Equal(memo.LinkedTodos, []string{"test-todo-1"}) {
+		t.Errorf("Expected the link to be restored, got %v", memo.LinkedTodos)
+	}
+
+	// Todos with subtodos are only demoted if kept
+	if _, err := LookupTool("todo_create").Call(ctx, h, []byte(`{"title":"Child","parent_id":"test-todo-2"}`)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := callTool[ConvertResult](t, ctx, h, "todo_demote_to_memo", `{"id":"test-todo-2"}`); !apperr.Is(err, apperr.Conflict) {
+		t.Errorf("Expected a conflict for a todo with subtodos, got %v", err)
+	}
+	result, err = callTool[ConvertResult](t, ctx, h, "todo_demote_to_memo", `{"id":"test-todo-2","keep_original":true}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !slices.Equal(result.Memo.LinkedTodos, []string{"test-todo-2"}) || result.Todo == nil {
+		t.Errorf("Expected the memo to link the kept todo, got %+v", result)
+	}
+}
@@ -0,0 +1,538 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/audit"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+	"github.com/pankona/memoya/internal/validation"
+)
+
+const (
+	// journalRetention is how long operations can be undone
+	journalRetention = 30 * 24 * time.Hour
+	// MaxUndoCount is the most operations undone or redone at once
+	MaxUndoCount = 20
+)
+
+// Journal records the changes tools make to todos and memos, so they can be
+// undone and redone. A nil Journal records nothing.
+type Journal struct {
+	storage storage.Storage
+	audit   *audit.Recorder
+	now     func() time.Time
+}
+
+// NewJournal creates a journal. Undone and redone changes are recorded in
+// the audit log by recorder.
+func NewJournal(storage storage.Storage, recorder *audit.Recorder) *Journal {
+	return &Journal{
+		storage: storage,
+		audit:   recorder,
+		now:     time.Now,
+	}
+}
+
+// journalFailureKey is the context key of where Record reports failures
+type journalFailureKey struct{}
+
+// withJournalFailure returns a context in which Record stores the error of
+// failing to record an entry in *failure
+func withJournalFailure(ctx context.Context, failure *error) context.Context {
+	return context.WithValue(ctx, journalFailureKey{}, failure)
+}
+
+// Record appends an entry of the steps made by the tool call in ctx.
+// Failures are not returned, since the changes have already been made; they
+// just cannot be undone. They are logged, and reported to the tool call so
+// its result can say so.
+func (j *Journal) Record(ctx context.Context, userID string, steps ...models.JournalStep) {
+	if j == nil || len(steps) == 0 {
+		return
+	}
+
+	req := audit.RequestFromContext(ctx)
+	now := j.now()
+	entry := &models.JournalEntry{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		SessionID: req.SessionID,
+		TokenID:   req.TokenID,
+		Tool:      audit.ToolFromContext(ctx),
+		Steps:     steps,
+		State:     models.JournalApplied,
+		CreatedAt: now,
+		ExpiresAt: now.Add(journalRetention),
+	}
+	if err := j.storage.CreateJournalEntry(ctx, entry); err != nil {
+		log.Printf("Failed to record journal entry of %s for user %s: %v", entry.Tool, userID, err)
+		if failure, ok := ctx.Value(journalFailureKey{}).(*error); ok {
+			*failure = err
+		}
+	}
+}
+
+// Undo reverts the user's last count operations that are still applied,
+// newest first and all in one transaction. With session set, only the
+// operations made with the sign-in session or access token of ctx are
+// undone.
+func (j *Journal) Undo(ctx context.Context, userID string, count int, session bool) ([]*models.JournalEntry, error) {
+	return j.apply(ctx, userID, count, session, models.JournalApplied, models.JournalUndone)
+}
+
+// Redo reapplies the user's last count undone operations, most recently
+// undone first and all in one transaction
+func (j *Journal) Redo(ctx context.Context, userID string, count int, session bool) ([]*models.JournalEntry, error) {
+	return j.apply(ctx, userID, count, session, models.JournalUndone, models.JournalApplied)
+}
+
+// apply moves entries from one state to the other, changing their items
+// back to how they were before (undo) or after (redo) the operation. An item
+// changed since is a conflict, and nothing is changed.
+func (j *Journal) apply(ctx context.Context, userID string, count int, session bool, from, to models.JournalState) ([]*models.JournalEntry, error) {
+	if j == nil {
+		return nil, fmt.Errorf("journal not initialized")
+	}
+	undo := to == models.JournalUndone
+	verb := "redo"
+	if undo {
+		verb = "undo"
+	}
+
+	filters := storage.JournalFilters{UserID: userID, State: from, Limit: count}
+	if session {
+		req := audit.RequestFromContext(ctx)
+		switch {
+		case req.SessionID != "":
+			filters.SessionID = req.SessionID
+		case req.TokenID != "":
+			filters.TokenID = req.TokenID
+		default:
+			v := validation.New(validation.Options{})
+			v.Add("session", "the request has no sign-in session or access token to limit the %s to", verb)
+			return nil, v.Err()
+		}
+	}
+
+	entries, err := j.storage.ListJournalEntries(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list journal entries: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, apperr.Errorf(apperr.NotFound, "nothing to %s", verb)
+	}
+
+	// Todos the operations delete must not be left linked from memos changed
+	// since. Transactions cannot query, so the memos are looked up first.
+	var deleted []string
+	for _, entry := range entries {
+		for _, step := range entry.Steps {
+			target := stepBefore(step)
+			if !undo {
+				target = stepAfter(step)
+			}
+			if step.TargetType == "todo" && target.todo == nil && !slices.Contains(deleted, step.TargetID) {
+				deleted = append(deleted, step.TargetID)
+			}
+		}
+	}
+	linking, err := linkingMemos(ctx, j.storage, userID, deleted...)
+	if err != nil {
+		return nil, err
+	}
+
+	now := j.now()
+	var items *txItems
+	err = j.storage.RunTransaction(ctx, userID, func(tx storage.Tx) error {
+		items = newTxItems(tx)
+
+		// Read and check everything before writing, as transactions require
+		for _, entry := range entries {
+			// Reread the entry, so concurrent requests do not apply it twice
+			stored, err := tx.GetJournalEntry(entry.ID)
+			if err != nil {
+				return err
+			}
+			if stored.State != from {
+				return apperr.Errorf(apperr.Conflict, "operation %s was already %s by another request", entry.ID, to)
+			}
+
+			for i := range entry.Steps {
+				// Steps are undone in reverse
+				step := entry.Steps[i]
+				if undo {
+					step = entry.Steps[len(entry.Steps)-1-i]
+				}
+				expected, target := stepAfter(step), stepBefore(step)
+				if !undo {
+					expected, target = target, expected
+				}
+				if err := items.change(step, expected, target, undo); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, id := range deleted {
+			if items.current[itemKey{targetType: "todo", id: id}].todo != nil {
+				continue
+			}
+			memos, err := items.linkedFrom(id, linking)
+			if err != nil {
+				return err
+			}
+			if len(memos) > 0 {
+				return apperr.Errorf(apperr.Conflict, "todo %s is linked from memo %s; remove the link before the %s", id, memos[0].ID, verb)
+			}
+		}
+
+		if err := items.write(); err != nil {
+			return err
+		}
+		for i, entry := range entries {
+			entry.State = to
+			entry.UndoneAt = nil
+			if undo {
+				// Entries undone together are ordered as undone, so redo
+				// reapplies the last one first. Firestore keeps microseconds.
+				undoneAt := now.Add(time.Duration(i) * time.Microsecond)
+				entry.UndoneAt = &undoneAt
+			}
+			if err := tx.SetJournalEntry(entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Undoing and redoing change items like any other tool
+	items.recordAudit(ctx, j.audit, userID)
+	if missed := unlinkDeleted(ctx, j.storage, userID, deleted...); missed != nil {
+		missed.recordAudit(ctx, j.audit, userID)
+	}
+	return entries, nil
+}
+
+// journalItem is a todo or memo as it was at some point. Both are nil if it
+// did not exist.
+type journalItem struct {
+	todo *models.Todo
+	memo *models.Memo
+}
+
+func stepBefore(step models.JournalStep) journalItem {
+	return journalItem{todo: step.TodoBefore, memo: step.MemoBefore}
+}
+
+func stepAfter(step models.JournalStep) journalItem {
+	return journalItem{todo: step.TodoAfter, memo: step.MemoAfter}
+}
+
+// sameVersion reports whether two states of an item are the same version
+func (a journalItem) sameVersion(b journalItem) bool {
+	switch {
+	case a.todo != nil || b.todo != nil:
+		return a.todo != nil && b.todo != nil && a.todo.LastModified.Equal(b.todo.LastModified)
+	case a.memo != nil || b.memo != nil:
+		return a.memo != nil && b.memo != nil && a.memo.LastModified.Equal(b.memo.LastModified)
+	}
+	return true
+}
+
+// itemKey identifies a todo or memo
+type itemKey struct {
+	targetType string
+	id         string
+}
+
+// txItems tracks the todos and memos read and changed in a transaction.
+// Changes are kept in memory until write, since transactions must read
+// everything before writing.
+type txItems struct {
+	tx      storage.Tx
+	keys    []itemKey // Changed items, in the order first changed
+	initial map[itemKey]journalItem
+	current map[itemKey]journalItem
+}
+
+func newTxItems(tx storage.Tx) *txItems {
+	return &txItems{
+		tx:      tx,
+		initial: make(map[itemKey]journalItem),
+		current: make(map[itemKey]journalItem),
+	}
+}
+
+// get returns the item as currently changed, reading it on first use
+func (s *txItems) get(key itemKey) (journalItem, error) {
+	if item, ok := s.current[key]; ok {
+		return item, nil
+	}
+	item, err := s.read(key)
+	if err != nil {
+		return journalItem{}, err
+	}
+	s.initial[key], s.current[key] = item, item
+	return item, nil
+}
+
+// set changes the item of key to item. Items not read before are new.
+func (s *txItems) set(key itemKey, item journalItem) {
+	if _, ok := s.initial[key]; !ok {
+		s.initial[key] = journalItem{}
+	}
+	if !slices.Contains(s.keys, key) {
+		s.keys = append(s.keys, key)
+	}
+	s.current[key] = item
+}
+
+// change changes the item of step to target, if it currently is expected
+func (s *txItems) change(step models.JournalStep, expected, target journalItem, undo bool) error {
+	key := itemKey{targetType: step.TargetType, id: step.TargetID}
+	current, err := s.get(key)
+	if err != nil {
+		return err
+	}
+
+	if !current.sameVersion(expected) {
+		if undo {
+			return apperr.Errorf(apperr.Conflict, "%s %s was changed after the operation; undo the later changes first or edit it directly", step.TargetType, step.TargetID)
+		}
+		return apperr.Errorf(apperr.Conflict, "%s %s was changed after the operation was undone", step.TargetType, step.TargetID)
+	}
+	s.set(key, target)
+	return nil
+}
+
+func (s *txItems) read(key itemKey) (journalItem, error) {
+	var item journalItem
+	var err error
+	switch key.targetType {
+	case "todo":
+		item.todo, err = s.tx.GetTodo(key.id)
+	case "memo":
+		item.memo, err = s.tx.GetMemo(key.id)
+	default:
+		return item, fmt.Errorf("unknown journal target type %q", key.targetType)
+	}
+	if apperr.Is(err, apperr.NotFound) {
+		return journalItem{}, nil
+	}
+	return item, err
+}
+
+// write stores the items as changed
+func (s *txItems) write() error {
+	for _, key := range s.keys {
+		item := s.current[key]
+		var err error
+		switch {
+		case item == s.initial[key]:
+			// Created and deleted again
+		case item.todo != nil:
+			err = s.tx.SetTodo(item.todo)
+		case item.memo != nil:
+			err = s.tx.SetMemo(item.memo)
+		case key.targetType == "todo":
+			err = s.tx.DeleteTodo(key.id)
+		default:
+			err = s.tx.DeleteMemo(key.id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// steps returns the journal steps of the changed items
+func (s *txItems) steps() []models.JournalStep {
+	steps := make([]models.JournalStep, 0, len(s.keys))
+	for _, key := range s.keys {
+		before, after := s.initial[key], s.current[key]
+		switch {
+		case before == after:
+		case key.targetType == "todo":
+			steps = append(steps, todoStep(before.todo, after.todo))
+		default:
+			steps = append(steps, memoStep(before.memo, after.memo))
+		}
+	}
+	return steps
+}
+
+// recordAudit records the changes of the items in the audit log
+func (s *txItems) recordAudit(ctx context.Context, recorder *audit.Recorder, userID string) {
+	for _, key := range s.keys {
+		before, after := s.initial[key], s.current[key]
+		switch {
+		case before == after:
+		case key.targetType == "todo":
+			recorder.Record(ctx, todoEvent(userID, before.todo, after.todo))
+		default:
+			recorder.Record(ctx, memoEvent(userID, before.memo, after.memo))
+		}
+	}
+}
+
+// todoStep returns the journal step of a todo changing from before to after.
+// The todos are copied, as handlers change them in place.
+func todoStep(before, after *models.Todo) models.JournalStep {
+	step := models.JournalStep{TargetType: "todo"}
+	if before != nil {
+		copied := *before
+		step.TodoBefore, step.TargetID = &copied, before.ID
+	}
+	if after != nil {
+		copied := *after
+		step.TodoAfter, step.TargetID = &copied, after.ID
+	}
+	return step
+}
+
+// memoStep returns the journal step of a memo changing from before to after.
+// The memos are copied, as handlers change them in place.
+func memoStep(before, after *models.Memo) models.JournalStep {
+	step := models.JournalStep{TargetType: "memo"}
+	if before != nil {
+		copied := *before
+		step.MemoBefore, step.TargetID = &copied, before.ID
+	}
+	if after != nil {
+		copied := *after
+		step.MemoAfter, step.TargetID = &copied, after.ID
+	}
+	return step
+}
+
+// JournalHandler serves the undo and redo tools
+type JournalHandler struct {
+	journal *Journal
+}
+
+func NewJournalHandler(journal *Journal) *JournalHandler {
+	return &JournalHandler{
+		journal: journal,
+	}
+}
+
+// UndoArgs represents arguments for undoing or redoing operations
+type UndoArgs struct {
+	Count   int  `json:"count,omitempty"`
+	Session bool `json:"session,omitempty"`
+}
+
+// JournalOperation summarizes an undone or redone operation
+type JournalOperation struct {
+	ID        string        `json:"id"`
+	Tool      string        `json:"tool"`
+	CreatedAt time.Time     `json:"created_at"`
+	Items     []JournalItem `json:"items"`
+}
+
+// JournalItem is a todo or memo changed by an operation
+type JournalItem struct {
+	Type  string `json:"type"` // "todo" or "memo"
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// UndoResult represents the result of the undo and redo tools
+type UndoResult struct {
+	Success    bool               `json:"success"`
+	Operations []JournalOperation `json:"operations"`
+	Message    string             `json:"message"`
+}
+
+// Undo reverts the user's last operations on todos and memos
+func (h *JournalHandler) Undo(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[UndoArgs]) (*mcp.CallToolResultFor[UndoResult], error) {
+	return h.run(ctx, params.Arguments, (*Journal).Undo, "Undid")
+}
+
+// Redo reapplies the user's last undone operations
+func (h *JournalHandler) Redo(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[UndoArgs]) (*mcp.CallToolResultFor[UndoResult], error) {
+	return h.run(ctx, params.Arguments, (*Journal).Redo, "Redid")
+}
+
+func (h *JournalHandler) run(ctx context.Context, args UndoArgs, apply func(*Journal, context.Context, string, int, bool) ([]*models.JournalEntry, error), done string) (*mcp.CallToolResultFor[UndoResult], error) {
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	if args.Count == 0 {
+		args.Count = 1
+	}
+	if args.Count < 1 || args.Count > MaxUndoCount {
+		v := validation.New(validation.Options{})
+		v.Add("count", "must be between 1 and %d", MaxUndoCount)
+		return nil, v.Err()
+	}
+
+	entries, err := apply(h.journal, ctx, userID, args.Count, args.Session)
+	if err != nil {
+		return nil, err
+	}
+
+	result := UndoResult{
+		Success:    true,
+		Operations: make([]JournalOperation, 0, len(entries)),
+	}
+	tools := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		result.Operations = append(result.Operations, journalOperation(entry))
+		tools = append(tools, entry.Tool)
+	}
+	result.Message = fmt.Sprintf("%s %d operations: %s", done, len(entries), strings.Join(tools, ", "))
+
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return &mcp.CallToolResultFor[UndoResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
+
+func journalOperation(entry *models.JournalEntry) JournalOperation {
+	op := JournalOperation{
+		ID:        entry.ID,
+		Tool:      entry.Tool,
+		CreatedAt: entry.CreatedAt,
+		Items:     make([]JournalItem, 0, len(entry.Steps)),
+	}
+	for _, step := range entry.Steps {
+		item := JournalItem{Type: step.TargetType, ID: step.TargetID}
+		switch {
+		case step.TodoAfter != nil:
+			item.Title = step.TodoAfter.Title
+		case step.TodoBefore != nil:
+			item.Title = step.TodoBefore.Title
+		case step.MemoAfter != nil:
+			item.Title = step.MemoAfter.Title
+		case step.MemoBefore != nil:
+			item.Title = step.MemoBefore.Title
+		}
+		op.Items = append(op.Items, item)
+	}
+	return op
+}
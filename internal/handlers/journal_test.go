@@ -0,0 +1,204 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/audit"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+)
+
+func newJournalTestHandlers(t *testing.T) (*Handlers, *MockStorage) {
+	t.Helper()
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	journal := NewJournal(mockStorage, audit.NewRecorder(mockStorage))
+	todo := NewTodoHandlerWithStorage(mockStorage)
+	todo.SetJournal(journal)
+	memo := NewMemoHandlerWithStorage(mockStorage)
+	memo.SetJournal(journal)
+	return &Handlers{Todo: todo, Memo: memo, Journal: NewJournalHandler(journal)}, mockStorage
+}
+
+func TestJournal_UndoRedo(t *testing.T) {
+	h, mockStorage := newJournalTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	if _, err := LookupTool("todo_update").Call(ctx, h, []byte(`{"id":"test-todo-1","status":"done"}`)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := LookupTool("memo_delete").Call(ctx, h, []byte(`{"id":"test-memo-1"}`)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Undo both, newest first
+	result, err := callTool[UndoResult](t, ctx, h, "undo", `{"count":2}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.Operations) != 2 || result.Operations[0].Tool != "memo_delete" || result.Operations[1].Tool != "todo_update" {
+		t.Errorf("Unexpected operations: %+v", result.Operations)
+	}
+	if todo, _ := mockStorage.GetTodo(ctx, "test-todo-1"); todo.Status != models.StatusTodo || todo.ClosedAt != nil {
+		t.Errorf("Expected the todo to be restored, got %+v", todo)
+	}
+	if _, err := mockStorage.GetMemo(ctx, "test-memo-1"); err != nil {
+		t.Errorf("Expected the memo to be restored, got %v", err)
+	}
+
+	// Redo the todo update, which was undone last
+	result, err = callTool[UndoResult](t, ctx, h, "redo", `{}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.Operations) != 1 || result.Operations[0].Tool != "todo_update" {
+		t.Errorf("Unexpected operations: %+v", result.Operations)
+	}
+	if todo, _ := mockStorage.GetTodo(ctx, "test-todo-1"); todo.Status != models.StatusDone {
+		t.Errorf("Expected the update to be redone, got %+v", todo)
+	}
+
+	// Other users have nothing to undo
+	otherCtx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-2")
+	if _, err := callTool[UndoResult](t, otherCtx, h, "undo", `{}`); !apperr.Is(err, apperr.NotFound) {
+		t.Errorf("Expected NotFound for another user, got %v", err)
+	}
+}
+
+func TestJournal_UndoCreate(t *testing.T) {
+	h, mockStorage := newJournalTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	result, err := LookupTool("todo_create").Call(ctx, h, []byte(`{"title":"Mistake"}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	var created TodoResult
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &created); err != nil {
+		t.Fatalf("Failed to unmarshal result: %v", err)
+	}
+
+	if _, err := callTool[UndoResult](t, ctx, h, "undo", `{}`); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := mockStorage.GetTodo(ctx, created.Todo.ID); !apperr.Is(err, apperr.NotFound) {
+		t.Errorf("Expected the created todo to be deleted, got %v", err)
+	}
+	if _, err := callTool[UndoResult](t, ctx, h, "undo", `{}`); !apperr.Is(err, apperr.NotFound) {
+		t.Errorf("Expected nothing left to undo, got %v", err)
+	}
+}
+
+func TestJournal_SessionAndConflicts(t *testing.T) {
+	h, mockStorage := newJournalTestHandlers(t)
+	userCtx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	agent := audit.WithRequest(userCtx, audit.Request{SessionID: "session-agent"})
+	human := audit.WithRequest(userCtx, audit.Request{SessionID: "session-human"})
+
+	if _, err := LookupTool("todo_update").Call(agent, h, []byte(`{"id":"test-todo-1","priority":"normal"}`)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := LookupTool("todo_update").Call(human, h, []byte(`{"id":"test-todo-2","title":"Renamed"}`)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// The agent's undo skips the human's later change
+	result, err := callTool[UndoResult](t, agent, h, "undo", `{"session":true}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result.Operations) != 1 || result.Operations[0].Items[0].ID != "test-todo-1" {
+		t.Errorf("Unexpected operations: %+v", result.Operations)
+	}
+	if todo, _ := mockStorage.GetTodo(userCtx, "test-todo-2"); todo.Title != "Renamed" {
+		t.Errorf("Expected the human's change to stay, got %+v", todo)
+	}
+
+	// An item changed after the operation is not overwritten
+	if _, err := LookupTool("todo_update").Call(agent, h, []byte(`{"id":"test-todo-2","status":"done"}`)); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := callTool[UndoResult](t, human, h, "undo", `{"session":true}`); !apperr.Is(err, apperr.Conflict) {
+		t.Errorf("Expected a conflict, got %v", err)
+	}
+
+	if _, err := callTool[UndoResult](t, userCtx, h, "undo", `{"session":true}`); !apperr.Is(err, apperr.Validation) {
+		t.Errorf("Expected a validation error without a session, got %v", err)
+	}
+	if _, err := callTool[UndoResult](t, userCtx, h, "undo", `{"count":21}`); !apperr.Is(err, apperr.Validation) {
+		t.Errorf("Expected a validation error for too many operations, got %v", err)
+	}
+}
+
+func TestJournal_CompoundOperationIsAtomic(t *testing.T) {
+	h, mockStorage := newJournalTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	// One operation changing a todo and a memo
+	todo, _ := mockStorage.GetTodo(ctx, "test-todo-1")
+	memo, _ := mockStorage.GetMemo(ctx, "test-memo-1")
+	todoAfter, memoAfter := *todo, *memo
+	todoAfter.Title, todoAfter.LastModified = "Changed todo", todo.LastModified.Add(1)
+	memoAfter.Title, memoAfter.LastModified = "Changed memo", memo.LastModified.Add(1)
+	mockStorage.todos[todo.ID], mockStorage.memos[memo.ID] = &todoAfter, &memoAfter
+	h.Todo.journal.Record(ctx, "test-user-1", todoStep(todo, &todoAfter), memoStep(memo, &memoAfter))
+
+	// The memo changes again outside of the journal
+	memoLater := memoAfter
+	memoLater.LastModified = memoAfter.LastModified.Add(1)
+	mockStorage.memos[memo.ID] = &memoLater
+
+	if _, err := callTool[UndoResult](t, ctx, h, "undo", `{}`); !apperr.Is(err, apperr.Conflict) {
+		t.Fatalf("Expected a conflict, got %v", err)
+	}
+	if current, _ := mockStorage.GetTodo(ctx, todo.ID); current.Title != "Changed todo" {
+		t.Errorf("Expected the todo to be left as is, got %q", current.Title)
+	}
+
+	// Once the memo is as the operation left it, both are undone
+	mockStorage.memos[memo.ID] = &memoAfter
+	if _, err := callTool[UndoResult](t, ctx, h, "undo", `{}`); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	restoredTodo, _ := mockStorage.GetTodo(ctx, todo.ID)
+	restoredMemo, _ := mockStorage.GetMemo(ctx, memo.ID)
+	if restoredTodo.Title != todo.Title || restoredMemo.Title != memo.Title {
+		t.Errorf("Expected both items to be restored, got %q and %q", restoredTodo.Title, restoredMemo.Title)
+	}
+}
+
+// journalFailingStorage fails to store journal entries
+type journalFailingStorage struct {
+	*MockStorage
+}
+
+func (s journalFailingStorage) CreateJournalEntry(ctx context.Context, entry *models.JournalEntry) error {
+	return fmt.Errorf("entry too large")
+}
+
+func TestJournal_RecordFailureWarns(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	todo := NewTodoHandlerWithStorage(mockStorage)
+	todo.SetJournal(NewJournal(journalFailingStorage{mockStorage}, audit.NewRecorder(mockStorage)))
+	h := &Handlers{Todo: todo}
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	// The change is made, and the result says it cannot be undone
+	result, err := callTool[map[string]any](t, ctx, h, "todo_update", `{"id":"test-todo-1","status":"done"}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if warning, _ := result["warning"].(string); !strings.Contains(warning, "cannot be undone") {
+		t.Errorf("Expected a warning that the change cannot be undone, got %v", result)
+	}
+	if updated, _ := mockStorage.GetTodo(ctx, "test-todo-1"); updated.Status != models.StatusDone {
+		t.Errorf("Expected the todo to be updated, got status %s", updated.Status)
+	}
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+	"github.com/pankona/memoya/internal/validation"
+)
+
+// Memos link todos by listing their IDs in LinkedTodos. The links are kept
+// valid: memos can only link existing todos of their user, and deleting a
+// todo removes it from the memos linking it.
+//
+// Memos are written in a transaction reading the todos they link, so a memo
+// written after a todo is deleted cannot link it. A memo written after the
+// memos linking the todo are looked up, but before the deletion, is not
+// unlinked by the deletion; unlinkDeleted finds it after the deletion.
+
+// linkingMemos returns the IDs of the user's memos linking any of the todos.
+// Transactions cannot query, so the memos are looked up before one and read
+// again in it.
+func linkingMemos(ctx context.Context, s storage.Storage, userID string, todoIDs ...string) ([]string, error) {
+	var ids []string
+	for _, todoID := range todoIDs {
+		memos, err := s.ListMemos(ctx, storage.MemoFilters{UserID: userID, LinkedTodo: todoID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list memos linking todo %s: %w", todoID, err)
+		}
+		for _, memo := range memos {
+			if !slices.Contains(ids, memo.ID) {
+				ids = append(ids, memo.ID)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// unlinkDeleted removes the deleted todos from the memos linking them that
+// the deletion missed. It runs after the deletion, and returns the items
+// changed, or nil if none were. Failures are logged, as the deletion is done.
+func unlinkDeleted(ctx context.Context, s storage.Storage, userID string, todoIDs ...string) *txItems {
+	linking, err := linkingMemos(ctx, s, userID, todoIDs...)
+	if err != nil || len(linking) == 0 {
+		if err != nil {
+			log.Printf("Failed to check links to deleted todos of user %s: %v", userID, err)
+		}
+		return nil
+	}
+
+	var items *txItems
+	err = s.RunTransaction(ctx, userID, func(tx storage.Tx) error {
+		items = newTxItems(tx)
+		for _, todoID := range todoIDs {
+			// The todo may have been restored since
+			todo, err := items.get(itemKey{targetType: "todo", id: todoID})
+			if err != nil {
+				return err
+			}
+			if todo.todo != nil {
+				continue
+			}
+			if _, err := items.unlinkTodo(todoID, linking); err != nil {
+				return err
+			}
+		}
+		return items.write()
+	})
+	if err != nil {
+		log.Printf("Failed to remove links to deleted todos of user %s: %v", userID, err)
+		return nil
+	}
+	return items
+}
+
+// checkLinkedTodos records a field error for each of ids that is not a todo
+// as currently changed
+func (s *txItems) checkLinkedTodos(v *validation.Validator, ids []string) error {
+	for i, id := range ids {
+		item, err := s.get(itemKey{targetType: "todo", id: id})
+		if err != nil {
+			return err
+		}
+		if item.todo == nil {
+			v.Add(fmt.Sprintf("linked_todos[%d]", i), "todo %s does not exist", id)
+		}
+	}
+	return nil
+}
+
+// unlinkedMemos returns the IDs of the memos s changed
+func (s *txItems) unlinkedMemos() []string {
+	var ids []string
+	for _, key := range s.keys {
+		if key.targetType == "memo" {
+			ids = append(ids, key.id)
+		}
+	}
+	return ids
+}
+
+// linkedFrom returns the memos currently linking the todo, out of the memos
+// of memoIDs and the memos changed in the transaction so far
+func (s *txItems) linkedFrom(todoID string, memoIDs []string) ([]*models.Memo, error) {
+	candidates := slices.Clone(memoIDs)
+	for _, key := range s.keys {
+		if key.targetType == "memo" && !slices.Contains(candidates, key.id) {
+			candidates = append(candidates, key.id)
+		}
+	}
+
+	var memos []*models.Memo
+	for _, id := range candidates {
+		item, err := s.get(itemKey{targetType: "memo", id: id})
+		if err != nil {
+			return nil, err
+		}
+		if item.memo != nil && slices.Contains(item.memo.LinkedTodos, todoID) {
+			memos = append(memos, item.memo)
+		}
+	}
+	return memos, nil
+}
+
+// unlinkTodo removes the todo from the memos linking it, as found by
+// linkedFrom, and returns the IDs of the memos changed
+func (s *txItems) unlinkTodo(todoID string, memoIDs []string) ([]string, error) {
+	memos, err := s.linkedFrom(todoID, memoIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	unlinked := make([]string, 0, len(memos))
+	for _, memo := range memos {
+		changed := *memo
+		changed.LinkedTodos = slices.DeleteFunc(slices.Clone(changed.LinkedTodos), func(id string) bool { return id == todoID })
+		changed.LastModified = time.Now()
+		s.set(itemKey{targetType: "memo", id: changed.ID}, journalItem{memo: &changed})
+		unlinked = append(unlinked, changed.ID)
+	}
+	return unlinked, nil
+}
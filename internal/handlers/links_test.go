@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/audit"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+func callTool[T any](t *testing.T, ctx context.Context, h *Handlers, name, args string) (T, error) {
+	t.Helper()
+	var out T
+	result, err := LookupTool(name).Call(ctx, h, []byte(args))
+	if err != nil {
+		return out, err
+	}
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &out); err != nil {
+		t.Fatalf("Failed to unmarshal result: %v", err)
+	}
+	return out, nil
+}
+
+func TestLinks_Get(t *testing.T) {
+	h, _ := newJournalTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	// test-memo-1 links test-todo-1
+	todo, err := callTool[TodoResult](t, ctx, h, "todo_get", `{"id":"test-todo-1"}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(todo.Backlinks) != 1 || todo.Backlinks[0].ID != "test-memo-1" {
+		t.Errorf("Expected test-memo-1 as the backlink, got %+v", todo.Backlinks)
+	}
+	if todo, _ := callTool[TodoResult](t, ctx, h, "todo_get", `{"id":"test-todo-2"}`); len(todo.Backlinks) != 0 {
+		t.Errorf("Expected no backlinks, got %+v", todo.Backlinks)
+	}
+
+	memo, err := callTool[MemoResult](t, ctx, h, "memo_get", `{"id":"test-memo-1"}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(memo.Todos) != 1 || memo.Todos[0].Title != "Test Todo 1" {
+		t.Errorf("Expected the linked todo, got %+v", memo.Todos)
+	}
+}
+
+func TestLinks_TodoDelete(t *testing.T) {
+	h, mockStorage := newJournalTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	result, err := callTool[DeleteResult](t, ctx, h, "todo_delete", `{"id":"test-todo-1"}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !slices.Equal(result.UnlinkedMemos, []string{"test-memo-1"}) {
+		t.Errorf("Expected test-memo-1 to be unlinked, got %v", result.UnlinkedMemos)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, "test-memo-1"); len(memo.LinkedTodos) != 0 {
+		t.Errorf("Expected the link to be removed, got %v", memo.LinkedTodos)
+	}
+
+	// Undoing restores the todo and the link to it
+	if _, err := callTool[UndoResult](t, ctx, h, "undo", `{}`); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := mockStorage.GetTodo(ctx, "test-todo-1"); err != nil {
+		t.Errorf("Expected the todo to be restored, got %v", err)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, "test-memo-1"); !slices.Equal(memo.LinkedTodos, []string{"test-todo-1"}) {
+		t.Errorf("Expected the link to be restored, got %v", memo.LinkedTodos)
+	}
+}
+
+func TestLinks_BatchDelete(t *testing.T) {
+	h, mockStorage := newBatchTestHandlers(t)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	result, err := callTool[BatchResult](t, ctx, h, "batch", `{"operations":[
+		{"op":"create","kind":"todo","ref":"draft","title":"Draft"},
+		{"op":"create","kind":"memo","title":"Draft notes","linked_todos":["$draft","test-todo-2"]},
+		{"op":"delete","kind":"todo","id":"$draft"},
+		{"op":"delete","kind":"todo","id":"test-todo-1"}
+	]}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !result.Success {
+		t.Fatalf("Expected every operation to be applied, got %+v", result)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, result.Results[1].ID); !slices.Equal(memo.LinkedTodos, []string{"test-todo-2"}) {
+		t.Errorf("Expected the link to the deleted todo to be removed, got %v", memo.LinkedTodos)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, "test-memo-1"); len(memo.LinkedTodos) != 0 {
+		t.Errorf("Expected the link to be removed, got %v", memo.LinkedTodos)
+	}
+}
+
+func TestLinks_UndoKeepsLinksValid(t *testing.T) {
+	h, mockStorage := newJournalTestHandlers(t)
+	userCtx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	agent := audit.WithRequest(userCtx, audit.Request{SessionID: "session-agent"})
+	human := audit.WithRequest(userCtx, audit.Request{SessionID: "session-human"})
+
+	todo, err := callTool[TodoResult](t, agent, h, "todo_create", `{"title":"Agent todo"}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := callTool[MemoResult](t, human, h, "memo_update", `{"id":"test-memo-2","linked_todos":["`+todo.Todo.ID+`"]}`); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Undoing the creation would leave the memo linking a deleted todo
+	if _, err := callTool[UndoResult](t, agent, h, "undo", `{"session":true}`); !apperr.Is(err, apperr.Conflict) {
+		t.Errorf("Expected a conflict, got %v", err)
+	}
+	if _, err := mockStorage.GetTodo(userCtx, todo.Todo.ID); err != nil {
+		t.Errorf("Expected the todo to be kept, got %v", err)
+	}
+}
+
+// racingStorage changes items while a handler is between its checks and
+// its transaction
+type racingStorage struct {
+	*MockStorage
+	// afterGetTodo runs once after a todo is read outside a transaction, and
+	// afterLinking once after the memos linking a todo are looked up
+	afterGetTodo, afterLinking func()
+}
+
+func (s *racingStorage) GetTodo(ctx context.Context, id string) (*models.Todo, error) {
+	todo, err := s.MockStorage.GetTodo(ctx, id)
+	if race := s.afterGetTodo; race != nil {
+		s.afterGetTodo = nil
+		race()
+	}
+	return todo, err
+}
+
+func (s *racingStorage) ListMemos(ctx context.Context, filters storage.MemoFilters) ([]*models.Memo, error) {
+	memos, err := s.MockStorage.ListMemos(ctx, filters)
+	if race := s.afterLinking; race != nil && filters.LinkedTodo != "" {
+		s.afterLinking = nil
+		race()
+	}
+	return memos, err
+}
+
+func TestLinks_MemoWriteRace(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	racing := &racingStorage{MockStorage: mockStorage}
+	h := &Handlers{Memo: NewMemoHandlerWithStorage(racing)}
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	memos, _ := mockStorage.ListMemos(ctx, storage.MemoFilters{UserID: "test-user-1"})
+
+	// The linked todo is deleted once checked, before the memo is written
+	racing.afterGetTodo = func() { mockStorage.DeleteTodo(ctx, "test-todo-2") }
+	if _, err := callTool[MemoResult](t, ctx, h, "memo_create", `{"title":"Notes","linked_todos":["test-todo-2"]}`); !apperr.Is(err, apperr.Validation) {
+		t.Errorf("Expected a validation error, got %v", err)
+	}
+	if after, _ := mockStorage.ListMemos(ctx, storage.MemoFilters{UserID: "test-user-1"}); len(after) != len(memos) {
+		t.Errorf("Expected no memo to be created, got %d memos instead of %d", len(after), len(memos))
+	}
+
+	racing.afterGetTodo = func() { mockStorage.DeleteTodo(ctx, "test-todo-1") }
+	if _, err := callTool[MemoResult](t, ctx, h, "memo_update", `{"id":"test-memo-2","linked_todos":["test-todo-1"]}`); !apperr.Is(err, apperr.Validation) {
+		t.Errorf("Expected a validation error, got %v", err)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, "test-memo-2"); len(memo.LinkedTodos) != 0 {
+		t.Errorf("Expected the memo to be left as is, got %v", memo.LinkedTodos)
+	}
+}
+
+func TestLinks_TodoDeleteRace(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	racing := &racingStorage{MockStorage: mockStorage}
+	journal := NewJournal(racing, audit.NewRecorder(racing))
+	todo := NewTodoHandlerWithStorage(racing)
+	todo.SetJournal(journal)
+	h := &Handlers{Todo: todo, Journal: NewJournalHandler(journal)}
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	// A memo links the todo once the memos linking it are looked up, before
+	// it is deleted
+	racing.afterLinking = func() {
+		memo, _ := mockStorage.GetMemo(ctx, "test-memo-2")
+		linked := *memo
+		linked.LinkedTodos = []string{"test-todo-2"}
+		mockStorage.UpdateMemo(ctx, &linked)
+	}
+	result, err := callTool[DeleteResult](t, ctx, h, "todo_delete", `{"id":"test-todo-2"}`)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !slices.Equal(result.UnlinkedMemos, []string{"test-memo-2"}) {
+		t.Errorf("Expected test-memo-2 to be unlinked, got %v", result.UnlinkedMemos)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, "test-memo-2"); len(memo.LinkedTodos) != 0 {
+		t.Errorf("Expected the link to the deleted todo to be removed, got %v", memo.LinkedTodos)
+	}
+
+	// Undoing restores the todo and the link with the deletion
+	if _, err := callTool[UndoResult](t, ctx, h, "undo", `{}`); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if memo, _ := mockStorage.GetMemo(ctx, "test-memo-2"); !slices.Equal(memo.LinkedTodos, []string{"test-todo-2"}) {
+		t.Errorf("Expected the link to be restored, got %v", memo.LinkedTodos)
+	}
+}
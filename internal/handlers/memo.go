@@ -8,13 +8,20 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/audit"
 	"github.com/pankona/memoya/internal/auth"
 	"github.com/pankona/memoya/internal/models"
 	"github.com/pankona/memoya/internal/storage"
+	"github.com/pankona/memoya/internal/validation"
 )
 
 type MemoHandler struct {
 	storage storage.Storage
+	quota   *Quota
+	opts    validation.Options
+	audit   *audit.Recorder
+	journal *Journal
 }
 
 func NewMemoHandler() *MemoHandler {
@@ -28,6 +35,70 @@ func NewMemoHandlerWithStorage(storage storage.Storage) *MemoHandler {
 	}
 }
 
+// SetQuota makes the handler enforce quota on the memos it creates and updates
+func (h *MemoHandler) SetQuota(quota *Quota) {
+	h.quota = quota
+}
+
+// SetValidation sets how the handler normalizes arguments
+func (h *MemoHandler) SetValidation(opts validation.Options) {
+	h.opts = opts
+}
+
+// SetAudit makes the handler record the changes it makes in the audit log
+func (h *MemoHandler) SetAudit(recorder *audit.Recorder) {
+	h.audit = recorder
+}
+
+// SetJournal makes the handler record the changes it makes in journal, so
+// they can be undone
+func (h *MemoHandler) SetJournal(journal *Journal) {
+	h.journal = journal
+}
+
+// record records a change of a memo in the audit log and the journal. A nil
+// before is a created memo, a nil after a deleted one.
+func (h *MemoHandler) record(ctx context.Context, userID string, before, after *models.Memo) {
+	h.audit.Record(ctx, memoEvent(userID, before, after))
+	h.journal.Record(ctx, userID, memoStep(before, after))
+}
+
+// newMemo returns the memo created by validated args
+func newMemo(userID string, args MemoCreateArgs) *models.Memo {
+	return &models.Memo{
+		ID:           uuid.New().String(),
+		UserID:       userID,
+		Title:        args.Title,
+		Description:  args.Description,
+		Tags:         nonNil(args.Tags),
+		LinkedTodos:  nonNil(args.LinkedTodos),
+		CreatedAt:    time.Now(),
+		LastModified: time.Now(),
+	}
+}
+
+// updateMemo changes memo as validated args say. Empty arguments leave
+// their field as is.
+func updateMemo(memo *models.Memo, args MemoUpdateArgs) {
+	if args.Title != "" {
+		memo.Title = args.Title
+	}
+
+	if args.Description != "" {
+		memo.Description = args.Description
+	}
+
+	if len(args.Tags) > 0 {
+		memo.Tags = args.Tags
+	}
+
+	if len(args.LinkedTodos) > 0 {
+		memo.LinkedTodos = args.LinkedTodos
+	}
+
+	memo.LastModified = time.Now()
+}
+
 // MemoCreateArgs represents arguments for creating a memo
 type MemoCreateArgs struct {
 	Title       string   `json:"title"`
@@ -40,7 +111,9 @@ type MemoCreateArgs struct {
 type MemoResult struct {
 	Success bool         `json:"success"`
 	Memo    *models.Memo `json:"memo"`
-	Message string       `json:"message"`
+	// Todos are the todos the memo links, included by memo_get
+	Todos   []*models.Todo `json:"todos,omitempty"`
+	Message string         `json:"message"`
 }
 
 func (h *MemoHandler) Create(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[MemoCreateArgs]) (*mcp.CallToolResultFor[MemoResult], error) {
@@ -56,23 +129,40 @@ func (h *MemoHandler) Create(ctx context.Context, ss *mcp.ServerSession, params
 		return nil, fmt.Errorf("authentication required: %w", err)
 	}
 
-	memo := &models.Memo{
-		ID:           uuid.New().String(),
-		UserID:       userID,
-		Title:        args.Title,
-		Description:  args.Description,
-		Tags:         args.Tags,
-		LinkedTodos:  args.LinkedTodos,
-		CreatedAt:    time.Now(),
-		LastModified: time.Now(),
+	if err := h.validateCreate(ctx, userID, &args); err != nil {
+		return nil, err
 	}
 
-	// Save to storage
-	err = h.storage.CreateMemo(ctx, memo)
+	// Enforce the user's quota
+	if err := h.quota.CheckItem(args.Description, args.Tags); err != nil {
+		return nil, err
+	}
+	if err := h.quota.CheckNewMemo(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	memo := newMemo(userID, args)
+
+	// Save to storage, with the todos it links read again, so none deleted
+	// since they were checked is linked
+	err = h.storage.RunTransaction(ctx, userID, func(tx storage.Tx) error {
+		items := newTxItems(tx)
+		v := validation.New(h.opts)
+		if err := items.checkLinkedTodos(v, memo.LinkedTodos); err != nil {
+			return err
+		}
+		if err := v.Err(); err != nil {
+			return err
+		}
+		items.set(itemKey{targetType: "memo", id: memo.ID}, journalItem{memo: memo})
+		return items.write()
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create memo: %w", err)
 	}
 
+	h.record(ctx, userID, nil, memo)
+
 	// Create result
 	result := MemoResult{
 		Success: true,
@@ -93,6 +183,67 @@ func (h *MemoHandler) Create(ctx context.Context, ss *mcp.ServerSession, params
 	}, nil
 }
 
+type MemoGetArgs struct {
+	ID string `json:"id"`
+}
+
+func (h *MemoHandler) Get(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[MemoGetArgs]) (*mcp.CallToolResultFor[MemoResult], error) {
+	args := params.Arguments
+
+	if h.storage == nil {
+		return nil, fmt.Errorf("storage not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	memo, err := h.storage.GetMemo(ctx, args.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get memo: %w", err)
+	}
+
+	// Check ownership
+	if memo.UserID != userID {
+		return nil, apperr.Errorf(apperr.Forbidden, "access denied: memo belongs to different user")
+	}
+
+	// Links made before they were kept valid may be to todos since deleted
+	var todos []*models.Todo
+	for _, id := range memo.LinkedTodos {
+		todo, err := h.storage.GetTodo(ctx, id)
+		if apperr.Is(err, apperr.NotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get linked todo: %w", err)
+		}
+		if todo.UserID == userID {
+			todos = append(todos, todo)
+		}
+	}
+
+	result := MemoResult{
+		Success: true,
+		Memo:    memo,
+		Todos:   todos,
+		Message: fmt.Sprintf("Memo '%s' retrieved successfully", memo.Title),
+	}
+
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return &mcp.CallToolResultFor[MemoResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
+
 type MemoListArgs struct {
 	Tags []string `json:"tags,omitempty"`
 }
@@ -116,10 +267,15 @@ func (h *MemoHandler) List(ctx context.Context, ss *mcp.ServerSession, params *m
 		return nil, fmt.Errorf("authentication required: %w", err)
 	}
 
+	tags, err := normalizeTagFilter(h.opts, args.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create filters with user isolation
 	filters := storage.MemoFilters{
 		UserID: userID,
-		Tags:   args.Tags,
+		Tags:   tags,
 	}
 
 	// Fetch from storage
@@ -175,34 +331,56 @@ func (h *MemoHandler) Update(ctx context.Context, ss *mcp.ServerSession, params
 
 	// Check ownership
 	if memo.UserID != userID {
-		return nil, fmt.Errorf("access denied: memo belongs to different user")
+		return nil, apperr.Errorf(apperr.Forbidden, "access denied: memo belongs to different user")
 	}
 
-	// Update fields
-	if args.Title != "" {
-		memo.Title = args.Title
+	if err := h.validateUpdate(ctx, userID, &args); err != nil {
+		return nil, err
 	}
 
-	if args.Description != "" {
-		memo.Description = args.Description
+	// Enforce the user's quota on the new values
+	if err := h.quota.CheckItem(args.Description, args.Tags); err != nil {
+		return nil, err
 	}
 
-	if len(args.Tags) > 0 {
-		memo.Tags = args.Tags
-	}
-
-	if len(args.LinkedTodos) > 0 {
-		memo.LinkedTodos = args.LinkedTodos
-	}
-
-	memo.LastModified = time.Now()
-
-	// Save to storage
-	err = h.storage.UpdateMemo(ctx, memo)
+	// Update the memo as currently stored, so concurrent changes to other
+	// fields are kept
+	var items *txItems
+	err = h.storage.RunTransaction(ctx, userID, func(tx storage.Tx) error {
+		items = newTxItems(tx)
+
+		key := itemKey{targetType: "memo", id: args.ID}
+		current, err := items.get(key)
+		if err != nil {
+			return err
+		}
+		if current.memo == nil {
+			return apperr.Errorf(apperr.NotFound, "memo %s not found", args.ID)
+		}
+		if err := checkPrecondition(ctx, current.memo); err != nil {
+			return err
+		}
+		v := validation.New(h.opts)
+		if err := items.checkLinkedTodos(v, args.LinkedTodos); err != nil {
+			return err
+		}
+		if err := v.Err(); err != nil {
+			return err
+		}
+
+		updated := *current.memo
+		updateMemo(&updated, args)
+		memo = &updated
+		items.set(key, journalItem{memo: memo})
+		return items.write()
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update memo: %w", err)
 	}
 
+	h.journal.Record(ctx, userID, items.steps()...)
+	items.recordAudit(ctx, h.audit, userID)
+
 	// Create result
 	result := MemoResult{
 		Success: true,
@@ -253,15 +431,34 @@ func (h *MemoHandler) Delete(ctx context.Context, ss *mcp.ServerSession, params
 
 	// Check ownership
 	if memo.UserID != userID {
-		return nil, fmt.Errorf("access denied: memo belongs to different user")
+		return nil, apperr.Errorf(apperr.Forbidden, "access denied: memo belongs to different user")
 	}
 
-	// Delete from storage
-	err = h.storage.DeleteMemo(ctx, args.ID)
+	var items *txItems
+	err = h.storage.RunTransaction(ctx, userID, func(tx storage.Tx) error {
+		items = newTxItems(tx)
+
+		key := itemKey{targetType: "memo", id: args.ID}
+		current, err := items.get(key)
+		if err != nil {
+			return err
+		}
+		if current.memo == nil {
+			return apperr.Errorf(apperr.NotFound, "memo %s not found", args.ID)
+		}
+		if err := checkPrecondition(ctx, current.memo); err != nil {
+			return err
+		}
+		items.set(key, journalItem{})
+		return items.write()
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete memo: %w", err)
 	}
 
+	h.journal.Record(ctx, userID, items.steps()...)
+	items.recordAudit(ctx, h.audit, userID)
+
 	result := MemoDeleteResult{
 		Success: true,
 		Message: fmt.Sprintf("Memo %s deleted successfully", args.ID),
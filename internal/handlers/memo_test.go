@@ -7,10 +7,14 @@ import (
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
 )
 
 func TestMemoHandler_Create(t *testing.T) {
 	mockStorage := NewMockStorage()
+	for _, id := range []string{"todo-1", "todo-2"} {
+		mockStorage.CreateTodo(context.Background(), &models.Todo{ID: id, UserID: "test-user-1", Title: id})
+	}
 	handler := NewMemoHandlerWithStorage(mockStorage)
 
 	args := MemoCreateArgs{
@@ -152,6 +156,32 @@ func TestMemoHandler_List_WithTagFilter(t *testing.T) {
 	}
 }
 
+func TestMemoHandler_Get(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	handler := NewMemoHandlerWithStorage(mockStorage)
+
+	params := &mcp.CallToolParamsFor[MemoGetArgs]{
+		Arguments: MemoGetArgs{ID: "test-memo-1"},
+	}
+
+	// Create context with test user ID
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	result, err := handler.Get(ctx, nil, params)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var memoResult MemoResult
+	textContent := result.Content[0].(*mcp.TextContent)
+	json.Unmarshal([]byte(textContent.Text), &memoResult)
+
+	if memoResult.Memo == nil || memoResult.Memo.ID != "test-memo-1" {
+		t.Errorf("Expected memo test-memo-1, got %v", memoResult.Memo)
+	}
+}
+
 func TestMemoHandler_Update(t *testing.T) {
 	mockStorage := NewMockStorage()
 	mockStorage.SetupTestData()
@@ -162,7 +192,7 @@ func TestMemoHandler_Update(t *testing.T) {
 		Title:       "Updated Title",
 		Description: "Updated Description",
 		Tags:        []string{"updated", "test"},
-		LinkedTodos: []string{"new-todo"},
+		LinkedTodos: []string{"test-todo-2"},
 	}
 
 	params := &mcp.CallToolParamsFor[MemoUpdateArgs]{
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
 	"github.com/pankona/memoya/internal/storage"
 )
 
@@ -101,7 +102,7 @@ func (h *MigrationHandler) PerformMigration(ctx context.Context, ss *mcp.ServerS
 
 	// Require explicit confirmation
 	if !args.Confirm {
-		return nil, fmt.Errorf("migration requires explicit confirmation")
+		return nil, apperr.Errorf(apperr.Validation, "migration requires explicit confirmation")
 	}
 
 	// Check if migration is needed
@@ -189,7 +190,7 @@ func (h *MigrationHandler) CleanupMigration(ctx context.Context, ss *mcp.ServerS
 
 	// Require explicit confirmation
 	if !args.Confirm {
-		return nil, fmt.Errorf("cleanup requires explicit confirmation")
+		return nil, apperr.Errorf(apperr.Validation, "cleanup requires explicit confirmation")
 	}
 
 	err := h.migration.CleanupLegacyCollections(ctx)
@@ -3,8 +3,11 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"time"
 
+	"github.com/pankona/memoya/internal/apperr"
 	"github.com/pankona/memoya/internal/models"
 	"github.com/pankona/memoya/internal/storage"
 )
@@ -14,6 +17,12 @@ type MockStorage struct {
 	memos              map[string]*models.Memo
 	users              map[string]*models.User
 	deviceAuthSessions map[string]*models.DeviceAuthSession
+	accessTokens       map[string]*models.AccessToken
+	refreshTokens      map[string]*models.RefreshToken
+	sessions           map[string]*models.Session
+	identities         map[string]*models.Identity
+	auditEvents        []*models.AuditEvent
+	journal            map[string]*models.JournalEntry
 }
 
 func NewMockStorage() *MockStorage {
@@ -22,6 +31,11 @@ func NewMockStorage() *MockStorage {
 		memos:              make(map[string]*models.Memo),
 		users:              make(map[string]*models.User),
 		deviceAuthSessions: make(map[string]*models.DeviceAuthSession),
+		accessTokens:       make(map[string]*models.AccessToken),
+		refreshTokens:      make(map[string]*models.RefreshToken),
+		sessions:           make(map[string]*models.Session),
+		identities:         make(map[string]*models.Identity),
+		journal:            make(map[string]*models.JournalEntry),
 	}
 }
 
@@ -33,14 +47,14 @@ func (m *MockStorage) CreateTodo(ctx context.Context, todo *models.Todo) error {
 func (m *MockStorage) GetTodo(ctx context.Context, id string) (*models.Todo, error) {
 	todo, exists := m.todos[id]
 	if !exists {
-		return nil, fmt.Errorf("todo not found")
+		return nil, apperr.Errorf(apperr.NotFound, "todo not found")
 	}
 	return todo, nil
 }
 
 func (m *MockStorage) UpdateTodo(ctx context.Context, todo *models.Todo) error {
 	if _, exists := m.todos[todo.ID]; !exists {
-		return fmt.Errorf("todo not found")
+		return apperr.Errorf(apperr.NotFound, "todo not found")
 	}
 	m.todos[todo.ID] = todo
 	return nil
@@ -48,7 +62,7 @@ func (m *MockStorage) UpdateTodo(ctx context.Context, todo *models.Todo) error {
 
 func (m *MockStorage) DeleteTodo(ctx context.Context, id string) error {
 	if _, exists := m.todos[id]; !exists {
-		return fmt.Errorf("todo not found")
+		return apperr.Errorf(apperr.NotFound, "todo not found")
 	}
 	delete(m.todos, id)
 	return nil
@@ -64,6 +78,16 @@ func (m *MockStorage) ListTodos(ctx context.Context, filters storage.TodoFilters
 	return result, nil
 }
 
+func (m *MockStorage) CountTodos(ctx context.Context, userID string) (int, error) {
+	count := 0
+	for _, todo := range m.todos {
+		if todo.UserID == userID {
+			count++
+		}
+	}
+	return count, nil
+}
+
 func (m *MockStorage) CreateMemo(ctx context.Context, memo *models.Memo) error {
 	m.memos[memo.ID] = memo
 	return nil
@@ -72,14 +96,14 @@ func (m *MockStorage) CreateMemo(ctx context.Context, memo *models.Memo) error {
 func (m *MockStorage) GetMemo(ctx context.Context, id string) (*models.Memo, error) {
 	memo, exists := m.memos[id]
 	if !exists {
-		return nil, fmt.Errorf("memo not found")
+		return nil, apperr.Errorf(apperr.NotFound, "memo not found")
 	}
 	return memo, nil
 }
 
 func (m *MockStorage) UpdateMemo(ctx context.Context, memo *models.Memo) error {
 	if _, exists := m.memos[memo.ID]; !exists {
-		return fmt.Errorf("memo not found")
+		return apperr.Errorf(apperr.NotFound, "memo not found")
 	}
 	m.memos[memo.ID] = memo
 	return nil
@@ -87,7 +111,7 @@ func (m *MockStorage) UpdateMemo(ctx context.Context, memo *models.Memo) error {
 
 func (m *MockStorage) DeleteMemo(ctx context.Context, id string) error {
 	if _, exists := m.memos[id]; !exists {
-		return fmt.Errorf("memo not found")
+		return apperr.Errorf(apperr.NotFound, "memo not found")
 	}
 	delete(m.memos, id)
 	return nil
@@ -103,6 +127,167 @@ func (m *MockStorage) ListMemos(ctx context.Context, filters storage.MemoFilters
 	return result, nil
 }
 
+func (m *MockStorage) CountMemos(ctx context.Context, userID string) (int, error) {
+	count := 0
+	for _, memo := range m.memos {
+		if memo.UserID == userID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (m *MockStorage) AppendAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	m.auditEvents = append(m.auditEvents, event)
+	return nil
+}
+
+func (m *MockStorage) ListAuditEvents(ctx context.Context, filters storage.AuditFilters) ([]*models.AuditEvent, error) {
+	var events []*models.AuditEvent
+	for i := len(m.auditEvents) - 1; i >= 0; i-- {
+		event := m.auditEvents[i]
+		switch {
+		case event.UserID != filters.UserID,
+			filters.Action != "" && event.Action != filters.Action,
+			filters.TargetType != "" && event.TargetType != filters.TargetType,
+			filters.TargetID != "" && event.TargetID != filters.TargetID,
+			filters.Tool != "" && event.Tool != filters.Tool,
+			filters.Since != nil && event.CreatedAt.Before(*filters.Since),
+			filters.Until != nil && !event.CreatedAt.Before(*filters.Until):
+			continue
+		}
+		events = append(events, event)
+		if filters.Limit > 0 && len(events) == filters.Limit {
+			break
+		}
+	}
+	return events, nil
+}
+
+func (m *MockStorage) CreateJournalEntry(ctx context.Context, entry *models.JournalEntry) error {
+	if _, exists := m.journal[entry.ID]; exists {
+		return apperr.Errorf(apperr.Conflict, "journal entry already exists")
+	}
+	copied := *entry
+	m.journal[entry.ID] = &copied
+	return nil
+}
+
+func (m *MockStorage) ListJournalEntries(ctx context.Context, filters storage.JournalFilters) ([]*models.JournalEntry, error) {
+	var entries []*models.JournalEntry
+	for _, entry := range m.journal {
+		if entry.UserID != filters.UserID || entry.State != filters.State ||
+			(filters.SessionID != "" && entry.SessionID != filters.SessionID) ||
+			(filters.TokenID != "" && entry.TokenID != filters.TokenID) {
+			continue
+		}
+		copied := *entry
+		entries = append(entries, &copied)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if filters.State == models.JournalUndone {
+			return entries[i].UndoneAt.After(*entries[j].UndoneAt)
+		}
+		return entries[i].CreatedAt.After(entries[j].CreatedAt)
+	})
+	if filters.Limit > 0 && len(entries) > filters.Limit {
+		entries = entries[:filters.Limit]
+	}
+	return entries, nil
+}
+
+// RunTransaction applies the writes of fn once it returns nil, so a failing
+// fn leaves the storage unchanged
+func (m *MockStorage) RunTransaction(ctx context.Context, userID string, fn func(tx storage.Tx) error) error {
+	tx := &mockTx{m: m, userID: userID}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	for _, write := range tx.writes {
+		write()
+	}
+	return nil
+}
+
+// mockTx buffers writes until the transaction commits
+type mockTx struct {
+	m      *MockStorage
+	userID string
+	writes []func()
+}
+
+func (t *mockTx) read() error {
+	if len(t.writes) > 0 {
+		return fmt.Errorf("read after write in transaction")
+	}
+	return nil
+}
+
+func (t *mockTx) GetTodo(id string) (*models.Todo, error) {
+	if err := t.read(); err != nil {
+		return nil, err
+	}
+	todo, exists := t.m.todos[id]
+	if !exists || todo.UserID != t.userID {
+		return nil, apperr.Errorf(apperr.NotFound, "todo not found")
+	}
+	copied := *todo
+	return &copied, nil
+}
+
+func (t *mockTx) SetTodo(todo *models.Todo) error {
+	copied := *todo
+	t.writes = append(t.writes, func() { t.m.todos[copied.ID] = &copied })
+	return nil
+}
+
+func (t *mockTx) DeleteTodo(id string) error {
+	t.writes = append(t.writes, func() { delete(t.m.todos, id) })
+	return nil
+}
+
+func (t *mockTx) GetMemo(id string) (*models.Memo, error) {
+	if err := t.read(); err != nil {
+		return nil, err
+	}
+	memo, exists := t.m.memos[id]
+	if !exists || memo.UserID != t.userID {
+		return nil, apperr.Errorf(apperr.NotFound, "memo not found")
+	}
+	copied := *memo
+	return &copied, nil
+}
+
+func (t *mockTx) SetMemo(memo *models.Memo) error {
+	copied := *memo
+	t.writes = append(t.writes, func() { t.m.memos[copied.ID] = &copied })
+	return nil
+}
+
+func (t *mockTx) DeleteMemo(id string) error {
+	t.writes = append(t.writes, func() { delete(t.m.memos, id) })
+	return nil
+}
+
+func (t *mockTx) GetJournalEntry(id string) (*models.JournalEntry, error) {
+	if err := t.read(); err != nil {
+		return nil, err
+	}
+	entry, exists := t.m.journal[id]
+	if !exists || entry.UserID != t.userID {
+		return nil, apperr.Errorf(apperr.NotFound, "journal entry not found")
+	}
+	copied := *entry
+	return &copied, nil
+}
+
+func (t *mockTx) SetJournalEntry(entry *models.JournalEntry) error {
+	copied := *entry
+	t.writes = append(t.writes, func() { t.m.journal[copied.ID] = &copied })
+	return nil
+}
+
 func (m *MockStorage) Search(ctx context.Context, query string, filters storage.SearchFilters) (*storage.SearchResults, error) {
 	results := &storage.SearchResults{
 		Todos: []*models.Todo{},
@@ -177,6 +362,9 @@ func (m *MockStorage) matchesTodo(todo *models.Todo, filters storage.TodoFilters
 	if filters.Priority != nil && todo.Priority != *filters.Priority {
 		return false
 	}
+	if filters.ParentID != nil && todo.ParentID != *filters.ParentID {
+		return false
+	}
 	if len(filters.Tags) > 0 {
 		for _, filterTag := range filters.Tags {
 			found := false
@@ -199,6 +387,9 @@ func (m *MockStorage) matchesMemo(memo *models.Memo, filters storage.MemoFilters
 	if filters.UserID != "" && memo.UserID != filters.UserID {
 		return false
 	}
+	if filters.LinkedTodo != "" && !slices.Contains(memo.LinkedTodos, filters.LinkedTodo) {
+		return false
+	}
 	if len(filters.Tags) > 0 {
 		for _, filterTag := range filters.Tags {
 			found := false
@@ -340,7 +531,7 @@ func (m *MockStorage) CreateUser(ctx context.Context, user *models.User) error {
 func (m *MockStorage) GetUser(ctx context.Context, id string) (*models.User, error) {
 	user, exists := m.users[id]
 	if !exists {
-		return nil, fmt.Errorf("user not found")
+		return nil, apperr.Errorf(apperr.NotFound, "user not found")
 	}
 	return user, nil
 }
@@ -351,12 +542,12 @@ func (m *MockStorage) GetUserByGoogleID(ctx context.Context, googleID string) (*
 			return user, nil
 		}
 	}
-	return nil, fmt.Errorf("user not found")
+	return nil, apperr.Errorf(apperr.NotFound, "user not found")
 }
 
 func (m *MockStorage) UpdateUser(ctx context.Context, user *models.User) error {
 	if _, exists := m.users[user.ID]; !exists {
-		return fmt.Errorf("user not found")
+		return apperr.Errorf(apperr.NotFound, "user not found")
 	}
 	m.users[user.ID] = user
 	return nil
@@ -364,7 +555,7 @@ func (m *MockStorage) UpdateUser(ctx context.Context, user *models.User) error {
 
 func (m *MockStorage) DeleteUser(ctx context.Context, id string) error {
 	if _, exists := m.users[id]; !exists {
-		return fmt.Errorf("user not found")
+		return apperr.Errorf(apperr.NotFound, "user not found")
 	}
 
 	// Delete all user's memos
@@ -381,6 +572,41 @@ func (m *MockStorage) DeleteUser(ctx context.Context, id string) error {
 		}
 	}
 
+	// Delete all user's journal entries
+	for entryID, entry := range m.journal {
+		if entry.UserID == id {
+			delete(m.journal, entryID)
+		}
+	}
+
+	// Delete all user's access tokens
+	for tokenID, token := range m.accessTokens {
+		if token.UserID == id {
+			delete(m.accessTokens, tokenID)
+		}
+	}
+
+	// Delete all user's refresh tokens
+	for tokenID, token := range m.refreshTokens {
+		if token.UserID == id {
+			delete(m.refreshTokens, tokenID)
+		}
+	}
+
+	// Delete all user's sessions
+	for sessionID, session := range m.sessions {
+		if session.UserID == id {
+			delete(m.sessions, sessionID)
+		}
+	}
+
+	// Delete all user's linked identities
+	for identityID, identity := range m.identities {
+		if identity.UserID == id {
+			delete(m.identities, identityID)
+		}
+	}
+
 	// Delete user record
 	delete(m.users, id)
 	return nil
@@ -395,14 +621,14 @@ func (m *MockStorage) CreateDeviceAuthSession(ctx context.Context, session *mode
 func (m *MockStorage) GetDeviceAuthSession(ctx context.Context, deviceCode string) (*models.DeviceAuthSession, error) {
 	session, exists := m.deviceAuthSessions[deviceCode]
 	if !exists {
-		return nil, fmt.Errorf("device auth session not found")
+		return nil, apperr.Errorf(apperr.NotFound, "device auth session not found")
 	}
 	return session, nil
 }
 
 func (m *MockStorage) UpdateDeviceAuthSession(ctx context.Context, session *models.DeviceAuthSession) error {
 	if _, exists := m.deviceAuthSessions[session.DeviceCode]; !exists {
-		return fmt.Errorf("device auth session not found")
+		return apperr.Errorf(apperr.NotFound, "device auth session not found")
 	}
 	m.deviceAuthSessions[session.DeviceCode] = session
 	return nil
@@ -410,12 +636,184 @@ func (m *MockStorage) UpdateDeviceAuthSession(ctx context.Context, session *mode
 
 func (m *MockStorage) DeleteDeviceAuthSession(ctx context.Context, deviceCode string) error {
 	if _, exists := m.deviceAuthSessions[deviceCode]; !exists {
-		return fmt.Errorf("device auth session not found")
+		return apperr.Errorf(apperr.NotFound, "device auth session not found")
 	}
 	delete(m.deviceAuthSessions, deviceCode)
 	return nil
 }
 
+func (m *MockStorage) DeleteExpiredDeviceAuthSessions(ctx context.Context, before time.Time) (int, error) {
+	deleted := 0
+	for deviceCode, session := range m.deviceAuthSessions {
+		if session.ExpiresAt.Before(before) {
+			delete(m.deviceAuthSessions, deviceCode)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// Personal access token operations
+func (m *MockStorage) CreateAccessToken(ctx context.Context, token *models.AccessToken) error {
+	m.accessTokens[token.ID] = token
+	return nil
+}
+
+func (m *MockStorage) GetAccessToken(ctx context.Context, id string) (*models.AccessToken, error) {
+	token, exists := m.accessTokens[id]
+	if !exists {
+		return nil, apperr.Errorf(apperr.NotFound, "access token not found")
+	}
+	return token, nil
+}
+
+func (m *MockStorage) GetAccessTokenByHash(ctx context.Context, tokenHash string) (*models.AccessToken, error) {
+	for _, token := range m.accessTokens {
+		if token.TokenHash == tokenHash {
+			return token, nil
+		}
+	}
+	return nil, apperr.Errorf(apperr.NotFound, "access token not found")
+}
+
+func (m *MockStorage) UpdateAccessToken(ctx context.Context, token *models.AccessToken) error {
+	if _, exists := m.accessTokens[token.ID]; !exists {
+		return apperr.Errorf(apperr.NotFound, "access token not found")
+	}
+	m.accessTokens[token.ID] = token
+	return nil
+}
+
+func (m *MockStorage) DeleteAccessToken(ctx context.Context, id string) error {
+	if _, exists := m.accessTokens[id]; !exists {
+		return apperr.Errorf(apperr.NotFound, "access token not found")
+	}
+	delete(m.accessTokens, id)
+	return nil
+}
+
+func (m *MockStorage) ListAccessTokens(ctx context.Context, userID string) ([]*models.AccessToken, error) {
+	var result []*models.AccessToken
+	for _, token := range m.accessTokens {
+		if token.UserID == userID {
+			result = append(result, token)
+		}
+	}
+	return result, nil
+}
+
+// Refresh token operations
+func (m *MockStorage) CreateRefreshToken(ctx context.Context, token *models.RefreshToken) error {
+	m.refreshTokens[token.ID] = token
+	return nil
+}
+
+func (m *MockStorage) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	for _, token := range m.refreshTokens {
+		if token.TokenHash == tokenHash {
+			return token, nil
+		}
+	}
+	return nil, apperr.Errorf(apperr.NotFound, "refresh token not found")
+}
+
+func (m *MockStorage) RotateRefreshToken(ctx context.Context, token, next *models.RefreshToken) error {
+	stored, exists := m.refreshTokens[token.ID]
+	if !exists {
+		return apperr.Errorf(apperr.NotFound, "refresh token not found")
+	}
+	if stored.RotatedAt != nil {
+		return apperr.Errorf(apperr.Conflict, "refresh token was already rotated")
+	}
+	m.refreshTokens[token.ID] = token
+	m.refreshTokens[next.ID] = next
+	return nil
+}
+
+func (m *MockStorage) DeleteRefreshTokenFamily(ctx context.Context, familyID string) error {
+	for tokenID, token := range m.refreshTokens {
+		if token.FamilyID == familyID {
+			delete(m.refreshTokens, tokenID)
+		}
+	}
+	return nil
+}
+
+// Session operations
+func (m *MockStorage) CreateSession(ctx context.Context, session *models.Session) error {
+	m.sessions[session.ID] = session
+	return nil
+}
+
+func (m *MockStorage) GetSession(ctx context.Context, id string) (*models.Session, error) {
+	session, exists := m.sessions[id]
+	if !exists {
+		return nil, apperr.Errorf(apperr.NotFound, "session not found")
+	}
+	return session, nil
+}
+
+func (m *MockStorage) UpdateSession(ctx context.Context, session *models.Session) error {
+	if _, exists := m.sessions[session.ID]; !exists {
+		return apperr.Errorf(apperr.NotFound, "session not found")
+	}
+	m.sessions[session.ID] = session
+	return nil
+}
+
+func (m *MockStorage) DeleteSession(ctx context.Context, id string) error {
+	if _, exists := m.sessions[id]; !exists {
+		return apperr.Errorf(apperr.NotFound, "session not found")
+	}
+	delete(m.sessions, id)
+	return nil
+}
+
+func (m *MockStorage) ListSessions(ctx context.Context, userID string) ([]*models.Session, error) {
+	var result []*models.Session
+	for _, session := range m.sessions {
+		if session.UserID == userID {
+			result = append(result, session)
+		}
+	}
+	return result, nil
+}
+
+// Identity operations
+func (m *MockStorage) CreateIdentity(ctx context.Context, identity *models.Identity) error {
+	if _, exists := m.identities[identity.ID]; exists {
+		return apperr.Errorf(apperr.Conflict, "identity already linked")
+	}
+	m.identities[identity.ID] = identity
+	return nil
+}
+
+func (m *MockStorage) GetIdentity(ctx context.Context, id string) (*models.Identity, error) {
+	identity, exists := m.identities[id]
+	if !exists {
+		return nil, apperr.Errorf(apperr.NotFound, "identity not found")
+	}
+	return identity, nil
+}
+
+func (m *MockStorage) DeleteIdentity(ctx context.Context, id string) error {
+	if _, exists := m.identities[id]; !exists {
+		return apperr.Errorf(apperr.NotFound, "identity not found")
+	}
+	delete(m.identities, id)
+	return nil
+}
+
+func (m *MockStorage) ListIdentities(ctx context.Context, userID string) ([]*models.Identity, error) {
+	var result []*models.Identity
+	for _, identity := range m.identities {
+		if identity.UserID == userID {
+			result = append(result, identity)
+		}
+	}
+	return result, nil
+}
+
 // Helper methods for testing
 func (m *MockStorage) GetUsers() map[string]*models.User {
 	return m.users
@@ -0,0 +1,22 @@
+package handlers
+
+import "context"
+
+type preconditionKey struct{}
+
+// WithPrecondition returns a context in which the tools updating or deleting
+// a todo or memo call check with the item as stored, in the transaction
+// changing it, and fail with the error check returns. The REST API checks
+// If-Match this way, so that no concurrent change slips in between.
+func WithPrecondition(ctx context.Context, check func(item any) error) context.Context {
+	return context.WithValue(ctx, preconditionKey{}, check)
+}
+
+// checkPrecondition checks the precondition of ctx, if any, on item
+func checkPrecondition(ctx context.Context, item any) error {
+	check, ok := ctx.Value(preconditionKey{}).(func(item any) error)
+	if !ok {
+		return nil
+	}
+	return check(item)
+}
@@ -0,0 +1,360 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+// Prompt names
+const (
+	PromptPlanMyDay           = "plan_my_day"
+	PromptWeeklyReview        = "weekly_review"
+	PromptBrainstormFromMemos = "brainstorm_from_memos"
+	PromptTriageBacklog       = "triage_backlog"
+)
+
+var (
+	tagsPromptArgument = &mcp.PromptArgument{
+		Name:        "tags",
+		Description: "Comma-separated tags to limit the scope (e.g. work,urgent)",
+	}
+	fromPromptArgument = &mcp.PromptArgument{
+		Name:        "from",
+		Description: "Start of the date range (YYYY-MM-DD)",
+	}
+	toPromptArgument = &mcp.PromptArgument{
+		Name:        "to",
+		Description: "End of the date range, inclusive (YYYY-MM-DD)",
+	}
+)
+
+// Prompts returns the definitions of all prompts served by PromptHandler
+func Prompts() []*mcp.Prompt {
+	return []*mcp.Prompt{
+		{
+			Name:        PromptPlanMyDay,
+			Title:       "Plan my day",
+			Description: "Suggest what to work on today based on open todos and recent memos",
+			Arguments:   []*mcp.PromptArgument{tagsPromptArgument},
+		},
+		{
+			Name:        PromptWeeklyReview,
+			Title:       "Weekly review",
+			Description: "Review what was done and captured in a date range (default: last 7 days)",
+			Arguments:   []*mcp.PromptArgument{tagsPromptArgument, fromPromptArgument, toPromptArgument},
+		},
+		{
+			Name:        PromptBrainstormFromMemos,
+			Title:       "Brainstorm from memos",
+			Description: "Brush up accumulated ideas in memos into concrete proposals",
+			Arguments:   []*mcp.PromptArgument{tagsPromptArgument, fromPromptArgument, toPromptArgument},
+		},
+		{
+			Name:        PromptTriageBacklog,
+			Title:       "Triage backlog",
+			Description: "Go through backlog todos and decide what to promote, merge or drop",
+			Arguments:   []*mcp.PromptArgument{tagsPromptArgument},
+		},
+	}
+}
+
+type PromptHandler struct {
+	storage storage.Storage
+	now     func() time.Time
+}
+
+func NewPromptHandler(storage storage.Storage) *PromptHandler {
+	return &PromptHandler{
+		storage: storage,
+		now:     time.Now,
+	}
+}
+
+// Get renders the requested prompt from the user's todos and memos
+func (h *PromptHandler) Get(ctx context.Context, ss *mcp.ServerSession, params *mcp.GetPromptParams) (*mcp.GetPromptResult, error) {
+	if h.storage == nil {
+		return nil, fmt.Errorf("storage not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+	if err := auth.RequireScope(ctx, auth.ScopeTodosRead, auth.ScopeMemosRead); err != nil {
+		return nil, err
+	}
+
+	args := params.Arguments
+	tags := splitTags(args["tags"])
+
+	switch params.Name {
+	case PromptPlanMyDay:
+		return h.planMyDay(ctx, userID, tags)
+	case PromptWeeklyReview:
+		from, to, err := h.dateRange(args, 7)
+		if err != nil {
+			return nil, err
+		}
+		return h.weeklyReview(ctx, userID, tags, from, to)
+	case PromptBrainstormFromMemos:
+		from, to, err := h.dateRange(args, 30)
+		if err != nil {
+			return nil, err
+		}
+		return h.brainstormFromMemos(ctx, userID, tags, from, to)
+	case PromptTriageBacklog:
+		return h.triageBacklog(ctx, userID, tags)
+	default:
+		return nil, apperr.Errorf(apperr.Validation, "unknown prompt: %s", params.Name)
+	}
+}
+
+func (h *PromptHandler) planMyDay(ctx context.Context, userID string, tags []string) (*mcp.GetPromptResult, error) {
+	todos, err := h.storage.ListTodos(ctx, storage.TodoFilters{UserID: userID, Tags: tags})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list todos: %w", err)
+	}
+	memos, err := h.storage.ListMemos(ctx, storage.MemoFilters{UserID: userID, Tags: tags})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memos: %w", err)
+	}
+
+	var inProgress, ready []*models.Todo
+	for _, todo := range todos {
+		switch todo.Status {
+		case models.StatusInProgress:
+			inProgress = append(inProgress, todo)
+		case models.StatusTodo:
+			ready = append(ready, todo)
+		}
+	}
+	sortTodosByPriority(ready)
+
+	since := h.now().AddDate(0, 0, -3)
+	recentMemos := filterMemos(memos, func(m *models.Memo) bool { return m.LastModified.After(since) })
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Today is %s. Help me decide what to work on today%s.\n\n", h.now().Format("Monday, 2006-01-02"), scopeSuffix(tags))
+	writeTodoSection(&b, "Todos in progress", inProgress)
+	writeTodoSection(&b, "Todos ready to start (high priority first)", ready)
+	writeMemoSection(&b, "Memos updated in the last 3 days", recentMemos)
+	b.WriteString("Propose a realistic plan for today: pick the few todos to focus on, in order, ")
+	b.WriteString("explain why, and point out anything in progress that looks stalled. ")
+	b.WriteString("Refer to todos by their ID so I can update them with todo_update.")
+
+	return promptResult("Plan for today", b.String()), nil
+}
+
+func (h *PromptHandler) weeklyReview(ctx context.Context, userID string, tags []string, from, to time.Time) (*mcp.GetPromptResult, error) {
+	todos, err := h.storage.ListTodos(ctx, storage.TodoFilters{UserID: userID, Tags: tags})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list todos: %w", err)
+	}
+	memos, err := h.storage.ListMemos(ctx, storage.MemoFilters{UserID: userID, Tags: tags})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memos: %w", err)
+	}
+
+	var done, created, open []*models.Todo
+	for _, todo := range todos {
+		if todo.Status == models.StatusDone {
+			if todo.ClosedAt != nil && inRange(*todo.ClosedAt, from, to) {
+				done = append(done, todo)
+			}
+			continue
+		}
+		if inRange(todo.CreatedAt, from, to) {
+			created = append(created, todo)
+		} else if todo.Status == models.StatusInProgress {
+			open = append(open, todo)
+		}
+	}
+	capturedMemos := filterMemos(memos, func(m *models.Memo) bool { return inRange(m.CreatedAt, from, to) })
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Let's do a review of %s to %s%s.\n\n", from.Format("2006-01-02"), to.Format("2006-01-02"), scopeSuffix(tags))
+	writeTodoSection(&b, "Todos completed", done)
+	writeTodoSection(&b, "Todos added and still open", created)
+	writeTodoSection(&b, "Todos in progress since before this period", open)
+	writeMemoSection(&b, "Memos captured", capturedMemos)
+	b.WriteString("Summarize what got done, what is carried over and any patterns you notice. ")
+	b.WriteString("Suggest priorities for the next period and todos that should be re-scoped or dropped.")
+
+	return promptResult("Review of the period", b.String()), nil
+}
+
+func (h *PromptHandler) brainstormFromMemos(ctx context.Context, userID string, tags []string, from, to time.Time) (*mcp.GetPromptResult, error) {
+	memos, err := h.storage.ListMemos(ctx, storage.MemoFilters{UserID: userID, Tags: tags})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memos: %w", err)
+	}
+
+	ideas := filterMemos(memos, func(m *models.Memo) bool { return inRange(m.LastModified, from, to) })
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Here are the memos I wrote between %s and %s%s.\n\n", from.Format("2006-01-02"), to.Format("2006-01-02"), scopeSuffix(tags))
+	writeMemoSection(&b, "Memos", ideas)
+	b.WriteString("Group related ideas, brush up the promising ones into concrete proposals, ")
+	b.WriteString("and for each proposal suggest a first actionable todo. ")
+	b.WriteString("Mention memo IDs so the todos can be linked back with linked_todos.")
+
+	return promptResult("Brainstorm from memos", b.String()), nil
+}
+
+func (h *PromptHandler) triageBacklog(ctx context.Context, userID string, tags []string) (*mcp.GetPromptResult, error) {
+	status := models.StatusBacklog
+	backlog, err := h.storage.ListTodos(ctx, storage.TodoFilters{UserID: userID, Status: &status, Tags: tags})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list todos: %w", err)
+	}
+
+	// Oldest first: those are the most likely to be stale
+	sort.SliceStable(backlog, func(i, j int) bool {
+		return backlog[i].CreatedAt.Before(backlog[j].CreatedAt)
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Help me triage my backlog%s.\n\n", scopeSuffix(tags))
+	writeTodoSection(&b, "Backlog todos (oldest first)", backlog)
+	b.WriteString("For each todo, recommend one of: promote to todo, keep in backlog, merge with another todo, or delete. ")
+	b.WriteString("Flag duplicates and vague items that need a clearer title. ")
+	b.WriteString("Refer to todos by their ID so the changes can be applied with todo_update and todo_delete.")
+
+	return promptResult("Backlog triage", b.String()), nil
+}
+
+// dateRange parses the from/to arguments, defaulting to the last defaultDays
+// days. With only to given, the range is the defaultDays days before it.
+func (h *PromptHandler) dateRange(args map[string]string, defaultDays int) (time.Time, time.Time, error) {
+	now := h.now()
+	to := now
+	from := now.AddDate(0, 0, -defaultDays)
+
+	if v := strings.TrimSpace(args["to"]); v != "" {
+		t, err := time.ParseInLocation("2006-01-02", v, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, apperr.Errorf(apperr.Validation, "invalid 'to' date %q: expected YYYY-MM-DD", v)
+		}
+		// Inclusive: cover the whole day
+		to = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+		from = t.AddDate(0, 0, -defaultDays)
+	}
+
+	if v := strings.TrimSpace(args["from"]); v != "" {
+		t, err := time.ParseInLocation("2006-01-02", v, now.Location())
+		if err != nil {
+			return time.Time{}, time.Time{}, apperr.Errorf(apperr.Validation, "invalid 'from' date %q: expected YYYY-MM-DD", v)
+		}
+		from = t
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, apperr.Errorf(apperr.Validation, "invalid date range: 'from' is after 'to'")
+	}
+
+	return from, to, nil
+}
+
+func promptResult(description, text string) *mcp.GetPromptResult {
+	return &mcp.GetPromptResult{
+		Description: description,
+		Messages: []*mcp.PromptMessage{
+			{Role: "user", Content: &mcp.TextContent{Text: text}},
+		},
+	}
+}
+
+func writeTodoSection(b *strings.Builder, title string, todos []*models.Todo) {
+	fmt.Fprintf(b, "## %s (%d)\n", title, len(todos))
+	if len(todos) == 0 {
+		b.WriteString("(none)\n\n")
+		return
+	}
+	for _, todo := range todos {
+		fmt.Fprintf(b, "- %s (id: %s, status: %s, priority: %s", todo.Title, todo.ID, todo.Status, todo.Priority)
+		if len(todo.Tags) > 0 {
+			fmt.Fprintf(b, ", tags: %s", strings.Join(todo.Tags, ", "))
+		}
+		b.WriteString(")\n")
+		if todo.Description != "" {
+			fmt.Fprintf(b, "  %s\n", firstLine(todo.Description))
+		}
+	}
+	b.WriteString("\n")
+}
+
+func writeMemoSection(b *strings.Builder, title string, memos []*models.Memo) {
+	fmt.Fprintf(b, "## %s (%d)\n", title, len(memos))
+	if len(memos) == 0 {
+		b.WriteString("(none)\n\n")
+		return
+	}
+	for _, memo := range memos {
+		fmt.Fprintf(b, "- %s (id: %s", memo.Title, memo.ID)
+		if len(memo.Tags) > 0 {
+			fmt.Fprintf(b, ", tags: %s", strings.Join(memo.Tags, ", "))
+		}
+		b.WriteString(")\n")
+		if memo.Description != "" {
+			fmt.Fprintf(b, "  %s\n", firstLine(memo.Description))
+		}
+	}
+	b.WriteString("\n")
+}
+
+func sortTodosByPriority(todos []*models.Todo) {
+	sort.SliceStable(todos, func(i, j int) bool {
+		return todos[i].Priority == models.PriorityHigh && todos[j].Priority != models.PriorityHigh
+	})
+}
+
+func filterMemos(memos []*models.Memo, keep func(*models.Memo) bool) []*models.Memo {
+	var result []*models.Memo
+	for _, memo := range memos {
+		if keep(memo) {
+			result = append(result, memo)
+		}
+	}
+	return result
+}
+
+func inRange(t, from, to time.Time) bool {
+	return !t.Before(from) && !t.After(to)
+}
+
+func splitTags(s string) []string {
+	var tags []string
+	for _, tag := range strings.Split(s, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+func scopeSuffix(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (scope: tags %s)", strings.Join(tags, ", "))
+}
+
+// firstLine returns the first line of s, truncated to 200 characters
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	if utf8.RuneCountInString(line) > 200 {
+		return string([]rune(line)[:200]) + "..."
+	}
+	return line
+}
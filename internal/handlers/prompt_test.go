@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/auth"
+)
+
+func getPromptText(t *testing.T, handler *PromptHandler, name string, args map[string]string) string {
+	t.Helper()
+
+	params := &mcp.GetPromptParams{
+		Name:      name,
+		Arguments: args,
+	}
+
+	// Create context with test user ID
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	result, err := handler.Get(ctx, nil, params)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result.Messages) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(result.Messages))
+	}
+
+	textContent, ok := result.Messages[0].Content.(*mcp.TextContent)
+	if !ok {
+		t.Fatal("Expected TextContent")
+	}
+
+	return textContent.Text
+}
+
+func TestPromptHandler_PlanMyDay(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	handler := NewPromptHandler(mockStorage)
+
+	text := getPromptText(t, handler, PromptPlanMyDay, nil)
+
+	for _, want := range []string{"Test Todo 1", "Test Todo 2", "Test Memo 1", "Test Memo 2"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected prompt to mention %q", want)
+		}
+	}
+}
+
+func TestPromptHandler_LongDescription(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	handler := NewPromptHandler(mockStorage)
+	todo, _ := mockStorage.GetTodo(context.Background(), "test-todo-2")
+	todo.Description = strings.Repeat("日本語の説明", 50)
+
+	text := getPromptText(t, handler, PromptPlanMyDay, nil)
+
+	if !utf8.ValidString(text) {
+		t.Error("Expected the prompt to be valid UTF-8")
+	}
+	if want := strings.Repeat("日本語の説明", 33) + "日本..."; !strings.Contains(text, want) {
+		t.Errorf("Expected the description to be cut at 200 characters, got %q", text)
+	}
+}
+
+func TestPromptHandler_TagScope(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	handler := NewPromptHandler(mockStorage)
+
+	text := getPromptText(t, handler, PromptWeeklyReview, map[string]string{"tags": "work"})
+
+	if !strings.Contains(text, "Test Todo 1") || !strings.Contains(text, "Test Memo 1") {
+		t.Error("Expected prompt to include items tagged work")
+	}
+	if strings.Contains(text, "Test Todo 2") || strings.Contains(text, "Test Memo 2") {
+		t.Error("Expected prompt to exclude items without tag work")
+	}
+}
+
+func TestPromptHandler_DateRange(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	handler := NewPromptHandler(mockStorage)
+
+	// Test data is created now, so a range in the past matches nothing
+	text := getPromptText(t, handler, PromptBrainstormFromMemos, map[string]string{
+		"from": "2020-01-01",
+		"to":   "2020-01-31",
+	})
+
+	if strings.Contains(text, "Test Memo 1") {
+		t.Error("Expected memos outside the range to be excluded")
+	}
+	if !strings.Contains(text, "2020-01-01 and 2020-01-31") {
+		t.Error("Expected prompt to mention the requested range")
+	}
+}
+
+func TestPromptHandler_DateRangeToOnly(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	handler := NewPromptHandler(mockStorage)
+
+	// The range ends at a past 'to', starting the default days before it
+	text := getPromptText(t, handler, PromptWeeklyReview, map[string]string{"to": "2020-01-31"})
+
+	if !strings.Contains(text, "2020-01-24 to 2020-01-31") {
+		t.Errorf("Expected the week before 'to' to be reviewed, got %q", text)
+	}
+}
+
+func TestPromptHandler_InvalidArguments(t *testing.T) {
+	mockStorage := NewMockStorage()
+	handler := NewPromptHandler(mockStorage)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	tests := []struct {
+		name   string
+		params *mcp.GetPromptParams
+	}{
+		{"unknown prompt", &mcp.GetPromptParams{Name: "unknown"}},
+		{"invalid date", &mcp.GetPromptParams{Name: PromptWeeklyReview, Arguments: map[string]string{"from": "last week"}}},
+		{"reversed range", &mcp.GetPromptParams{Name: PromptWeeklyReview, Arguments: map[string]string{"from": "2025-02-01", "to": "2025-01-01"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := handler.Get(ctx, nil, tt.params); err == nil {
+				t.Error("Expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestPromptHandler_RequiresAuth(t *testing.T) {
+	handler := NewPromptHandler(NewMockStorage())
+
+	_, err := handler.Get(context.Background(), nil, &mcp.GetPromptParams{Name: PromptPlanMyDay})
+	if err == nil {
+		t.Error("Expected authentication error, got nil")
+	}
+}
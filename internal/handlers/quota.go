@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/config"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+// Quota enforces how much each user may store. A nil Quota enforces nothing.
+// The item counts are checked before creating an item, so concurrent creates
+// may overshoot a quota slightly.
+type Quota struct {
+	storage storage.Storage
+
+	mu     sync.RWMutex
+	limits config.QuotaConfig
+}
+
+func NewQuota(storage storage.Storage, limits config.QuotaConfig) *Quota {
+	return &Quota{
+		storage: storage,
+		limits:  limits,
+	}
+}
+
+// Limits returns the enforced limits
+func (q *Quota) Limits() config.QuotaConfig {
+	if q == nil {
+		return config.QuotaConfig{}
+	}
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	return q.limits
+}
+
+// SetLimits replaces the enforced limits
+func (q *Quota) SetLimits(limits config.QuotaConfig) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.limits = limits
+}
+
+// CheckItem checks the description and tags of a todo or memo
+func (q *Quota) CheckItem(description string, tags []string) error {
+	limits := q.Limits()
+	if limits.MaxDescriptionBytes > 0 && len(description) > limits.MaxDescriptionBytes {
+		return apperr.Errorf(apperr.Validation, "description is %d bytes, more than the limit of %d bytes", len(description), limits.MaxDescriptionBytes)
+	}
+	if limits.MaxTagsPerItem > 0 && len(tags) > limits.MaxTagsPerItem {
+		return apperr.Errorf(apperr.Validation, "%d tags given, more than the limit of %d tags per item", len(tags), limits.MaxTagsPerItem)
+	}
+	return nil
+}
+
+// CheckNewTodo fails with QuotaExceeded when the user has as many todos as
+// they may have
+func (q *Quota) CheckNewTodo(ctx context.Context, userID string) error {
+	return q.checkCount(ctx, "todos", q.Limits().MaxTodos, 1, func() (int, error) {
+		return q.storage.CountTodos(ctx, userID)
+	})
+}
+
+// CheckNewMemo fails with QuotaExceeded when the user has as many memos as
+// they may have
+func (q *Quota) CheckNewMemo(ctx context.Context, userID string) error {
+	return q.checkCount(ctx, "memos", q.Limits().MaxMemos, 1, func() (int, error) {
+		return q.storage.CountMemos(ctx, userID)
+	})
+}
+
+// CheckNewItems fails with QuotaExceeded when the user may not have the given
+// numbers of todos and memos more
+func (q *Quota) CheckNewItems(ctx context.Context, userID string, todos, memos int) error {
+	if todos > 0 {
+		if err := q.checkCount(ctx, "todos", q.Limits().MaxTodos, todos, func() (int, error) {
+			return q.storage.CountTodos(ctx, userID)
+		}); err != nil {
+			return err
+		}
+	}
+	if memos > 0 {
+		return q.checkCount(ctx, "memos", q.Limits().MaxMemos, memos, func() (int, error) {
+			return q.storage.CountMemos(ctx, userID)
+		})
+	}
+	return nil
+}
+
+// checkCount fails when n more items would be more than limit
+func (q *Quota) checkCount(ctx context.Context, kind string, limit, n int, count func() (int, error)) error {
+	if q == nil || limit <= 0 {
+		return nil
+	}
+	current, err := count()
+	if err != nil {
+		return fmt.Errorf("failed to count %s: %w", kind, err)
+	}
+	if current+n > limit {
+		if n > 1 {
+			return apperr.Errorf(apperr.QuotaExceeded, "quota of %d %s would be exceeded by creating %d more; %d may be created", limit, kind, n, max(limit-current, 0))
+		}
+		return apperr.Errorf(apperr.QuotaExceeded, "quota of %d %s reached; delete some before creating more", limit, kind)
+	}
+	return nil
+}
+
+// RateLimitStatus reports a user's request rate limit
+type RateLimitStatus struct {
+	PerMinute int `json:"per_minute"` // Requests per minute; 0 if unlimited
+	Burst     int `json:"burst"`      // Requests that may be made at once
+	Remaining int `json:"remaining"`  // Requests that may be made right now; -1 if unlimited
+}
+
+// QuotaUsage reports how much of a quota is used
+type QuotaUsage struct {
+	Used  int `json:"used"`
+	Limit int `json:"limit"` // 0 if unlimited
+}
+
+type QuotaHandler struct {
+	quota     *Quota
+	rateLimit func(userID string) RateLimitStatus
+}
+
+// NewQuotaHandler creates the quota_status handler. rateLimit reports the
+// request rate limit of a user.
+func NewQuotaHandler(quota *Quota, rateLimit func(userID string) RateLimitStatus) *QuotaHandler {
+	return &QuotaHandler{
+		quota:     quota,
+		rateLimit: rateLimit,
+	}
+}
+
+// QuotaStatusArgs represents arguments for reporting quotas
+type QuotaStatusArgs struct {
+	// No arguments needed
+}
+
+// QuotaStatusResult represents the result of the quota_status tool
+type QuotaStatusResult struct {
+	Success             bool            `json:"success"`
+	RateLimit           RateLimitStatus `json:"rate_limit"`
+	Todos               QuotaUsage      `json:"todos"`
+	Memos               QuotaUsage      `json:"memos"`
+	MaxDescriptionBytes int             `json:"max_description_bytes"` // 0 if unlimited
+	MaxTagsPerItem      int             `json:"max_tags_per_item"`     // 0 if unlimited
+	Message             string          `json:"message"`
+}
+
+// Status reports the user's rate limit and how much of their quotas they use
+func (h *QuotaHandler) Status(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[QuotaStatusArgs]) (*mcp.CallToolResultFor[QuotaStatusResult], error) {
+	if h.quota == nil || h.quota.storage == nil {
+		return nil, fmt.Errorf("storage not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	todos, err := h.quota.storage.CountTodos(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count todos: %w", err)
+	}
+	memos, err := h.quota.storage.CountMemos(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count memos: %w", err)
+	}
+
+	limits := h.quota.Limits()
+	result := QuotaStatusResult{
+		Success:             true,
+		RateLimit:           RateLimitStatus{Remaining: -1},
+		Todos:               QuotaUsage{Used: todos, Limit: limits.MaxTodos},
+		Memos:               QuotaUsage{Used: memos, Limit: limits.MaxMemos},
+		MaxDescriptionBytes: limits.MaxDescriptionBytes,
+		MaxTagsPerItem:      limits.MaxTagsPerItem,
+	}
+	if h.rateLimit != nil {
+		result.RateLimit = h.rateLimit(userID)
+	}
+	result.Message = fmt.Sprintf("%s todos and %s memos used", usage(result.Todos), usage(result.Memos))
+
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return &mcp.CallToolResultFor[QuotaStatusResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
+
+// usage formats a quota usage like "12/10000"
+func usage(u QuotaUsage) string {
+	if u.Limit <= 0 {
+		return fmt.Sprintf("%d (unlimited)", u.Used)
+	}
+	return fmt.Sprintf("%d/%d", u.Used, u.Limit)
+}
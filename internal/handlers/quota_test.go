@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/config"
+)
+
+func TestQuota_Enforced(t *testing.T) {
+	mockStorage := NewMockStorage()
+	quota := NewQuota(mockStorage, config.QuotaConfig{MaxTodos: 1, MaxMemos: 1, MaxDescriptionBytes: 8, MaxTagsPerItem: 2})
+	todos := NewTodoHandlerWithStorage(mockStorage)
+	todos.SetQuota(quota)
+	memos := NewMemoHandlerWithStorage(mockStorage)
+	memos.SetQuota(quota)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	createTodo := func(args TodoCreateArgs) error {
+		_, err := todos.Create(ctx, nil, &mcp.CallToolParamsFor[TodoCreateArgs]{Arguments: args})
+		return err
+	}
+
+	if err := createTodo(TodoCreateArgs{Title: "first", Description: "too long description"}); !apperr.Is(err, apperr.Validation) {
+		t.Errorf("Expected a validation error for a long description, got %v", err)
+	}
+	if err := createTodo(TodoCreateArgs{Title: "first", Tags: []string{"a", "b", "c"}}); !apperr.Is(err, apperr.Validation) {
+		t.Errorf("Expected a validation error for too many tags, got %v", err)
+	}
+	if err := createTodo(TodoCreateArgs{Title: "first", Tags: []string{"a", "b"}}); err != nil {
+		t.Fatalf("Expected the first todo to be created, got %v", err)
+	}
+	err := createTodo(TodoCreateArgs{Title: "second"})
+	if !apperr.Is(err, apperr.QuotaExceeded) {
+		t.Fatalf("Expected QuotaExceeded, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "quota of 1 todos") {
+		t.Errorf("Unexpected message: %v", err)
+	}
+
+	// Memos have a quota of their own
+	if _, err := memos.Create(ctx, nil, &mcp.CallToolParamsFor[MemoCreateArgs]{Arguments: MemoCreateArgs{Title: "memo"}}); err != nil {
+		t.Errorf("Expected the memo to be created, got %v", err)
+	}
+
+	// Other users are not affected
+	otherCtx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-2")
+	if _, err := todos.Create(otherCtx, nil, &mcp.CallToolParamsFor[TodoCreateArgs]{Arguments: TodoCreateArgs{Title: "other"}}); err != nil {
+		t.Errorf("Expected another user's todo to be created, got %v", err)
+	}
+}
+
+func TestQuotaHandler_Status(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	quota := NewQuota(mockStorage, config.QuotaConfig{MaxTodos: 100, MaxTagsPerItem: 5})
+	handler := NewQuotaHandler(quota, func(userID string) RateLimitStatus {
+		return RateLimitStatus{PerMinute: 60, Burst: 10, Remaining: 7}
+	})
+
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	result, err := handler.Status(ctx, nil, &mcp.CallToolParamsFor[QuotaStatusArgs]{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var status QuotaStatusResult
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &status); err != nil {
+		t.Fatalf("Failed to unmarshal result: %v", err)
+	}
+	if status.RateLimit.Remaining != 7 || status.RateLimit.PerMinute != 60 {
+		t.Errorf("Unexpected rate limit: %+v", status.RateLimit)
+	}
+	if status.Todos.Used == 0 || status.Todos.Limit != 100 {
+		t.Errorf("Unexpected todo usage: %+v", status.Todos)
+	}
+	if status.Memos.Limit != 0 || status.MaxTagsPerItem != 5 {
+		t.Errorf("Unexpected limits: %+v", status)
+	}
+}
@@ -0,0 +1,344 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/audit"
+	"github.com/pankona/memoya/internal/auth"
+)
+
+// Handlers holds the handler instances tools are bound to. Only the handlers
+// used by the tools being served need to be set.
+type Handlers struct {
+	Memo     *MemoHandler
+	Todo     *TodoHandler
+	Search   *SearchHandler
+	Tag      *TagHandler
+	Token    *TokenHandler
+	Auth     *AuthHandler
+	Quota    *QuotaHandler
+	Activity *ActivityHandler
+	Journal  *JournalHandler
+	Batch    *BatchHandler
+}
+
+// ForwardFunc sends a tool call elsewhere (e.g. to memoya-server) and returns
+// the JSON text of the result
+type ForwardFunc func(ctx context.Context, name string, args any) (string, error)
+
+// Tool is a tool declared once in the registry. The MCP servers, the HTTP
+// endpoints and the bridge are all built from it.
+type Tool struct {
+	Name        string
+	Description string
+	// RequiresAuth is set for tools working on the signed-in user's data
+	RequiresAuth bool
+	// Local tools run in the bridge itself instead of on memoya-server
+	Local bool
+	// Scopes must all be granted to personal access tokens calling the tool
+	Scopes []auth.Scope
+
+	schema  *ToolSchema
+	bind    func(h *Handlers) *mcp.ServerTool
+	forward func(f ForwardFunc) *mcp.ServerTool
+	call    func(ctx context.Context, h *Handlers, data []byte) (*mcp.CallToolResult, error)
+}
+
+// Bind returns the MCP tool calling the tool's handler in h
+func (t *Tool) Bind(h *Handlers) *mcp.ServerTool {
+	return t.bind(h)
+}
+
+// Forward returns the MCP tool passing calls to f
+func (t *Tool) Forward(f ForwardFunc) *mcp.ServerTool {
+	return t.forward(f)
+}
+
+// Call decodes JSON arguments, validates them and calls the tool's handler in
+// h. Bad arguments are reported as ErrInvalidArguments.
+func (t *Tool) Call(ctx context.Context, h *Handlers, data []byte) (*mcp.CallToolResult, error) {
+	return t.call(ctx, h, data)
+}
+
+// tools is the registry. Property descriptions are per tool; enums apply to
+// every property of the same name (see propertyEnums).
+var tools = []*Tool{
+	// Memo tools
+	defineTool("memo_create", "Create a new memo", writeMemos, memoHandler, (*MemoHandler).Create, map[string]string{
+		"title":        "Memo title",
+		"description":  "Memo description",
+		"tags":         "Tags for the memo",
+		"linked_todos": "IDs of linked todos",
+	}),
+	defineTool("memo_get", "Get a memo by ID, with the todos it links", readMemos, memoHandler, (*MemoHandler).Get, map[string]string{
+		"id": "Memo ID to retrieve",
+	}),
+	defineTool("memo_list", "List memos with optional filters", readMemos, memoHandler, (*MemoHandler).List, map[string]string{
+		"tags": "Filter by tags",
+	}),
+	defineTool("memo_update", "Update an existing memo", writeMemos, memoHandler, (*MemoHandler).Update, map[string]string{
+		"id":           "Memo ID to update",
+		"title":        "New title",
+		"description":  "New description",
+		"tags":         "New tags",
+		"linked_todos": "New linked todo IDs",
+	}),
+	defineTool("memo_delete", "Delete a memo", writeMemos, memoHandler, (*MemoHandler).Delete, map[string]string{
+		"id": "Memo ID to delete",
+	}),
+	defineTool("memo_bulk_update", "Add or remove tags of every memo matching a filter. Preview with dry_run first, then pass the returned confirmation token to apply the update.", writeMemos, memoHandler, (*MemoHandler).BulkUpdate, map[string]string{
+		"filter":             "Memos to update, like search: by keyword in the title, description or tags (query) and by tags (all memos if empty)",
+		"patch":              "Tags to add (add_tags) and remove (remove_tags)",
+		"dry_run":            "Only return the IDs of the memos that would change and a confirmation token",
+		"confirmation_token": "Token returned by the dry run, required to apply the update",
+	}),
+
+	// Todo tools
+	defineTool("todo_create", "Create a new todo item", writeTodos, todoHandler, (*TodoHandler).Create, map[string]string{
+		"title":       "Todo title",
+		"description": "Todo description",
+		"status":      "Todo status (default: backlog)",
+		"priority":    "Todo priority (default: normal)",
+		"tags":        "Tags for the todo",
+		"parent_id":   "Parent todo ID for hierarchical structure",
+	}),
+	defineTool("todo_get", "Get a todo item by ID, with the memos linking it as backlinks", readTodos, todoHandler, (*TodoHandler).Get, map[string]string{
+		"id": "Todo ID to retrieve",
+	}),
+	defineTool("todo_list", "List todo items with optional filters", readTodos, todoHandler, (*TodoHandler).List, map[string]string{
+		"status":   "Filter by status",
+		"tags":     "Filter by tags",
+		"priority": "Filter by priority",
+	}),
+	defineTool("todo_update", "Update an existing todo item", writeTodos, todoHandler, (*TodoHandler).Update, map[string]string{
+		"id":          "Todo ID to update",
+		"title":       "New title",
+		"description": "New description",
+		"status":      "New status",
+		"priority":    "New priority",
+		"tags":        "New tags",
+	}),
+	defineTool("todo_delete", "Delete a todo item, removing it from the memos linking it", writeTodos, todoHandler, (*TodoHandler).Delete, map[string]string{
+		"id": "Todo ID to delete",
+	}),
+	defineTool("todo_bulk_update", "Change the status, priority or tags of every todo matching a filter. Preview with dry_run first, then pass the returned confirmation token to apply the update.", writeTodos, todoHandler, (*TodoHandler).BulkUpdate, map[string]string{
+		"filter":             "Todos to update, by status, priority and tags (all todos if empty)",
+		"patch":              "New status and priority, and tags to add (add_tags) and remove (remove_tags)",
+		"dry_run":            "Only return the IDs of the todos that would change and a confirmation token",
+		"confirmation_token": "Token returned by the dry run, required to apply the update",
+	}),
+
+	// Search and tag tools
+	defineTool("search", "Search todos and memos by keyword or tags", readAll, searchHandler, (*SearchHandler).Search, map[string]string{
+		"query": "Search query",
+		"tags":  "Filter by tags",
+		"type":  "Filter by type (default: all)",
+	}),
+	defineTool("tag_list", "List all unique tags from todos and memos", readAll, tagHandler, (*TagHandler).List, nil),
+
+	// Limit tools
+	defineTool("quota_status", "Show the request rate limit and how much of the storage quotas is used", readAll, quotaHandler, (*QuotaHandler).Status, nil),
+
+	// Audit log tools
+	defineTool("activity_log", "List the changes made to your todos, memos and account, newest first, with the token and client that made them", adminOnly, activityHandler, (*ActivityHandler).Log, map[string]string{
+		"action":      "Filter by action, e.g. todo.update",
+		"target_type": "Filter by the kind of item changed",
+		"target_id":   "Filter by the ID of the item changed",
+		"tool":        "Filter by the tool that made the change",
+		"since":       "Only changes at or after this RFC 3339 time",
+		"until":       "Only changes before this RFC 3339 time",
+		"limit":       "Maximum number of events (default: 50, max: 1000)",
+	}),
+
+	// Undo tools
+	defineTool("undo", "Undo your last operations on todos and memos, newest first. Operations changing several items are undone as a whole.", writeAll, journalHandler, (*JournalHandler).Undo, map[string]string{
+		"count":   "Number of operations to undo (default: 1, max: 20)",
+		"session": "Only undo operations made in this session or with this access token",
+	}),
+	defineTool("redo", "Redo your last undone operations on todos and memos", writeAll, journalHandler, (*JournalHandler).Redo, map[string]string{
+		"count":   "Number of operations to redo (default: 1, max: 20)",
+		"session": "Only redo operations made in this session or with this access token",
+	}),
+
+	// Conversion tools
+	defineTool("memo_promote_to_todo", "Turn a memo into a todo with its title, description and tags. The todo refers back to the memo in source_memo_id.", writeAll, memoHandler, (*MemoHandler).PromoteToTodo, map[string]string{
+		"id":            "Memo ID to promote",
+		"status":        "Status of the todo (default: backlog)",
+		"priority":      "Priority of the todo (default: normal)",
+		"keep_original": "Keep the memo and link it to the todo instead of deleting it",
+		"drop_links":    "Delete the memo even if it links todos, dropping the links; otherwise such a memo must be kept",
+	}),
+	defineTool("todo_demote_to_memo", "Turn a todo into a memo with its title, description and tags. The memo refers back to the todo in source_todo_id.", writeAll, todoHandler, (*TodoHandler).DemoteToMemo, map[string]string{
+		"id":            "Todo ID to demote",
+		"keep_original": "Keep the todo and link it from the memo instead of deleting it",
+		"drop_links":    "Delete the todo even if memos link it, removing it from them; otherwise such a todo must be kept",
+	}),
+
+	// Batch tools
+	defineTool("batch", "Create, update and delete several todos and memos in one transaction. Items created by an operation can be referred to by later ones as $ref.", writeAll, batchHandler, (*BatchHandler).Batch, map[string]string{
+		"operations":     "Operations applied in order (max: 100). Each has an op (create, update or delete), a kind (todo or memo), the id of the item unless created, and the fields of the corresponding todo or memo tool. A create may have a ref, which later operations use as \"$ref\" in id, parent_id and linked_todos.",
+		"all_or_nothing": "Apply no operation unless all of them succeed (default: apply those that succeed)",
+	}),
+
+	// Personal access token tools
+	defineTool("token_create", "Create a personal access token for scripts and integrations", adminOnly, tokenHandler, (*TokenHandler).Create, map[string]string{
+		"name":            "Name describing where the token is used",
+		"scopes":          "Scopes granted to the token",
+		"expires_in_days": "Days until the token expires (default: never)",
+	}),
+	defineTool("token_list", "List personal access tokens", adminOnly, tokenHandler, (*TokenHandler).List, nil),
+	defineTool("token_revoke", "Revoke a personal access token", adminOnly, tokenHandler, (*TokenHandler).Revoke, map[string]string{
+		"id": "Access token ID to revoke",
+	}),
+
+	// Auth tools
+	localTool(defineTool("auth_start", "Start authentication process for memoya", nil, authHandler, (*AuthHandler).Start, map[string]string{
+		"provider": "Identity provider to sign in with, e.g. google or github (default: the server's default provider)",
+		"link":     "Link the account to the signed-in memoya user instead of signing in with it",
+	})),
+	localTool(defineTool("auth_status", "Check authentication status and retrieve auth token", nil, authHandler, (*AuthHandler).Status, nil)),
+	localTool(defineTool("auth_logout", "Sign out of memoya and remove the saved tokens", nil, authHandler, (*AuthHandler).Logout, map[string]string{
+		"all":        "Sign out every session of the user, not only this one",
+		"session_id": "Sign out the given session instead of this one (see auth_sessions)",
+	})),
+	localTool(defineTool("auth_sessions", "List the devices and clients signed in to memoya", nil, authHandler, (*AuthHandler).Sessions, nil)),
+	localTool(defineTool("auth_identities", "List the accounts linked to the memoya user", nil, authHandler, (*AuthHandler).Identities, nil)),
+}
+
+// Scopes required by tools
+var (
+	readTodos  = []auth.Scope{auth.ScopeTodosRead}
+	writeTodos = []auth.Scope{auth.ScopeTodosWrite}
+	readMemos  = []auth.Scope{auth.ScopeMemosRead}
+	writeMemos = []auth.Scope{auth.ScopeMemosWrite}
+	readAll    = []auth.Scope{auth.ScopeTodosRead, auth.ScopeMemosRead}
+	writeAll   = []auth.Scope{auth.ScopeTodosWrite, auth.ScopeMemosWrite}
+	adminOnly  = []auth.Scope{auth.ScopeAdmin}
+)
+
+// toolIndex maps tool names to registry entries
+var toolIndex = make(map[string]*Tool)
+
+func init() {
+	for _, tool := range tools {
+		toolIndex[tool.Name] = tool
+	}
+}
+
+// Tools returns every tool in the registry
+func Tools() []*Tool {
+	return tools
+}
+
+// LookupTool returns the named tool, or nil if unknown
+func LookupTool(name string) *Tool {
+	return toolIndex[name]
+}
+
+func memoHandler(h *Handlers) *MemoHandler         { return h.Memo }
+func todoHandler(h *Handlers) *TodoHandler         { return h.Todo }
+func searchHandler(h *Handlers) *SearchHandler     { return h.Search }
+func tagHandler(h *Handlers) *TagHandler           { return h.Tag }
+func tokenHandler(h *Handlers) *TokenHandler       { return h.Token }
+func authHandler(h *Handlers) *AuthHandler         { return h.Auth }
+func quotaHandler(h *Handlers) *QuotaHandler       { return h.Quota }
+func activityHandler(h *Handlers) *ActivityHandler { return h.Activity }
+func journalHandler(h *Handlers) *JournalHandler   { return h.Journal }
+func batchHandler(h *Handlers) *BatchHandler       { return h.Batch }
+
+// defineTool declares a tool calling method on the handler picked from
+// Handlers by handler. Tools require authentication unless made local, and
+// calls are refused unless the request was granted scopes.
+func defineTool[H, In, Out any](
+	name, description string,
+	scopes []auth.Scope,
+	handler func(h *Handlers) H,
+	method func(H, context.Context, *mcp.ServerSession, *mcp.CallToolParamsFor[In]) (*mcp.CallToolResultFor[Out], error),
+	descriptions map[string]string,
+) *Tool {
+	schema := newToolSchema[In, Out](descriptions)
+	run := func(h H, ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[In]) (*mcp.CallToolResultFor[Out], error) {
+		if err := auth.RequireScope(ctx, scopes...); err != nil {
+			return nil, err
+		}
+		var notRecorded error
+		res, err := method(h, withJournalFailure(audit.WithTool(ctx, name), &notRecorded), ss, params)
+		if err == nil && notRecorded != nil {
+			res = withWarning(res, fmt.Sprintf("the changes were made but cannot be undone: %v", notRecorded))
+		}
+		return res, err
+	}
+
+	return &Tool{
+		Name:         name,
+		Description:  description,
+		RequiresAuth: true,
+		Scopes:       scopes,
+		schema:       schema,
+		bind: func(h *Handlers) *mcp.ServerTool {
+			bound := handler(h)
+			return newTool(schema, name, description, func(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[In]) (*mcp.CallToolResultFor[Out], error) {
+				return run(bound, ctx, ss, params)
+			})
+		},
+		forward: func(f ForwardFunc) *mcp.ServerTool {
+			return newTool(schema, name, description, func(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[In]) (*mcp.CallToolResultFor[Out], error) {
+				text, err := f(ctx, name, params.Arguments)
+				if err != nil {
+					return nil, err
+				}
+				return &mcp.CallToolResultFor[Out]{
+					Content: []mcp.Content{&mcp.TextContent{Text: text}},
+				}, nil
+			})
+		},
+		call: func(ctx context.Context, h *Handlers, data []byte) (*mcp.CallToolResult, error) {
+			var args In
+			if err := json.Unmarshal(data, &args); err != nil {
+				return nil, apperr.Errorf(apperr.Validation, "%w: %w", ErrInvalidArguments, err)
+			}
+			if err := schema.Validate(args); err != nil {
+				return nil, err
+			}
+
+			res, err := run(handler(h), ctx, nil, &mcp.CallToolParamsFor[In]{Name: name, Arguments: args})
+			if err != nil {
+				return nil, err
+			}
+			return &mcp.CallToolResult{Content: res.Content, IsError: res.IsError}, nil
+		},
+	}
+}
+
+// localTool marks t as running in the bridge without authentication
+func localTool(t *Tool) *Tool {
+	t.RequiresAuth = false
+	t.Local = true
+	return t
+}
+
+// withWarning sets the warning of a tool result, a JSON object
+func withWarning[Out any](res *mcp.CallToolResultFor[Out], warning string) *mcp.CallToolResultFor[Out] {
+	if res == nil || len(res.Content) == 0 {
+		return res
+	}
+	text, ok := res.Content[0].(*mcp.TextContent)
+	if !ok {
+		return res
+	}
+	var result map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(text.Text), &result); err != nil {
+		return res
+	}
+	result[resultWarning], _ = json.Marshal(warning)
+	data, err := json.Marshal(result)
+	if err != nil {
+		return res
+	}
+	res.Content = append([]mcp.Content{&mcp.TextContent{Text: string(data)}}, res.Content[1:]...)
+	return res
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/auth"
+)
+
+func TestTools_Registry(t *testing.T) {
+	for _, tool := range Tools() {
+		if LookupTool(tool.Name) != tool {
+			t.Errorf("Expected %s to be found by name", tool.Name)
+		}
+		if ToolSchemaFor(tool.Name) == nil {
+			t.Errorf("Expected schema for %s", tool.Name)
+		}
+		if tool.Local == tool.RequiresAuth {
+			t.Errorf("Expected %s to either run locally or require auth", tool.Name)
+		}
+	}
+
+	if LookupTool("unknown") != nil {
+		t.Error("Expected unknown tool to be nil")
+	}
+}
+
+func TestTool_Call(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	h := &Handlers{Todo: NewTodoHandlerWithStorage(mockStorage)}
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	tool := LookupTool("todo_list")
+
+	result, err := tool.Call(ctx, h, []byte(`{"status":"todo"}`))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	textContent, ok := result.Content[0].(*mcp.TextContent)
+	if !ok || !strings.Contains(textContent.Text, "Test Todo 1") {
+		t.Errorf("Expected todo list, got %#v", result.Content)
+	}
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"status not in enum", `{"status":"someday"}`},
+		{"wrong type", `{"tags":"work"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tool.Call(ctx, h, []byte(tt.data))
+			if !errors.Is(err, ErrInvalidArguments) {
+				t.Errorf("Expected ErrInvalidArguments, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTool_CallScopes(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	h := &Handlers{Todo: NewTodoHandlerWithStorage(mockStorage)}
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	readOnly := auth.WithScopes(ctx, []auth.Scope{auth.ScopeTodosRead})
+
+	if _, err := LookupTool("todo_list").Call(readOnly, h, []byte(`{}`)); err != nil {
+		t.Errorf("Expected todo_list to be allowed, got %v", err)
+	}
+	if _, err := LookupTool("todo_create").Call(readOnly, h, []byte(`{"title":"x"}`)); !apperr.Is(err, apperr.Forbidden) {
+		t.Errorf("Expected todo_create to be forbidden, got %v", err)
+	}
+
+	admin := auth.WithScopes(ctx, []auth.Scope{auth.ScopeAdmin})
+	if _, err := LookupTool("todo_create").Call(admin, h, []byte(`{"title":"x"}`)); err != nil {
+		t.Errorf("Expected admin to be allowed, got %v", err)
+	}
+}
+
+func TestTool_Forward(t *testing.T) {
+	var gotName string
+	var gotArgs any
+	tool := LookupTool("memo_delete").Forward(func(ctx context.Context, name string, args any) (string, error) {
+		gotName, gotArgs = name, args
+		return `{"success":true,"message":"Memo deleted successfully"}`, nil
+	})
+
+	result, err := tool.Handler(context.Background(), nil, &mcp.CallToolParamsFor[map[string]any]{
+		Arguments: map[string]any{"id": "memo-1"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if gotName != "memo_delete" {
+		t.Errorf("Expected memo_delete to be forwarded, got %q", gotName)
+	}
+	if args, ok := gotArgs.(MemoDeleteArgs); !ok || args.ID != "memo-1" {
+		t.Errorf("Expected typed arguments, got %#v", gotArgs)
+	}
+	if result.StructuredContent == nil {
+		t.Error("Expected structured content")
+	}
+}
@@ -0,0 +1,213 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/jsonschema"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+)
+
+// Allowed values of enum-like arguments, shared by every tool
+var propertyEnums = map[string][]any{
+	"status":   enum(todoStatuses),
+	"priority": enum(todoPriorities),
+	"type":     {"todo", "memo", "all"},
+	"scopes":   enum(auth.Scopes),
+	// activity_log filters
+	"action":      enum(models.AuditActions),
+	"target_type": enum(auditTargetTypes),
+	// batch operations
+	"op":   {"create", "update", "delete"},
+	"kind": {"todo", "memo"},
+}
+
+func enum[S ~string](values []S) []any {
+	enum := make([]any, len(values))
+	for i, value := range values {
+		enum[i] = string(value)
+	}
+	return enum
+}
+
+func enumStrings[S ~string](values []S) []string {
+	strs := make([]string, len(values))
+	for i, value := range values {
+		strs[i] = string(value)
+	}
+	return strs
+}
+
+// resultWarning is the property of a tool result warning of a partial success
+const resultWarning = "warning"
+
+// ErrInvalidArguments is returned for tool arguments not matching the input schema
+var ErrInvalidArguments = errors.New("invalid arguments")
+
+// ToolSchema holds the JSON Schemas generated from a tool's argument and
+// result types
+type ToolSchema struct {
+	input    *jsonschema.Schema
+	output   *jsonschema.Schema
+	resolved *jsonschema.Resolved
+}
+
+// Input returns a copy of the input schema. A schema can only be resolved
+// once, so every user (e.g. each mcp.Server) needs its own copy.
+func (s *ToolSchema) Input() *jsonschema.Schema {
+	return cloneSchema(s.input)
+}
+
+// Output returns a copy of the output schema
+func (s *ToolSchema) Output() *jsonschema.Schema {
+	return cloneSchema(s.output)
+}
+
+// Validate checks tool arguments (the handler's args struct) against the input schema
+func (s *ToolSchema) Validate(args any) error {
+	if err := s.resolved.Validate(args); err != nil {
+		return apperr.Errorf(apperr.Validation, "%w: %w", ErrInvalidArguments, err)
+	}
+	return nil
+}
+
+// ToolSchemaFor returns the schemas of the named tool, or nil if unknown
+func ToolSchemaFor(name string) *ToolSchema {
+	tool := LookupTool(name)
+	if tool == nil {
+		return nil
+	}
+	return tool.schema
+}
+
+// ValidateToolArgs checks args against the input schema of the named tool
+func ValidateToolArgs(name string, args any) error {
+	schema := ToolSchemaFor(name)
+	if schema == nil {
+		return fmt.Errorf("unknown tool: %s", name)
+	}
+	return schema.Validate(args)
+}
+
+func newToolSchema[In, Out any](descriptions map[string]string) *ToolSchema {
+	input, err := jsonschema.For[In]()
+	if err != nil {
+		panic(err)
+	}
+	refineInput(input)
+	for name, prop := range input.Properties {
+		prop.Description = descriptions[name]
+	}
+
+	output, err := jsonschema.For[Out]()
+	if err != nil {
+		panic(err)
+	}
+	refineOutput(output, reflect.TypeFor[Out]())
+	if output.Properties != nil {
+		output.Properties[resultWarning] = &jsonschema.Schema{
+			Type:        "string",
+			Description: "Set when the tool succeeded only in part, e.g. made changes that cannot be undone",
+		}
+	}
+
+	resolved, err := cloneSchema(input).Resolve(nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return &ToolSchema{
+		input:    input,
+		output:   output,
+		resolved: resolved,
+	}
+}
+
+// refineInput constrains the properties of the input schema s, and of the
+// objects in it, by their names
+func refineInput(s *jsonschema.Schema) {
+	for name, prop := range s.Properties {
+		if prop.Type == "array" && prop.Items != nil {
+			prop.Items.Enum = propertyEnums[name]
+		} else {
+			prop.Enum = propertyEnums[name]
+		}
+		if prop.Type == "string" && prop.Enum == nil && isRequired(s, name) {
+			// Required strings (IDs, titles) must not be empty
+			prop.MinLength = jsonschema.Ptr(1)
+		}
+		if prop.Type == "array" && prop.Items != nil && prop.Items.Type == "string" {
+			prop.Items.MinLength = jsonschema.Ptr(1)
+		}
+		if prop.Type == "array" && prop.Items != nil && prop.Items.Type == "object" {
+			refineInput(prop.Items)
+		}
+		if prop.Type == "object" {
+			refineInput(prop)
+		}
+	}
+}
+
+func cloneSchema(s *jsonschema.Schema) *jsonschema.Schema {
+	data, err := json.Marshal(s)
+	if err != nil {
+		panic(err)
+	}
+	var clone jsonschema.Schema
+	if err := json.Unmarshal(data, &clone); err != nil {
+		panic(err)
+	}
+	return &clone
+}
+
+// refineOutput fixes the inferred schema of t to match its JSON encoding:
+// time.Time is an RFC 3339 string, and nil slices are encoded as null
+func refineOutput(s *jsonschema.Schema, t reflect.Type) {
+	nullable := false
+	for t.Kind() == reflect.Pointer {
+		nullable = true
+		t = t.Elem()
+	}
+
+	switch {
+	case t == reflect.TypeFor[time.Time]():
+		*s = jsonschema.Schema{Type: "string", Format: "date-time"}
+		if nullable {
+			s.Types, s.Type = []string{"null", "string"}, ""
+		}
+
+	case t.Kind() == reflect.Slice:
+		refineOutput(s.Items, t.Elem())
+		s.Types, s.Type = []string{"null", "array"}, ""
+
+	case t.Kind() == reflect.Struct:
+		for i := range t.NumField() {
+			field := t.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if name == "-" || !field.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			if prop, ok := s.Properties[name]; ok {
+				refineOutput(prop, field.Type)
+			}
+		}
+	}
+}
+
+func isRequired(s *jsonschema.Schema, name string) bool {
+	for _, r := range s.Required {
+		if r == name {
+			return true
+		}
+	}
+	return false
+}
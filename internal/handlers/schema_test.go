@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/auth"
+)
+
+func TestValidateToolArgs(t *testing.T) {
+	tests := []struct {
+		name    string
+		tool    string
+		args    any
+		wantErr bool
+	}{
+		{"valid create", "todo_create", TodoCreateArgs{Title: "Write docs", Status: "in_progress", Tags: []string{"work"}}, false},
+		{"defaults omitted", "todo_create", TodoCreateArgs{Title: "Write docs"}, false},
+		{"status not in enum", "todo_create", TodoCreateArgs{Title: "Write docs", Status: "In Progress"}, true},
+		{"priority not in enum", "todo_update", TodoUpdateArgs{ID: "todo-1", Priority: "urgent"}, true},
+		{"empty title", "memo_create", MemoCreateArgs{Title: ""}, true},
+		{"empty tag", "memo_list", MemoListArgs{Tags: []string{""}}, true},
+		{"search type not in enum", "search", SearchArgs{Type: "memos"}, true},
+		{"unknown tool", "unknown", TodoGetArgs{ID: "todo-1"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateToolArgs(tt.tool, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestToolSchema_OutputMatchesResult(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	todoHandler := NewTodoHandlerWithStorage(mockStorage)
+	memoHandler := NewMemoHandlerWithStorage(mockStorage)
+
+	created, err := todoHandler.Create(ctx, nil, &mcp.CallToolParamsFor[TodoCreateArgs]{
+		Arguments: TodoCreateArgs{Title: "No tags"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	listed, err := memoHandler.List(ctx, nil, &mcp.CallToolParamsFor[MemoListArgs]{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	tests := []struct {
+		tool    string
+		content []mcp.Content
+	}{
+		{"todo_create", created.Content},
+		{"memo_list", listed.Content},
+	}
+
+	for _, tt := range tests {
+		resolved, err := ToolSchemaFor(tt.tool).Output().Resolve(nil)
+		if err != nil {
+			t.Fatalf("Failed to resolve output schema of %s: %v", tt.tool, err)
+		}
+
+		var result map[string]any
+		if err := json.Unmarshal([]byte(tt.content[0].(*mcp.TextContent).Text), &result); err != nil {
+			t.Fatalf("Failed to unmarshal JSON: %v", err)
+		}
+
+		if err := resolved.Validate(result); err != nil {
+			t.Errorf("Result of %s doesn't match its output schema: %v", tt.tool, err)
+		}
+	}
+}
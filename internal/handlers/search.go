@@ -9,6 +9,7 @@ import (
 	"github.com/pankona/memoya/internal/auth"
 	"github.com/pankona/memoya/internal/models"
 	"github.com/pankona/memoya/internal/storage"
+	"github.com/pankona/memoya/internal/validation"
 )
 
 // SearchArgs represents arguments for search
@@ -36,6 +37,7 @@ type SearchItems struct {
 
 type SearchHandler struct {
 	storage storage.Storage
+	opts    validation.Options
 }
 
 func NewSearchHandler(storage storage.Storage) *SearchHandler {
@@ -44,6 +46,11 @@ func NewSearchHandler(storage storage.Storage) *SearchHandler {
 	}
 }
 
+// SetValidation sets how the handler normalizes the tags to filter by
+func (h *SearchHandler) SetValidation(opts validation.Options) {
+	h.opts = opts
+}
+
 func (h *SearchHandler) Search(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[SearchArgs]) (*mcp.CallToolResultFor[SearchResult], error) {
 	args := params.Arguments
 
@@ -57,6 +64,11 @@ func (h *SearchHandler) Search(ctx context.Context, ss *mcp.ServerSession, param
 		return nil, fmt.Errorf("authentication required: %w", err)
 	}
 
+	tags, err := normalizeTagFilter(h.opts, args.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	// Default to "all" if type not specified
 	searchType := args.Type
 	if searchType == "" {
@@ -67,7 +79,7 @@ func (h *SearchHandler) Search(ctx context.Context, ss *mcp.ServerSession, param
 	filters := storage.SearchFilters{
 		UserID: userID,
 		Type:   searchType,
-		Tags:   args.Tags,
+		Tags:   tags,
 	}
 
 	// Perform search
@@ -79,7 +91,7 @@ func (h *SearchHandler) Search(ctx context.Context, ss *mcp.ServerSession, param
 	searchResult := SearchResult{
 		Success: true,
 		Query:   args.Query,
-		Tags:    args.Tags,
+		Tags:    tags,
 		Type:    searchType,
 		Results: SearchItems{
 			Todos: results.Todos,
@@ -8,13 +8,20 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/audit"
 	"github.com/pankona/memoya/internal/auth"
 	"github.com/pankona/memoya/internal/models"
 	"github.com/pankona/memoya/internal/storage"
+	"github.com/pankona/memoya/internal/validation"
 )
 
 type TodoHandler struct {
 	storage storage.Storage
+	quota   *Quota
+	opts    validation.Options
+	audit   *audit.Recorder
+	journal *Journal
 }
 
 func NewTodoHandler() *TodoHandler {
@@ -28,6 +35,34 @@ func NewTodoHandlerWithStorage(storage storage.Storage) *TodoHandler {
 	}
 }
 
+// SetQuota makes the handler enforce quota on the todos it creates and updates
+func (h *TodoHandler) SetQuota(quota *Quota) {
+	h.quota = quota
+}
+
+// SetValidation sets how the handler normalizes arguments
+func (h *TodoHandler) SetValidation(opts validation.Options) {
+	h.opts = opts
+}
+
+// SetAudit makes the handler record the changes it makes in the audit log
+func (h *TodoHandler) SetAudit(recorder *audit.Recorder) {
+	h.audit = recorder
+}
+
+// SetJournal makes the handler record the changes it makes in journal, so
+// they can be undone
+func (h *TodoHandler) SetJournal(journal *Journal) {
+	h.journal = journal
+}
+
+// record records a change of a todo in the audit log and the journal. A nil
+// before is a created todo, a nil after a deleted one.
+func (h *TodoHandler) record(ctx context.Context, userID string, before, after *models.Todo) {
+	h.audit.Record(ctx, todoEvent(userID, before, after))
+	h.journal.Record(ctx, userID, todoStep(before, after))
+}
+
 // TodoCreateArgs represents arguments for creating a todo
 type TodoCreateArgs struct {
 	Title       string   `json:"title"`
@@ -42,7 +77,9 @@ type TodoCreateArgs struct {
 type TodoResult struct {
 	Success bool         `json:"success"`
 	Todo    *models.Todo `json:"todo"`
-	Message string       `json:"message"`
+	// Backlinks are the memos linking the todo, included by todo_get
+	Backlinks []*models.Memo `json:"backlinks,omitempty"`
+	Message   string         `json:"message"`
 }
 
 func (h *TodoHandler) Create(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[TodoCreateArgs]) (*mcp.CallToolResultFor[TodoResult], error) {
@@ -54,31 +91,20 @@ func (h *TodoHandler) Create(ctx context.Context, ss *mcp.ServerSession, params
 		return nil, fmt.Errorf("authentication required: %w", err)
 	}
 
-	todo := &models.Todo{
-		ID:           uuid.New().String(),
-		UserID:       userID,
-		Title:        args.Title,
-		Description:  args.Description,
-		Tags:         args.Tags,
-		ParentID:     args.ParentID,
-		CreatedAt:    time.Now(),
-		LastModified: time.Now(),
+	if err := h.validateCreate(ctx, userID, &args); err != nil {
+		return nil, err
 	}
 
-	// Set status with default
-	if args.Status != "" {
-		todo.Status = models.TodoStatus(args.Status)
-	} else {
-		todo.Status = models.StatusBacklog
+	// Enforce the user's quota
+	if err := h.quota.CheckItem(args.Description, args.Tags); err != nil {
+		return nil, err
 	}
-
-	// Set priority with default
-	if args.Priority != "" {
-		todo.Priority = models.TodoPriority(args.Priority)
-	} else {
-		todo.Priority = models.PriorityNormal
+	if err := h.quota.CheckNewTodo(ctx, userID); err != nil {
+		return nil, err
 	}
 
+	todo := newTodo(userID, args)
+
 	// Save to storage
 	if h.storage != nil {
 		err := h.storage.CreateTodo(ctx, todo)
@@ -87,6 +113,8 @@ func (h *TodoHandler) Create(ctx context.Context, ss *mcp.ServerSession, params
 		}
 	}
 
+	h.record(ctx, userID, nil, todo)
+
 	// Create result
 	result := TodoResult{
 		Success: true,
@@ -107,6 +135,57 @@ func (h *TodoHandler) Create(ctx context.Context, ss *mcp.ServerSession, params
 	}, nil
 }
 
+type TodoGetArgs struct {
+	ID string `json:"id"`
+}
+
+func (h *TodoHandler) Get(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[TodoGetArgs]) (*mcp.CallToolResultFor[TodoResult], error) {
+	args := params.Arguments
+
+	if h.storage == nil {
+		return nil, fmt.Errorf("storage not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	todo, err := h.storage.GetTodo(ctx, args.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get todo: %w", err)
+	}
+
+	// Check ownership
+	if todo.UserID != userID {
+		return nil, apperr.Errorf(apperr.Forbidden, "access denied: todo belongs to different user")
+	}
+
+	memos, err := h.storage.ListMemos(ctx, storage.MemoFilters{UserID: userID, LinkedTodo: todo.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memos linking todo: %w", err)
+	}
+
+	result := TodoResult{
+		Success:   true,
+		Todo:      todo,
+		Backlinks: memos,
+		Message:   fmt.Sprintf("Todo '%s' retrieved successfully", todo.Title),
+	}
+
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return &mcp.CallToolResultFor[TodoResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
+
 type TodoListArgs struct {
 	Status   string   `json:"status,omitempty"`
 	Tags     []string `json:"tags,omitempty"`
@@ -144,8 +223,12 @@ func (h *TodoHandler) List(ctx context.Context, ss *mcp.ServerSession, params *m
 			filters.Priority = &priority
 		}
 
-		if len(args.Tags) > 0 {
-			filters.Tags = args.Tags
+		tags, err := normalizeTagFilter(h.opts, args.Tags)
+		if err != nil {
+			return nil, err
+		}
+		if len(tags) > 0 {
+			filters.Tags = tags
 		}
 
 		todos, err := h.storage.ListTodos(ctx, filters)
@@ -213,42 +296,49 @@ func (h *TodoHandler) Update(ctx context.Context, ss *mcp.ServerSession, params
 
 	// Check ownership
 	if todo.UserID != userID {
-		return nil, fmt.Errorf("access denied: todo belongs to different user")
+		return nil, apperr.Errorf(apperr.Forbidden, "access denied: todo belongs to different user")
 	}
 
-	// Update fields
-	if args.Title != "" {
-		todo.Title = args.Title
+	if err := h.validateUpdate(&args); err != nil {
+		return nil, err
 	}
 
-	if args.Description != "" {
-		todo.Description = args.Description
+	// Enforce the user's quota on the new values
+	if err := h.quota.CheckItem(args.Description, args.Tags); err != nil {
+		return nil, err
 	}
 
-	if args.Status != "" {
-		todo.Status = models.TodoStatus(args.Status)
-		if args.Status == "done" && todo.ClosedAt == nil {
-			now := time.Now()
-			todo.ClosedAt = &now
-		}
-	}
-
-	if args.Priority != "" {
-		todo.Priority = models.TodoPriority(args.Priority)
-	}
+	// Update the todo as currently stored, so concurrent changes to other
+	// fields are kept
+	var items *txItems
+	err = h.storage.RunTransaction(ctx, userID, func(tx storage.Tx) error {
+		items = newTxItems(tx)
 
-	if len(args.Tags) > 0 {
-		todo.Tags = args.Tags
-	}
-
-	todo.LastModified = time.Now()
+		key := itemKey{targetType: "todo", id: args.ID}
+		current, err := items.get(key)
+		if err != nil {
+			return err
+		}
+		if current.todo == nil {
+			return apperr.Errorf(apperr.NotFound, "todo %s not found", args.ID)
+		}
+		if err := checkPrecondition(ctx, current.todo); err != nil {
+			return err
+		}
 
-	// Save to storage
-	err = h.storage.UpdateTodo(ctx, todo)
+		updated := *current.todo
+		updateTodo(&updated, args)
+		todo = &updated
+		items.set(key, journalItem{todo: todo})
+		return items.write()
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to update todo: %w", err)
 	}
 
+	h.journal.Record(ctx, userID, items.steps()...)
+	items.recordAudit(ctx, h.audit, userID)
+
 	// Create result
 	result := TodoResult{
 		Success: true,
@@ -274,8 +364,10 @@ type TodoDeleteArgs struct {
 }
 
 type DeleteResult struct {
-	Success bool   `json:"success"`
-	Message string `json:"message"`
+	Success bool `json:"success"`
+	// UnlinkedMemos are the memos the deleted todo was removed from
+	UnlinkedMemos []string `json:"unlinked_memos,omitempty"`
+	Message       string   `json:"message"`
 }
 
 func (h *TodoHandler) Delete(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[TodoDeleteArgs]) (*mcp.CallToolResultFor[DeleteResult], error) {
@@ -299,18 +391,58 @@ func (h *TodoHandler) Delete(ctx context.Context, ss *mcp.ServerSession, params
 
 	// Check ownership
 	if todo.UserID != userID {
-		return nil, fmt.Errorf("access denied: todo belongs to different user")
+		return nil, apperr.Errorf(apperr.Forbidden, "access denied: todo belongs to different user")
 	}
 
-	// Delete from storage
-	err = h.storage.DeleteTodo(ctx, args.ID)
+	linking, err := linkingMemos(ctx, h.storage, userID, todo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete the todo and the links to it together
+	var items *txItems
+	var unlinked []string
+	err = h.storage.RunTransaction(ctx, userID, func(tx storage.Tx) error {
+		items = newTxItems(tx)
+
+		key := itemKey{targetType: "todo", id: todo.ID}
+		current, err := items.get(key)
+		if err != nil {
+			return err
+		}
+		if current.todo == nil {
+			return apperr.Errorf(apperr.NotFound, "todo %s not found", todo.ID)
+		}
+		if err := checkPrecondition(ctx, current.todo); err != nil {
+			return err
+		}
+		items.set(key, journalItem{})
+		if unlinked, err = items.unlinkTodo(todo.ID, linking); err != nil {
+			return err
+		}
+		return items.write()
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to delete todo: %w", err)
 	}
+	steps := items.steps()
+	items.recordAudit(ctx, h.audit, userID)
+	if missed := unlinkDeleted(ctx, h.storage, userID, todo.ID); missed != nil {
+		steps = append(steps, missed.steps()...)
+		missed.recordAudit(ctx, h.audit, userID)
+		unlinked = append(unlinked, missed.unlinkedMemos()...)
+	}
+
+	// The deletion is one operation, undone as a whole
+	h.journal.Record(ctx, userID, steps...)
 
 	result := DeleteResult{
-		Success: true,
-		Message: fmt.Sprintf("Todo %s deleted successfully", args.ID),
+		Success:       true,
+		UnlinkedMemos: unlinked,
+		Message:       fmt.Sprintf("Todo %s deleted successfully", args.ID),
+	}
+	if len(unlinked) > 0 {
+		result.Message = fmt.Sprintf("Todo %s deleted successfully and unlinked from %d memos", args.ID, len(unlinked))
 	}
 
 	// Convert to JSON
@@ -325,3 +457,71 @@ func (h *TodoHandler) Delete(ctx context.Context, ss *mcp.ServerSession, params
 		},
 	}, nil
 }
+
+// newTodo returns the todo created by validated args
+func newTodo(userID string, args TodoCreateArgs) *models.Todo {
+	todo := &models.Todo{
+		ID:           uuid.New().String(),
+		UserID:       userID,
+		Title:        args.Title,
+		Description:  args.Description,
+		Tags:         nonNil(args.Tags),
+		ParentID:     args.ParentID,
+		CreatedAt:    time.Now(),
+		LastModified: time.Now(),
+	}
+
+	// Set status with default
+	if args.Status != "" {
+		todo.Status = models.TodoStatus(args.Status)
+	} else {
+		todo.Status = models.StatusBacklog
+	}
+
+	// Set priority with default
+	if args.Priority != "" {
+		todo.Priority = models.TodoPriority(args.Priority)
+	} else {
+		todo.Priority = models.PriorityNormal
+	}
+	return todo
+}
+
+// updateTodo changes todo as validated args say. Empty arguments leave
+// their field as is.
+func updateTodo(todo *models.Todo, args TodoUpdateArgs) {
+	if args.Title != "" {
+		todo.Title = args.Title
+	}
+
+	if args.Description != "" {
+		todo.Description = args.Description
+	}
+
+	if args.Status != "" {
+		todo.Status = models.TodoStatus(args.Status)
+		if args.Status == "done" && todo.ClosedAt == nil {
+			now := time.Now()
+			todo.ClosedAt = &now
+		}
+	}
+
+	if args.Priority != "" {
+		todo.Priority = models.TodoPriority(args.Priority)
+	}
+
+	if len(args.Tags) > 0 {
+		todo.Tags = args.Tags
+	}
+
+	todo.LastModified = time.Now()
+}
+
+// nonNil returns s, or an empty slice if s is nil, so lists are stored as
+// arrays rather than null
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -6,12 +6,14 @@ import (
 	"testing"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
 	"github.com/pankona/memoya/internal/auth"
 	"github.com/pankona/memoya/internal/models"
 )
 
 func TestTodoHandler_Create(t *testing.T) {
 	mockStorage := NewMockStorage()
+	mockStorage.CreateTodo(context.Background(), &models.Todo{ID: "parent-123", UserID: "test-user-1", Title: "Parent"})
 	handler := NewTodoHandlerWithStorage(mockStorage)
 
 	args := TodoCreateArgs{
@@ -52,11 +54,14 @@ func TestTodoHandler_Create(t *testing.T) {
 		t.Fatal("Expected non-empty text content")
 	}
 
-	if len(mockStorage.todos) != 1 {
-		t.Fatalf("Expected 1 todo in storage, got %d", len(mockStorage.todos))
+	if len(mockStorage.todos) != 2 {
+		t.Fatalf("Expected 2 todos in storage, got %d", len(mockStorage.todos))
 	}
 
 	for _, todo := range mockStorage.todos {
+		if todo.ID == "parent-123" {
+			continue
+		}
 		if todo.Title != args.Title {
 			t.Errorf("Expected title %s, got %s", args.Title, todo.Title)
 		}
@@ -253,6 +258,49 @@ func TestTodoHandler_ListWithTagFilter(t *testing.T) {
 	}
 }
 
+func TestTodoHandler_Get(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	handler := NewTodoHandlerWithStorage(mockStorage)
+
+	params := &mcp.CallToolParamsFor[TodoGetArgs]{
+		Arguments: TodoGetArgs{ID: "test-todo-1"},
+	}
+
+	// Create context with test user ID
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	result, err := handler.Get(ctx, nil, params)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var todoResult TodoResult
+	textContent := result.Content[0].(*mcp.TextContent)
+	json.Unmarshal([]byte(textContent.Text), &todoResult)
+
+	if todoResult.Todo == nil || todoResult.Todo.ID != "test-todo-1" {
+		t.Errorf("Expected todo test-todo-1, got %v", todoResult.Todo)
+	}
+}
+
+func TestTodoHandler_GetOtherUsersTodo(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	handler := NewTodoHandlerWithStorage(mockStorage)
+
+	params := &mcp.CallToolParamsFor[TodoGetArgs]{
+		Arguments: TodoGetArgs{ID: "test-todo-1"},
+	}
+
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "other-user")
+	_, err := handler.Get(ctx, nil, params)
+
+	if err == nil {
+		t.Fatal("Expected access denied error, got nil")
+	}
+}
+
 func TestTodoHandler_Update(t *testing.T) {
 	mockStorage := NewMockStorage()
 	mockStorage.SetupTestData()
@@ -322,6 +370,42 @@ func TestTodoHandler_Update(t *testing.T) {
 	}
 }
 
+func TestTodoHandler_UpdatePrecondition(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	handler := NewTodoHandlerWithStorage(mockStorage)
+
+	// The precondition sees the todo as stored when it is changed
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	ctx = WithPrecondition(ctx, func(item any) error {
+		if todo, ok := item.(*models.Todo); !ok || todo.Title != "Test Todo 1" {
+			return apperr.Errorf(apperr.PreconditionFailed, "todo has been modified")
+		}
+		return nil
+	})
+
+	todo, _ := mockStorage.GetTodo(ctx, "test-todo-1")
+	changed := *todo
+	changed.Title = "Changed concurrently"
+	mockStorage.UpdateTodo(ctx, &changed)
+
+	params := &mcp.CallToolParamsFor[TodoUpdateArgs]{Arguments: TodoUpdateArgs{ID: "test-todo-1", Status: "done"}}
+	if _, err := handler.Update(ctx, nil, params); !apperr.Is(err, apperr.PreconditionFailed) {
+		t.Fatalf("Expected a failed precondition, got %v", err)
+	}
+	if todo, _ := mockStorage.GetTodo(ctx, "test-todo-1"); todo.Status == models.StatusDone {
+		t.Error("Expected the todo to be left unchanged")
+	}
+
+	mockStorage.UpdateTodo(ctx, todo)
+	if _, err := handler.Update(ctx, nil, params); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if todo, _ := mockStorage.GetTodo(ctx, "test-todo-1"); todo.Status != models.StatusDone {
+		t.Errorf("Expected the todo to be updated, got status %s", todo.Status)
+	}
+}
+
 func TestTodoHandler_Delete(t *testing.T) {
 	mockStorage := NewMockStorage()
 	mockStorage.SetupTestData()
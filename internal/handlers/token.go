@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/audit"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+)
+
+// TokenHandler manages personal access tokens
+type TokenHandler struct {
+	tokens *auth.TokenService
+	audit  *audit.Recorder
+}
+
+func NewTokenHandler(tokens *auth.TokenService) *TokenHandler {
+	return &TokenHandler{
+		tokens: tokens,
+	}
+}
+
+// SetAudit makes the handler record the tokens it creates and revokes in the
+// audit log
+func (h *TokenHandler) SetAudit(recorder *audit.Recorder) {
+	h.audit = recorder
+}
+
+// TokenCreateArgs represents arguments for creating a personal access token
+type TokenCreateArgs struct {
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays int      `json:"expires_in_days,omitempty"`
+}
+
+// TokenCreateResult represents the result of creating a token. Token is only
+// ever returned here.
+type TokenCreateResult struct {
+	Success     bool                `json:"success"`
+	Token       string              `json:"token"`
+	AccessToken *models.AccessToken `json:"access_token"`
+	Message     string              `json:"message"`
+}
+
+func (h *TokenHandler) Create(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[TokenCreateArgs]) (*mcp.CallToolResultFor[TokenCreateResult], error) {
+	args := params.Arguments
+
+	if h.tokens == nil {
+		return nil, fmt.Errorf("token service not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	raw, token, err := h.tokens.Create(ctx, userID, args.Name, args.Scopes, time.Duration(args.ExpiresInDays)*24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create access token: %w", err)
+	}
+
+	var changes audit.Changes
+	changes.Add("name", "", token.Name)
+	changes.Add("scopes", "", audit.List(token.Scopes))
+	h.audit.Record(ctx, &models.AuditEvent{
+		UserID:     userID,
+		Action:     models.AuditTokenCreate,
+		TargetType: "token",
+		TargetID:   token.ID,
+		Changes:    changes,
+	})
+
+	result := TokenCreateResult{
+		Success:     true,
+		Token:       raw,
+		AccessToken: token,
+		Message:     fmt.Sprintf("Access token '%s' created. Copy it now, it will not be shown again.", token.Name),
+	}
+
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return &mcp.CallToolResultFor[TokenCreateResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
+
+// TokenListArgs represents arguments for listing personal access tokens
+type TokenListArgs struct{}
+
+// TokenListResult represents the result of listing tokens
+type TokenListResult struct {
+	Success bool                  `json:"success"`
+	Tokens  []*models.AccessToken `json:"tokens"`
+	Message string                `json:"message"`
+}
+
+func (h *TokenHandler) List(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[TokenListArgs]) (*mcp.CallToolResultFor[TokenListResult], error) {
+	if h.tokens == nil {
+		return nil, fmt.Errorf("token service not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	tokens, err := h.tokens.List(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreatedAt.Before(tokens[j].CreatedAt)
+	})
+
+	result := TokenListResult{
+		Success: true,
+		Tokens:  tokens,
+		Message: fmt.Sprintf("Found %d access tokens", len(tokens)),
+	}
+
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return &mcp.CallToolResultFor[TokenListResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
+
+// TokenRevokeArgs represents arguments for revoking a personal access token
+type TokenRevokeArgs struct {
+	ID string `json:"id"`
+}
+
+func (h *TokenHandler) Revoke(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[TokenRevokeArgs]) (*mcp.CallToolResultFor[DeleteResult], error) {
+	args := params.Arguments
+
+	if h.tokens == nil {
+		return nil, fmt.Errorf("token service not initialized")
+	}
+
+	// Get user ID from context (set by auth middleware)
+	userID, err := auth.RequireAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authentication required: %w", err)
+	}
+
+	if err := h.tokens.Revoke(ctx, userID, args.ID); err != nil {
+		return nil, err
+	}
+
+	h.audit.Record(ctx, &models.AuditEvent{
+		UserID:     userID,
+		Action:     models.AuditTokenRevoke,
+		TargetType: "token",
+		TargetID:   args.ID,
+	})
+
+	result := DeleteResult{
+		Success: true,
+		Message: fmt.Sprintf("Access token %s revoked", args.ID),
+	}
+
+	jsonBytes, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return &mcp.CallToolResultFor[DeleteResult]{
+		Content: []mcp.Content{
+			&mcp.TextContent{Text: string(jsonBytes)},
+		},
+	}, nil
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/auth"
+)
+
+func TestTokenHandler_Lifecycle(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	tokens := auth.NewTokenService(mockStorage)
+	handler := NewTokenHandler(tokens)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	result, err := handler.Create(ctx, nil, &mcp.CallToolParamsFor[TokenCreateArgs]{
+		Arguments: TokenCreateArgs{Name: "ci", Scopes: []string{"todos:read"}, ExpiresInDays: 30},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var created TokenCreateResult
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &created); err != nil {
+		t.Fatalf("Failed to parse result: %v", err)
+	}
+	if !strings.HasPrefix(created.Token, auth.AccessTokenPrefix) {
+		t.Errorf("Expected token with %s prefix, got %q", auth.AccessTokenPrefix, created.Token)
+	}
+	if created.AccessToken.ExpiresAt == nil {
+		t.Error("Expected expiry to be set")
+	}
+
+	// Only the hash is stored
+	stored, err := mockStorage.GetAccessToken(ctx, created.AccessToken.ID)
+	if err != nil {
+		t.Fatalf("Expected stored token, got %v", err)
+	}
+	if stored.TokenHash != auth.HashAccessToken(created.Token) || strings.Contains(stored.TokenHash, created.Token) {
+		t.Error("Expected the token to be stored hashed")
+	}
+
+	// The token authenticates and records its use
+	token, err := tokens.Authenticate(ctx, created.Token)
+	if err != nil {
+		t.Fatalf("Expected token to authenticate, got %v", err)
+	}
+	if token.UserID != "test-user-1" || token.LastUsedAt == nil {
+		t.Errorf("Expected last use to be recorded for test-user-1, got %+v", token)
+	}
+
+	// Other users can't revoke it
+	otherCtx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-2")
+	_, err = handler.Revoke(otherCtx, nil, &mcp.CallToolParamsFor[TokenRevokeArgs]{Arguments: TokenRevokeArgs{ID: token.ID}})
+	if !apperr.Is(err, apperr.Forbidden) {
+		t.Errorf("Expected FORBIDDEN, got %v", err)
+	}
+
+	if _, err := handler.Revoke(ctx, nil, &mcp.CallToolParamsFor[TokenRevokeArgs]{Arguments: TokenRevokeArgs{ID: token.ID}}); err != nil {
+		t.Fatalf("Expected revoke to succeed, got %v", err)
+	}
+	if _, err := tokens.Authenticate(ctx, created.Token); !apperr.Is(err, apperr.Unauthorized) {
+		t.Errorf("Expected revoked token to be rejected, got %v", err)
+	}
+}
+
+func TestTokenHandler_CreateValidation(t *testing.T) {
+	handler := NewTokenHandler(auth.NewTokenService(NewMockStorage()))
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	tests := []struct {
+		name string
+		args TokenCreateArgs
+	}{
+		{"no name", TokenCreateArgs{Scopes: []string{"todos:read"}}},
+		{"no scopes", TokenCreateArgs{Name: "ci"}},
+		{"unknown scope", TokenCreateArgs{Name: "ci", Scopes: []string{"everything"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := handler.Create(ctx, nil, &mcp.CallToolParamsFor[TokenCreateArgs]{Arguments: tt.args})
+			if !apperr.Is(err, apperr.Validation) {
+				t.Errorf("Expected VALIDATION_ERROR, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// newTool builds an MCP tool for handler using the JSON Schemas generated from
+// the handler types. Arguments are validated against the input schema before
+// handler is called, and successful results are returned as structuredContent
+// alongside the JSON text.
+func newTool[In, Out any](schema *ToolSchema, name, description string, handler mcp.ToolHandlerFor[In, Out]) *mcp.ServerTool {
+	return &mcp.ServerTool{
+		Tool: &mcp.Tool{
+			Name:         name,
+			Description:  description,
+			InputSchema:  schema.Input(),
+			OutputSchema: schema.Output(),
+		},
+		Handler: func(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[map[string]any]) (*mcp.CallToolResult, error) {
+			// The SDK has already validated the arguments against InputSchema
+			var args In
+			if params.Arguments != nil {
+				data, err := json.Marshal(params.Arguments)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal arguments: %w", err)
+				}
+				if err := json.Unmarshal(data, &args); err != nil {
+					return nil, fmt.Errorf("failed to parse arguments: %w", err)
+				}
+			}
+
+			res, err := handler(ctx, ss, &mcp.CallToolParamsFor[In]{
+				Meta:      params.Meta,
+				Name:      params.Name,
+				Arguments: args,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return structuredResult[Out](res.Content, res.IsError), nil
+		},
+	}
+}
+
+// partialResult is implemented by results reporting "success": false when
+// only some of their changes failed. They match the output schema either way,
+// and are only errors if nothing was changed.
+type partialResult interface {
+	madeChanges() bool
+}
+
+// structuredResult attaches the JSON text of content as structured content.
+// Responses reporting "success": false are marked as errors instead, since
+// they don't match the output schema, unless Out is a partialResult.
+func structuredResult[Out any](content []mcp.Content, isError bool) *mcp.CallToolResult {
+	result := &mcp.CallToolResult{
+		Content: content,
+		IsError: isError,
+	}
+	if isError || len(content) == 0 {
+		return result
+	}
+
+	textContent, ok := content[0].(*mcp.TextContent)
+	if !ok {
+		return result
+	}
+
+	var structured map[string]any
+	if err := json.Unmarshal([]byte(textContent.Text), &structured); err != nil {
+		return result
+	}
+
+	if success, ok := structured["success"].(bool); ok && !success {
+		var out Out
+		partial, ok := any(&out).(partialResult)
+		if !ok || json.Unmarshal([]byte(textContent.Text), &out) != nil {
+			result.IsError = true
+			return result
+		}
+		result.IsError = !partial.madeChanges()
+	}
+
+	result.StructuredContent = structured
+	return result
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestNewTool(t *testing.T) {
+	var gotArgs TodoListArgs
+	tool := newTool(ToolSchemaFor("todo_list"), "todo_list", "List todo items", func(ctx context.Context, ss *mcp.ServerSession, params *mcp.CallToolParamsFor[TodoListArgs]) (*mcp.CallToolResultFor[TodoListResult], error) {
+		gotArgs = params.Arguments
+		return &mcp.CallToolResultFor[TodoListResult]{
+			Content: []mcp.Content{&mcp.TextContent{Text: `{"success":true,"todos":[],"message":"Found 0 todos"}`}},
+		}, nil
+	})
+
+	if got := tool.Tool.InputSchema.Properties["status"].Enum; len(got) != 4 {
+		t.Errorf("Expected status enum with 4 values, got %v", got)
+	}
+	if tool.Tool.OutputSchema == nil {
+		t.Error("Expected output schema")
+	}
+
+	result, err := tool.Handler(context.Background(), nil, &mcp.CallToolParamsFor[map[string]any]{
+		Arguments: map[string]any{"status": "todo", "tags": []any{"work"}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if gotArgs.Status != "todo" || len(gotArgs.Tags) != 1 || gotArgs.Tags[0] != "work" {
+		t.Errorf("Expected typed arguments, got %+v", gotArgs)
+	}
+
+	structured, ok := result.StructuredContent.(map[string]any)
+	if !ok || structured["message"] != "Found 0 todos" {
+		t.Errorf("Expected structured content, got %#v", result.StructuredContent)
+	}
+}
+
+func TestStructuredResult_Failure(t *testing.T) {
+	result := structuredResult[TodoListResult]([]mcp.Content{
+		&mcp.TextContent{Text: `{"success":false,"error":"Authentication required"}`},
+	}, false)
+
+	if !result.IsError {
+		t.Error("Expected failed response to be marked as error")
+	}
+	if result.StructuredContent != nil {
+		t.Errorf("Expected no structured content, got %#v", result.StructuredContent)
+	}
+}
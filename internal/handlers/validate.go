@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+	"github.com/pankona/memoya/internal/validation"
+)
+
+// Allowed values of the todo status and priority
+var (
+	todoStatuses   = []string{string(models.StatusBacklog), string(models.StatusTodo), string(models.StatusInProgress), string(models.StatusDone)}
+	todoPriorities = []string{string(models.PriorityHigh), string(models.PriorityNormal)}
+)
+
+// validateCreate normalizes args and checks that its parent is a todo of
+// userID
+func (h *TodoHandler) validateCreate(ctx context.Context, userID string, args *TodoCreateArgs) error {
+	v := validation.New(h.opts)
+	normalizeTodoCreate(v, args)
+	if err := checkTodoRef(ctx, h.storage, v, userID, "parent_id", args.ParentID); err != nil {
+		return err
+	}
+	return v.Err()
+}
+
+// validateUpdate normalizes the fields args changes
+func (h *TodoHandler) validateUpdate(args *TodoUpdateArgs) error {
+	v := validation.New(h.opts)
+	normalizeTodoUpdate(v, args)
+	return v.Err()
+}
+
+// validateCreate normalizes args and checks that its linked todos are todos
+// of userID
+func (h *MemoHandler) validateCreate(ctx context.Context, userID string, args *MemoCreateArgs) error {
+	v := validation.New(h.opts)
+	normalizeMemoCreate(v, args)
+	if err := checkLinkedTodos(ctx, h.storage, v, userID, args.LinkedTodos); err != nil {
+		return err
+	}
+	return v.Err()
+}
+
+// validateUpdate normalizes the fields args changes and checks that its
+// linked todos are todos of userID
+func (h *MemoHandler) validateUpdate(ctx context.Context, userID string, args *MemoUpdateArgs) error {
+	v := validation.New(h.opts)
+	normalizeMemoUpdate(v, args)
+	if err := checkLinkedTodos(ctx, h.storage, v, userID, args.LinkedTodos); err != nil {
+		return err
+	}
+	return v.Err()
+}
+
+// normalizeTodoCreate normalizes the fields of args, leaving references to
+// other todos unchecked
+func normalizeTodoCreate(v *validation.Validator, args *TodoCreateArgs) {
+	args.Title = v.Title("title", args.Title, true)
+	args.Description = v.Description("description", args.Description)
+	args.Tags = v.Tags("tags", args.Tags)
+	v.OneOf("status", args.Status, todoStatuses...)
+	v.OneOf("priority", args.Priority, todoPriorities...)
+	args.ParentID = strings.TrimSpace(args.ParentID)
+}
+
+// normalizeTodoUpdate normalizes the fields args changes
+func normalizeTodoUpdate(v *validation.Validator, args *TodoUpdateArgs) {
+	args.Title = v.Title("title", args.Title, false)
+	args.Description = v.Description("description", args.Description)
+	args.Tags = v.Tags("tags", args.Tags)
+	v.OneOf("status", args.Status, todoStatuses...)
+	v.OneOf("priority", args.Priority, todoPriorities...)
+}
+
+// normalizeMemoCreate normalizes the fields of args, leaving its linked todos
+// unchecked
+func normalizeMemoCreate(v *validation.Validator, args *MemoCreateArgs) {
+	args.Title = v.Title("title", args.Title, true)
+	args.Description = v.Description("description", args.Description)
+	args.Tags = v.Tags("tags", args.Tags)
+	args.LinkedTodos = v.IDs("linked_todos", args.LinkedTodos, validation.MaxLinkedTodos)
+}
+
+// normalizeMemoUpdate normalizes the fields args changes, leaving its linked
+// todos unchecked
+func normalizeMemoUpdate(v *validation.Validator, args *MemoUpdateArgs) {
+	args.Title = v.Title("title", args.Title, false)
+	args.Description = v.Description("description", args.Description)
+	args.Tags = v.Tags("tags", args.Tags)
+	args.LinkedTodos = v.IDs("linked_todos", args.LinkedTodos, validation.MaxLinkedTodos)
+}
+
+// normalizeTagFilter normalizes tags to filter by the same way tags are
+// normalized when saved, so filters match them
+func normalizeTagFilter(opts validation.Options, tags []string) ([]string, error) {
+	v := validation.New(opts)
+	tags = v.Tags("tags", tags)
+	return tags, v.Err()
+}
+
+func checkLinkedTodos(ctx context.Context, s storage.Storage, v *validation.Validator, userID string, ids []string) error {
+	for i, id := range ids {
+		if err := checkTodoRef(ctx, s, v, userID, fmt.Sprintf("linked_todos[%d]", i), id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkTodoRef records a field error unless id is empty or names a todo of
+// userID. Todos of other users are reported as missing, so their IDs cannot
+// be probed.
+func checkTodoRef(ctx context.Context, s storage.Storage, v *validation.Validator, userID, field, id string) error {
+	if id == "" || s == nil {
+		return nil
+	}
+
+	todo, err := s.GetTodo(ctx, id)
+	switch {
+	case apperr.Is(err, apperr.NotFound):
+		v.Add(field, "todo %s does not exist", id)
+	case err != nil:
+		return fmt.Errorf("failed to get todo %s: %w", id, err)
+	case todo.UserID != userID:
+		v.Add(field, "todo %s does not exist", id)
+	}
+	return nil
+}
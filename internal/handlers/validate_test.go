@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/validation"
+)
+
+func TestTodoHandler_CreateNormalizes(t *testing.T) {
+	mockStorage := NewMockStorage()
+	handler := NewTodoHandlerWithStorage(mockStorage)
+	handler.SetValidation(validation.Options{LowercaseTags: true})
+
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+	_, err := handler.Create(ctx, nil, &mcp.CallToolParamsFor[TodoCreateArgs]{Arguments: TodoCreateArgs{
+		Title: "  Write docs  ",
+		Tags:  []string{"Work", " work ", "docs"},
+	}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	for _, todo := range mockStorage.todos {
+		if todo.Title != "Write docs" {
+			t.Errorf("Expected a trimmed title, got %q", todo.Title)
+		}
+		if !reflect.DeepEqual(todo.Tags, []string{"work", "docs"}) {
+			t.Errorf("Expected normalized tags, got %q", todo.Tags)
+		}
+	}
+}
+
+func TestHandlers_ReferentialIntegrity(t *testing.T) {
+	mockStorage := NewMockStorage()
+	mockStorage.SetupTestData()
+	mockStorage.CreateTodo(context.Background(), &models.Todo{ID: "other-todo", UserID: "test-user-2", Title: "Other"})
+	todos := NewTodoHandlerWithStorage(mockStorage)
+	memos := NewMemoHandlerWithStorage(mockStorage)
+	ctx := context.WithValue(context.Background(), auth.UserIDKey, "test-user-1")
+
+	tests := []struct {
+		name       string
+		call       func() error
+		wantFields []string
+	}{
+		{"missing parent", func() error {
+			_, err := todos.Create(ctx, nil, &mcp.CallToolParamsFor[TodoCreateArgs]{Arguments: TodoCreateArgs{Title: "Child", ParentID: "missing"}})
+			return err
+		}, []string{"parent_id"}},
+		{"other user's parent", func() error {
+			_, err := todos.Create(ctx, nil, &mcp.CallToolParamsFor[TodoCreateArgs]{Arguments: TodoCreateArgs{Title: "Child", ParentID: "other-todo"}})
+			return err
+		}, []string{"parent_id"}},
+		{"linked todos", func() error {
+			_, err := memos.Create(ctx, nil, &mcp.CallToolParamsFor[MemoCreateArgs]{Arguments: MemoCreateArgs{Title: "Notes", LinkedTodos: []string{"test-todo-1", "missing", "other-todo"}}})
+			return err
+		}, []string{"linked_todos[1]", "linked_todos[2]"}},
+		{"update", func() error {
+			_, err := memos.Update(ctx, nil, &mcp.CallToolParamsFor[MemoUpdateArgs]{Arguments: MemoUpdateArgs{ID: "test-memo-1", Title: " ", LinkedTodos: []string{"missing"}}})
+			return err
+		}, []string{"title", "linked_todos[0]"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fieldErrors validation.Errors
+			if err := tt.call(); !errors.As(err, &fieldErrors) {
+				t.Fatalf("Expected field errors, got %v", err)
+			}
+			var fields []string
+			for _, fe := range fieldErrors {
+				fields = append(fields, fe.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("Expected errors on %v, got %v", tt.wantFields, fields)
+			}
+		})
+	}
+}
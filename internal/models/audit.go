@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+)
+
+// AuditAction identifies what an audit event records
+type AuditAction string
+
+const (
+	AuditTodoCreate     AuditAction = "todo.create"
+	AuditTodoUpdate     AuditAction = "todo.update"
+	AuditTodoDelete     AuditAction = "todo.delete"
+	AuditMemoCreate     AuditAction = "memo.create"
+	AuditMemoUpdate     AuditAction = "memo.update"
+	AuditMemoDelete     AuditAction = "memo.delete"
+	AuditTokenCreate    AuditAction = "token.create"
+	AuditTokenRevoke    AuditAction = "token.revoke"
+	AuditSignIn         AuditAction = "auth.sign_in"
+	AuditSignOut        AuditAction = "auth.sign_out"
+	AuditIdentityUnlink AuditAction = "auth.unlink"
+	AuditAccountDelete  AuditAction = "account.delete"
+)
+
+// AuditActions lists every audit action
+var AuditActions = []AuditAction{
+	AuditTodoCreate, AuditTodoUpdate, AuditTodoDelete,
+	AuditMemoCreate, AuditMemoUpdate, AuditMemoDelete,
+	AuditTokenCreate, AuditTokenRevoke,
+	AuditSignIn, AuditSignOut, AuditIdentityUnlink, AuditAccountDelete,
+}
+
+// AuditEvent records a change to a user's data or account. Events are only
+// ever appended, and are kept when the account is deleted.
+type AuditEvent struct {
+	ID         string        `firestore:"id" json:"id"`
+	UserID     string        `firestore:"user_id" json:"user_id"`
+	TokenID    string        `firestore:"token_id,omitempty" json:"token_id,omitempty"`     // Personal access token the change was made with
+	SessionID  string        `firestore:"session_id,omitempty" json:"session_id,omitempty"` // Sign-in session the change was made in
+	Client     string        `firestore:"client,omitempty" json:"client,omitempty"`         // User-Agent of the request
+	RequestID  string        `firestore:"request_id,omitempty" json:"request_id,omitempty"`
+	Tool       string        `firestore:"tool,omitempty" json:"tool,omitempty"` // Tool called, if any
+	Action     AuditAction   `firestore:"action" json:"action"`
+	TargetType string        `firestore:"target_type" json:"target_type"` // "todo", "memo", "token", "session", "identity" or "user"
+	TargetID   string        `firestore:"target_id" json:"target_id"`
+	Changes    []FieldChange `firestore:"changes" json:"changes"`
+	CreatedAt  time.Time     `firestore:"created_at" json:"created_at"`
+}
+
+// FieldChange summarizes how one field changed. Long values are truncated.
+type FieldChange struct {
+	Field string `firestore:"field" json:"field"`
+	From  string `firestore:"from,omitempty" json:"from,omitempty"`
+	To    string `firestore:"to,omitempty" json:"to,omitempty"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+)
+
+// ChangeKind identifies what kind of item changed
+type ChangeKind string
+
+const (
+	ChangeKindTodo ChangeKind = "todo"
+	ChangeKindMemo ChangeKind = "memo"
+)
+
+// ChangeOp describes what happened to the item
+type ChangeOp string
+
+const (
+	ChangeOpCreated ChangeOp = "created"
+	ChangeOpUpdated ChangeOp = "updated"
+	ChangeOpDeleted ChangeOp = "deleted"
+)
+
+// Change is a single entry of a user's change feed
+type Change struct {
+	Kind ChangeKind `json:"kind"`
+	ID   string     `json:"id"`
+	Op   ChangeOp   `json:"op"`
+	At   time.Time  `json:"at"`
+}
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"net/url"
+	"time"
+)
+
+// Identity links an account at an identity provider to a memoya user. A
+// user can sign in with any of their linked identities. Only the provider's
+// stable account ID is stored.
+type Identity struct {
+	ID        string    `firestore:"id" json:"id"` // IdentityID(Provider, Subject)
+	UserID    string    `firestore:"user_id" json:"user_id"`
+	Provider  string    `firestore:"provider" json:"provider"` // Name of the identity provider, e.g. "google" or "github"
+	Subject   string    `firestore:"subject" json:"subject"`   // Account ID at the provider
+	CreatedAt time.Time `firestore:"created_at" json:"created_at"`
+}
+
+// IdentityID returns the ID of the identity of subject at provider
+func IdentityID(provider, subject string) string {
+	return provider + ":" + url.PathEscape(subject)
+}
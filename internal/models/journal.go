@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+)
+
+// JournalState tells whether a journal entry is in effect
+type JournalState string
+
+const (
+	JournalApplied JournalState = "applied"
+	JournalUndone  JournalState = "undone"
+)
+
+// JournalEntry records the changes one tool call made to a user's todos and
+// memos, so they can be undone and redone. A call changing several items is
+// one entry, undone as a whole.
+type JournalEntry struct {
+	ID        string        `firestore:"id" json:"id"`
+	UserID    string        `firestore:"user_id" json:"user_id"`
+	SessionID string        `firestore:"session_id,omitempty" json:"session_id,omitempty"` // Sign-in session the change was made in
+	TokenID   string        `firestore:"token_id,omitempty" json:"token_id,omitempty"`     // Personal access token the change was made with
+	Tool      string        `firestore:"tool" json:"tool"`
+	Steps     []JournalStep `firestore:"steps" json:"steps"`
+	State     JournalState  `firestore:"state" json:"state"`
+	CreatedAt time.Time     `firestore:"created_at" json:"created_at"`
+	UndoneAt  *time.Time    `firestore:"undone_at,omitempty" json:"undone_at,omitempty"`
+	ExpiresAt time.Time     `firestore:"expires_at" json:"-"` // Deleted by a TTL policy after this
+	// StepCount is the number of steps stored as documents of their own by
+	// Firestore, 0 for entries keeping them inline
+	StepCount int `firestore:"step_count,omitempty" json:"-"`
+}
+
+// JournalStep is the change of one todo or memo, as the item before and
+// after it. A nil before is a created item, a nil after a deleted one.
+type JournalStep struct {
+	TargetType string `firestore:"target_type" json:"target_type"` // "todo" or "memo"
+	TargetID   string `firestore:"target_id" json:"target_id"`
+	TodoBefore *Todo  `firestore:"todo_before,omitempty" json:"todo_before,omitempty"`
+	TodoAfter  *Todo  `firestore:"todo_after,omitempty" json:"todo_after,omitempty"`
+	MemoBefore *Memo  `firestore:"memo_before,omitempty" json:"memo_before,omitempty"`
+	MemoAfter  *Memo  `firestore:"memo_after,omitempty" json:"memo_after,omitempty"`
+}
@@ -11,6 +11,7 @@ type Memo struct {
 	Description  string     `firestore:"description" json:"description"`
 	Tags         []string   `firestore:"tags" json:"tags"`
 	LinkedTodos  []string   `firestore:"linked_todos" json:"linked_todos"`
+	SourceTodoID string     `firestore:"source_todo_id,omitempty" json:"source_todo_id,omitempty"` // Todo the memo was demoted from
 	CreatedAt    time.Time  `firestore:"created_at" json:"created_at"`
 	LastModified time.Time  `firestore:"last_modified" json:"last_modified"`
 	ClosedAt     *time.Time `firestore:"closed_at,omitempty" json:"closed_at,omitempty"`
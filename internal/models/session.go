@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+)
+
+// Session is one sign-in of a user. Access tokens and the refresh token
+// family issued by the sign-in carry its ID and stop working once the
+// session is deleted.
+type Session struct {
+	ID         string    `firestore:"id" json:"id"`
+	UserID     string    `firestore:"user_id" json:"user_id"`
+	Client     string    `firestore:"client" json:"client"` // User-Agent of the sign-in request
+	CreatedAt  time.Time `firestore:"created_at" json:"created_at"`
+	LastUsedAt time.Time `firestore:"last_used_at" json:"last_used_at"` // Updated on each refresh
+	ExpiresAt  time.Time `firestore:"expires_at" json:"expires_at"`     // Extended on each refresh
+}
@@ -29,6 +29,7 @@ type Todo struct {
 	Priority     TodoPriority `firestore:"priority" json:"priority"`
 	Tags         []string     `firestore:"tags" json:"tags"`
 	ParentID     string       `firestore:"parent_id,omitempty" json:"parent_id,omitempty"`
+	SourceMemoID string       `firestore:"source_memo_id,omitempty" json:"source_memo_id,omitempty"` // Memo the todo was promoted from
 	CreatedAt    time.Time    `firestore:"created_at" json:"created_at"`
 	LastModified time.Time    `firestore:"last_modified" json:"last_modified"`
 	ClosedAt     *time.Time   `firestore:"closed_at,omitempty" json:"closed_at,omitempty"`
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+)
+
+// AccessToken is a personal access token for scripts and integrations. Only
+// the SHA-256 hash of the token is stored.
+type AccessToken struct {
+	ID         string     `firestore:"id" json:"id"`
+	UserID     string     `firestore:"user_id" json:"user_id"`
+	Name       string     `firestore:"name" json:"name"`
+	TokenHash  string     `firestore:"token_hash" json:"-"`
+	Scopes     []string   `firestore:"scopes" json:"scopes"`
+	CreatedAt  time.Time  `firestore:"created_at" json:"created_at"`
+	ExpiresAt  *time.Time `firestore:"expires_at,omitempty" json:"expires_at,omitempty"`     // Never expires if nil
+	LastUsedAt *time.Time `firestore:"last_used_at,omitempty" json:"last_used_at,omitempty"` // Updated at most once a minute
+}
+
+// RefreshToken exchanges for a new access token. Refresh tokens rotate: each
+// use replaces the token with a new one in the same family. Only the SHA-256
+// hash of the token is stored.
+type RefreshToken struct {
+	ID        string     `firestore:"id" json:"id"`
+	UserID    string     `firestore:"user_id" json:"user_id"`
+	FamilyID  string     `firestore:"family_id" json:"family_id"` // ID of the session the tokens were issued for
+	TokenHash string     `firestore:"token_hash" json:"-"`
+	CreatedAt time.Time  `firestore:"created_at" json:"created_at"`
+	ExpiresAt time.Time  `firestore:"expires_at" json:"expires_at"`
+	RotatedAt *time.Time `firestore:"rotated_at,omitempty" json:"rotated_at,omitempty"` // Set once exchanged
+}
@@ -6,26 +6,32 @@ import (
 
 // User represents a user in the system with minimal privacy-focused data
 type User struct {
-	ID        string    `firestore:"id" json:"id"`               // Random UUID for user identification
-	GoogleID  string    `firestore:"google_id" json:"google_id"` // Google OAuth user ID for authentication only
+	ID        string    `firestore:"id" json:"id"`                                   // Random UUID for user identification
+	GoogleID  string    `firestore:"google_id,omitempty" json:"google_id,omitempty"` // Legacy Google sign-in, moved to an Identity on next sign-in
 	CreatedAt time.Time `firestore:"created_at" json:"created_at"`
 	IsActive  bool      `firestore:"is_active" json:"is_active"` // Account status
 }
 
 // DeviceAuthSession represents a temporary authentication session for OAuth Device Flow
 type DeviceAuthSession struct {
-	DeviceCode      string    `firestore:"device_code" json:"device_code"`
-	UserCode        string    `firestore:"user_code" json:"user_code"`
-	VerificationURI string    `firestore:"verification_uri" json:"verification_uri"`
-	ExpiresAt       time.Time `firestore:"expires_at" json:"expires_at"`
-	UserID          string    `firestore:"user_id,omitempty" json:"user_id,omitempty"` // Set after authorization
-	Status          string    `firestore:"status" json:"status"`                       // "pending", "authorized", "expired"
-	CreatedAt       time.Time `firestore:"created_at" json:"created_at"`
+	DeviceCode      string     `firestore:"device_code" json:"device_code"`
+	UserCode        string     `firestore:"user_code" json:"user_code"`
+	VerificationURI string     `firestore:"verification_uri" json:"verification_uri"`
+	ExpiresAt       time.Time  `firestore:"expires_at" json:"expires_at"`
+	DeleteAt        time.Time  `firestore:"delete_at" json:"delete_at"`                               // A Firestore TTL policy deletes the session after this
+	Interval        int        `firestore:"interval" json:"interval"`                                 // Seconds clients wait between polls
+	LastPolledAt    *time.Time `firestore:"last_polled_at,omitempty" json:"last_polled_at,omitempty"` // Enforces the interval
+	Provider        string     `firestore:"provider,omitempty" json:"provider,omitempty"`             // Identity provider the user signs in with
+	LinkUserID      string     `firestore:"link_user_id,omitempty" json:"link_user_id,omitempty"`     // Set when linking the identity to a signed-in user
+	UserID          string     `firestore:"user_id,omitempty" json:"user_id,omitempty"`               // Set after authorization
+	Status          string     `firestore:"status" json:"status"`                                     // "pending", "denied", "expired"; authorized sessions are deleted
+	CreatedAt       time.Time  `firestore:"created_at" json:"created_at"`
 }
 
 // DeviceAuthStatus constants
 const (
 	DeviceAuthStatusPending    = "pending"
 	DeviceAuthStatusAuthorized = "authorized"
+	DeviceAuthStatusDenied     = "denied"
 	DeviceAuthStatusExpired    = "expired"
 )
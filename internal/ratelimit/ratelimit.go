@@ -0,0 +1,137 @@
+// Package ratelimit limits how often clients may call memoya-server, with a
+// token bucket per key (a user ID or an IP address)
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often buckets that refilled completely are dropped
+const sweepInterval = time.Minute
+
+// Limiter allows each key bursts of up to burst events and refills its bucket
+// with perMinute events per minute. A nil Limiter, or one with a zero rate,
+// allows everything.
+type Limiter struct {
+	perMinute int
+	burst     int
+	now       func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// bucket holds the tokens of one key as of last
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// New creates a limiter allowing perMinute events per minute per key, in
+// bursts of up to burst events (at least one)
+func New(perMinute, burst int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &Limiter{
+		perMinute: perMinute,
+		burst:     burst,
+		now:       time.Now,
+		buckets:   make(map[string]*bucket),
+	}
+}
+
+// PerMinute returns the sustained rate of events per key, or 0 if unlimited
+func (l *Limiter) PerMinute() int {
+	if !l.enabled() {
+		return 0
+	}
+	return l.perMinute
+}
+
+// Burst returns how many events a key may make at once, or 0 if unlimited
+func (l *Limiter) Burst() int {
+	if !l.enabled() {
+		return 0
+	}
+	return l.burst
+}
+
+// Allow takes a token from the bucket of key. When the bucket is empty it
+// returns false and how long until a token is available.
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	if !l.enabled() {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b := l.refill(key, now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	wait := time.Duration((1 - b.tokens) / l.rate() * float64(time.Second))
+	return false, wait
+}
+
+// Remaining returns how many events key may make right now, or -1 if
+// unlimited
+func (l *Limiter) Remaining(key string) int {
+	if !l.enabled() {
+		return -1
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return int(math.Floor(l.refill(key, l.now()).tokens))
+}
+
+func (l *Limiter) enabled() bool {
+	return l != nil && l.perMinute > 0
+}
+
+// rate returns the tokens added per second
+func (l *Limiter) rate() float64 {
+	return float64(l.perMinute) / 60
+}
+
+// refill returns the bucket of key with the tokens added since it was last
+// used. New keys start with a full bucket.
+func (l *Limiter) refill(key string, now time.Time) *bucket {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.burst), last: now}
+		l.buckets[key] = b
+		return b
+	}
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(float64(l.burst), b.tokens+elapsed.Seconds()*l.rate())
+		b.last = now
+	}
+	return b
+}
+
+// sweep drops the buckets that have refilled completely, since they behave
+// like new ones, so idle keys do not pile up
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate() >= float64(l.burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
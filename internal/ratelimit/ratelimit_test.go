@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	now := time.Now()
+	l := New(60, 2) // One token per second
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if ok, _ := l.Allow("alice"); !ok {
+			t.Fatalf("Expected request %d of the burst to be allowed", i+1)
+		}
+	}
+
+	ok, wait := l.Allow("alice")
+	if ok {
+		t.Fatal("Expected the request after the burst to be limited")
+	}
+	if wait != time.Second {
+		t.Errorf("Expected to wait 1s, got %v", wait)
+	}
+
+	// Keys have buckets of their own
+	if ok, _ := l.Allow("bob"); !ok {
+		t.Error("Expected another key to be allowed")
+	}
+
+	now = now.Add(1500 * time.Millisecond)
+	if ok, _ := l.Allow("alice"); !ok {
+		t.Error("Expected a token to be refilled")
+	}
+	if got := l.Remaining("alice"); got != 0 {
+		t.Errorf("Expected no tokens left, got %d", got)
+	}
+}
+
+func TestLimiter_Sweep(t *testing.T) {
+	now := time.Now()
+	l := New(60, 5)
+	l.now = func() time.Time { return now }
+
+	l.Allow("alice")
+	now = now.Add(2 * sweepInterval)
+	l.Allow("bob")
+
+	if _, ok := l.buckets["alice"]; ok {
+		t.Error("Expected the refilled bucket to be dropped")
+	}
+	if _, ok := l.buckets["bob"]; !ok {
+		t.Error("Expected the bucket in use to be kept")
+	}
+}
+
+func TestLimiter_Disabled(t *testing.T) {
+	for _, l := range []*Limiter{nil, New(0, 10)} {
+		for i := 0; i < 100; i++ {
+			if ok, _ := l.Allow("alice"); !ok {
+				t.Fatal("Expected a disabled limiter to allow everything")
+			}
+		}
+		if got := l.Remaining("alice"); got != -1 {
+			t.Errorf("Expected unlimited remaining requests, got %d", got)
+		}
+	}
+}
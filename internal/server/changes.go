@@ -0,0 +1,278 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+const (
+	// changeBufferSize is how many changes a client can catch up on; further
+	// behind, it is told to reset
+	changeBufferSize = 256
+	// changeFeedIdleTimeout stops watching a user nobody has asked about for a while
+	changeFeedIdleTimeout = 5 * time.Minute
+	// changePollInterval is used by storages without native change notifications
+	changePollInterval = 10 * time.Second
+	// changeSettleDelay is how long audit events may take to be stored after
+	// the change they record. Changes are only returned once this old, so
+	// that none stored late falls behind a cursor already returned.
+	changeSettleDelay = 2 * time.Second
+)
+
+// watchFunc streams the changes of a user until ctx is done
+type watchFunc func(ctx context.Context, userID string, changes chan<- *models.Change) error
+
+// auditLister lists audit events, which record every change to todos and memos
+type auditLister interface {
+	ListAuditEvents(ctx context.Context, filters storage.AuditFilters) ([]*models.AuditEvent, error)
+}
+
+// changeHub serves the users' change feeds from the audit log, which every
+// server instance records the changes it makes to. Cursors handed to
+// clients are the time up to which changes were returned, so any instance
+// can resume them. While clients are waiting, the user's items are watched
+// to know when to read the audit log again.
+type changeHub struct {
+	watch       watchFunc
+	events      auditLister
+	idleTimeout time.Duration
+	settle      time.Duration
+	now         func() time.Time
+
+	mu    sync.Mutex
+	feeds map[string]*changeFeed
+}
+
+// changeFeed watches the changes of a user to wake up waiting clients
+type changeFeed struct {
+	cancel   context.CancelFunc
+	notify   chan struct{} // closed and replaced whenever a change arrives
+	lastUsed time.Time
+	waiters  int
+}
+
+func newChangeHub(watch watchFunc, events auditLister) *changeHub {
+	return &changeHub{
+		watch:       watch,
+		events:      events,
+		idleTimeout: changeFeedIdleTimeout,
+		settle:      changeSettleDelay,
+		now:         time.Now,
+		feeds:       make(map[string]*changeFeed),
+	}
+}
+
+// storageWatcher watches changes through s, polling when s has no native change notifications
+func storageWatcher(s storage.Storage) watchFunc {
+	return func(ctx context.Context, userID string, changes chan<- *models.Change) error {
+		return storage.WatchChanges(ctx, s, userID, changePollInterval, changes)
+	}
+}
+
+// Wait returns the user's changes after cursor, waiting until at least one
+// arrives or ctx is done. An empty cursor returns the current cursor right
+// away. reset is true when cursor can't be resumed.
+func (h *changeHub) Wait(ctx context.Context, userID, cursor string) (changes []*models.Change, next string, reset bool, err error) {
+	since, ok := parseCursor(cursor)
+	if cursor == "" || !ok {
+		return nil, formatCursor(h.bound(time.Time{})), cursor != "", nil
+	}
+
+	for {
+		// Take the notification channel before reading, so a change made
+		// while reading is not missed
+		feed, notify := h.waitStart(userID)
+
+		until := h.bound(since)
+		changes, reset, err := h.changesBetween(ctx, userID, since, until)
+		if err != nil || reset || len(changes) > 0 {
+			h.waitEnd(feed)
+			if reset {
+				return nil, formatCursor(until), true, err
+			}
+			return changes, formatCursor(until), false, err
+		}
+		since = until
+
+		select {
+		case <-notify:
+			h.waitEnd(feed)
+			// Give the audit event of the change time to be stored
+			select {
+			case <-time.After(h.settle):
+			case <-ctx.Done():
+				return nil, formatCursor(since), false, nil
+			}
+		case <-ctx.Done():
+			h.waitEnd(feed)
+			return nil, formatCursor(since), false, nil
+		}
+	}
+}
+
+// bound returns the time up to which changes are returned now, and never
+// before since. Firestore keeps microseconds.
+func (h *changeHub) bound(since time.Time) time.Time {
+	bound := h.now().Add(-h.settle).Truncate(time.Microsecond)
+	if bound.Before(since) {
+		return since
+	}
+	return bound
+}
+
+// changesBetween returns the changes to the user's todos and memos recorded
+// after since and up to until, oldest first. reset is true when there are
+// more than a client can catch up on.
+func (h *changeHub) changesBetween(ctx context.Context, userID string, since, until time.Time) ([]*models.Change, bool, error) {
+	if !until.After(since) {
+		return nil, false, nil
+	}
+
+	from, to := since.Add(time.Microsecond), until.Add(time.Microsecond)
+	events, err := h.events.ListAuditEvents(ctx, storage.AuditFilters{
+		UserID: userID,
+		Since:  &from,
+		Until:  &to,
+		Limit:  changeBufferSize + 1,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(events) > changeBufferSize {
+		return nil, true, nil
+	}
+
+	var changes []*models.Change
+	for _, event := range slices.Backward(events) {
+		if change := auditChange(event); change != nil {
+			changes = append(changes, change)
+		}
+	}
+	return changes, false, nil
+}
+
+// auditChange returns the change an audit event records, if it is one to a
+// todo or memo
+func auditChange(event *models.AuditEvent) *models.Change {
+	change := &models.Change{ID: event.TargetID, At: event.CreatedAt}
+	switch event.Action {
+	case models.AuditTodoCreate, models.AuditTodoUpdate, models.AuditTodoDelete:
+		change.Kind = models.ChangeKindTodo
+	case models.AuditMemoCreate, models.AuditMemoUpdate, models.AuditMemoDelete:
+		change.Kind = models.ChangeKindMemo
+	default:
+		return nil
+	}
+	switch event.Action {
+	case models.AuditTodoCreate, models.AuditMemoCreate:
+		change.Op = models.ChangeOpCreated
+	case models.AuditTodoUpdate, models.AuditMemoUpdate:
+		change.Op = models.ChangeOpUpdated
+	default:
+		change.Op = models.ChangeOpDeleted
+	}
+	return change
+}
+
+// waitStart registers a waiter on the user's feed, starting it if needed,
+// and returns the feed with the channel closed on its next change
+func (h *changeHub) waitStart(userID string) (*changeFeed, <-chan struct{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	feed, ok := h.feeds[userID]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		feed = &changeFeed{
+			cancel: cancel,
+			notify: make(chan struct{}),
+		}
+		h.feeds[userID] = feed
+		go h.run(ctx, userID, feed)
+	}
+	feed.waiters++
+	feed.lastUsed = time.Now()
+	return feed, feed.notify
+}
+
+// waitEnd unregisters a waiter of waitStart
+func (h *changeHub) waitEnd(feed *changeFeed) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	feed.waiters--
+	feed.lastUsed = time.Now()
+}
+
+func (h *changeHub) run(ctx context.Context, userID string, feed *changeFeed) {
+	changes := make(chan *models.Change)
+	errc := make(chan error, 1)
+	go func() { errc <- h.watch(ctx, userID, changes) }()
+
+	ticker := time.NewTicker(h.idleTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-changes:
+			h.mu.Lock()
+			close(feed.notify)
+			feed.notify = make(chan struct{})
+			h.mu.Unlock()
+
+		case <-ticker.C:
+			h.mu.Lock()
+			idle := feed.waiters == 0 && time.Since(feed.lastUsed) > h.idleTimeout
+			if idle {
+				h.stop(userID, feed)
+			}
+			h.mu.Unlock()
+			if idle {
+				<-errc
+				return
+			}
+
+		case err := <-errc:
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Printf("Change feed for user %s stopped: %v", userID, err)
+			}
+			h.mu.Lock()
+			h.stop(userID, feed)
+			h.mu.Unlock()
+			return
+		}
+	}
+}
+
+// stop removes the feed and wakes up its waiters, which read the audit log
+// once more and start a new feed. h.mu must be held.
+func (h *changeHub) stop(userID string, feed *changeFeed) {
+	if h.feeds[userID] == feed {
+		delete(h.feeds, userID)
+	}
+	feed.cancel()
+	close(feed.notify)
+	feed.notify = make(chan struct{})
+}
+
+// formatCursor returns the cursor of changes up to t: its Unix time in
+// microseconds
+func formatCursor(t time.Time) string {
+	return strconv.FormatInt(t.UnixMicro(), 10)
+}
+
+func parseCursor(cursor string) (time.Time, bool) {
+	micros, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil || micros <= 0 {
+		return time.Time{}, false
+	}
+	return time.UnixMicro(micros), true
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+// fakeAuditLog is an audit log shared by server instances
+type fakeAuditLog struct {
+	mu     sync.Mutex
+	events []*models.AuditEvent
+}
+
+func (l *fakeAuditLog) record(action models.AuditAction, targetID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, &models.AuditEvent{UserID: "user-1", Action: action, TargetID: targetID, CreatedAt: time.Now()})
+}
+
+func (l *fakeAuditLog) ListAuditEvents(ctx context.Context, filters storage.AuditFilters) ([]*models.AuditEvent, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var events []*models.AuditEvent
+	for _, event := range slices.Backward(l.events) {
+		if event.UserID == filters.UserID && !event.CreatedAt.Before(*filters.Since) && event.CreatedAt.Before(*filters.Until) {
+			events = append(events, event)
+		}
+		if len(events) == filters.Limit {
+			break
+		}
+	}
+	return events, nil
+}
+
+func newTestChangeHub(log *fakeAuditLog, source chan *models.Change) *changeHub {
+	hub := newChangeHub(func(ctx context.Context, userID string, changes chan<- *models.Change) error {
+		for {
+			select {
+			case change := <-source:
+				changes <- change
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}, log)
+	hub.settle = 20 * time.Millisecond
+	return hub
+}
+
+func TestChangeHub_Wait(t *testing.T) {
+	log := &fakeAuditLog{}
+	source := make(chan *models.Change)
+	hub := newTestChangeHub(log, source)
+
+	// An empty cursor returns the current position right away
+	changes, cursor, reset, err := hub.Wait(context.Background(), "user-1", "")
+	if err != nil || reset || len(changes) != 0 || cursor == "" {
+		t.Fatalf("Expected initial cursor, got changes=%v cursor=%q reset=%v err=%v", changes, cursor, reset, err)
+	}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		log.record(models.AuditTodoCreate, "t1")
+		log.record(models.AuditTokenCreate, "token-1")
+		source <- &models.Change{Kind: models.ChangeKindTodo, ID: "t1", Op: models.ChangeOpCreated}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	changes, next, reset, err := hub.Wait(ctx, "user-1", cursor)
+	if err != nil || reset {
+		t.Fatalf("Expected changes, got reset=%v err=%v", reset, err)
+	}
+	if len(changes) != 1 || changes[0].ID != "t1" || changes[0].Op != models.ChangeOpCreated {
+		t.Fatalf("Expected creation of t1, got %v", changes)
+	}
+	if next == cursor {
+		t.Error("Expected cursor to advance")
+	}
+
+	// Nothing new: waits until the context is done
+	shortCtx, shortCancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer shortCancel()
+
+	changes, later, _, err := hub.Wait(shortCtx, "user-1", next)
+	if err != nil || len(changes) != 0 {
+		t.Errorf("Expected no changes, got changes=%v err=%v", changes, err)
+	}
+	nextAt, _ := parseCursor(next)
+	if laterAt, ok := parseCursor(later); !ok || laterAt.Before(nextAt) {
+		t.Errorf("Expected a cursor at or after %q, got %q", next, later)
+	}
+}
+
+func TestChangeHub_Wait_OtherInstance(t *testing.T) {
+	log := &fakeAuditLog{}
+	first := newTestChangeHub(log, make(chan *models.Change))
+	second := newTestChangeHub(log, make(chan *models.Change))
+
+	_, cursor, _, err := first.Wait(context.Background(), "user-1", "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	time.Sleep(30 * time.Millisecond)
+	log.record(models.AuditMemoUpdate, "m1")
+	log.record(models.AuditTodoDelete, "t1")
+	time.Sleep(30 * time.Millisecond)
+
+	// Another instance resumes the cursor rather than resetting
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	changes, _, reset, err := second.Wait(ctx, "user-1", cursor)
+	if err != nil || reset {
+		t.Fatalf("Expected changes, got reset=%v err=%v", reset, err)
+	}
+	if len(changes) != 2 || changes[0].ID != "m1" || changes[0].Kind != models.ChangeKindMemo || changes[1].Op != models.ChangeOpDeleted {
+		t.Errorf("Expected the memo update and todo deletion in order, got %v", changes)
+	}
+}
+
+func TestChangeHub_Wait_Reset(t *testing.T) {
+	log := &fakeAuditLog{}
+	hub := newTestChangeHub(log, make(chan *models.Change))
+
+	for _, cursor := range []string{"other-feed.3", "garbage", "-5"} {
+		changes, next, reset, err := hub.Wait(context.Background(), "user-1", cursor)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !reset || len(changes) != 0 || next == "" {
+			t.Errorf("Expected reset with a fresh cursor for %q, got changes=%v cursor=%q reset=%v", cursor, changes, next, reset)
+		}
+	}
+
+	// Too far behind to catch up
+	_, cursor, _, _ := hub.Wait(context.Background(), "user-1", "")
+	time.Sleep(30 * time.Millisecond)
+	for i := 0; i <= changeBufferSize; i++ {
+		log.record(models.AuditTodoUpdate, "t1")
+	}
+	time.Sleep(30 * time.Millisecond)
+	if _, _, reset, err := hub.Wait(context.Background(), "user-1", cursor); err != nil || !reset {
+		t.Errorf("Expected a reset, got reset=%v err=%v", reset, err)
+	}
+}
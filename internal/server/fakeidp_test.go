@@ -0,0 +1,232 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/client"
+	"github.com/pankona/memoya/internal/fakeidp"
+	"github.com/pankona/memoya/internal/generated/server"
+	"github.com/pankona/memoya/internal/handlers"
+	"github.com/pankona/memoya/internal/models"
+)
+
+// newFakeIdPSetup serves memoya signing in with a fake identity provider and
+// returns the provider and a client side auth handler for it
+func newFakeIdPSetup(t *testing.T, opts fakeidp.Options) (*httptest.Server, *handlers.AuthHandler, *client.HTTPClient) {
+	t.Helper()
+	ctx := context.Background()
+
+	idp := httptest.NewServer(fakeidp.New(opts))
+	t.Cleanup(idp.Close)
+	provider, err := auth.NewOIDCProvider(ctx, "fake", idp.URL, "memoya-dev", "")
+	if err != nil {
+		t.Fatalf("Failed to discover the fake identity provider: %v", err)
+	}
+
+	mockStorage := handlers.NewMockStorage()
+	s := NewServerWithAuth(ctx, mockStorage, auth.NewDeviceFlowService(mockStorage, provider))
+	r := chi.NewRouter()
+	server.HandlerFromMux(s, r)
+	r.Post("/mcp/{tool}", s.CallTool)
+	ts := httptest.NewServer(r)
+	t.Cleanup(ts.Close)
+
+	// The client saves its tokens below XDG_CONFIG_HOME
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	httpClient := client.NewHTTPClient(ts.URL)
+	httpClient.SetCredentialStore(handlers.NewConfigManager())
+	return idp, handlers.NewAuthHandler(httpClient), httpClient
+}
+
+func authStart(t *testing.T, h *handlers.AuthHandler) handlers.AuthStartResult {
+	t.Helper()
+
+	res, err := h.Start(context.Background(), nil, &mcp.CallToolParamsFor[handlers.AuthStartArgs]{})
+	if err != nil {
+		t.Fatalf("auth_start failed: %v", err)
+	}
+	var result handlers.AuthStartResult
+	json.Unmarshal([]byte(res.Content[0].(*mcp.TextContent).Text), &result)
+	if !result.Success {
+		t.Fatalf("auth_start failed: %s", result.Message)
+	}
+	return result
+}
+
+func authStatus(t *testing.T, h *handlers.AuthHandler) handlers.AuthStatusResult {
+	t.Helper()
+
+	res, err := h.Status(context.Background(), nil, &mcp.CallToolParamsFor[handlers.AuthStatusArgs]{})
+	if err != nil {
+		t.Fatalf("auth_status failed: %v", err)
+	}
+	var result handlers.AuthStatusResult
+	json.Unmarshal([]byte(res.Content[0].(*mcp.TextContent).Text), &result)
+	return result
+}
+
+func TestDeviceFlow_FakeIdPAutoApprove(t *testing.T) {
+	_, h, httpClient := newFakeIdPSetup(t, fakeidp.Options{Identities: []string{"alice"}, AutoApprove: "alice"})
+
+	start := authStart(t, h)
+	if start.Provider != "fake" || start.UserCode == "" {
+		t.Errorf("Unexpected auth_start result: %+v", start)
+	}
+
+	status := authStatus(t, h)
+	if !status.Authenticated {
+		t.Fatalf("Expected to be signed in, got %+v", status)
+	}
+
+	// The saved token works for tool calls
+	if _, err := httpClient.CallTool(context.Background(), "todo_list", struct{}{}); err != nil {
+		t.Errorf("Expected todo_list to succeed, got %v", err)
+	}
+}
+
+func TestDeviceFlow_FakeIdPVerificationPage(t *testing.T) {
+	idp, h, _ := newFakeIdPSetup(t, fakeidp.Options{Identities: []string{"alice", "bob"}, Interval: 1})
+
+	start := authStart(t, h)
+	if status := authStatus(t, h); status.State != handlers.AuthStatePending || status.RetryIn != 1 {
+		t.Fatalf("Expected to wait for approval, got %+v", status)
+	}
+
+	// Approve the code as bob on the verification page
+	resp, err := http.PostForm(start.VerificationURL, url.Values{
+		"user_code": {start.UserCode},
+		"subject":   {"bob"},
+		"action":    {"approve"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to approve the code: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || start.VerificationURL != idp.URL+"/device" {
+		t.Fatalf("Expected the verification page at %s to approve the code, got %d", start.VerificationURL, resp.StatusCode)
+	}
+
+	// Checking again within the interval does not poll
+	if status := authStatus(t, h); status.State != handlers.AuthStatePending {
+		t.Fatalf("Expected to wait out the interval, got %+v", status)
+	}
+
+	time.Sleep(time.Second)
+	if status := authStatus(t, h); !status.Authenticated || status.State != handlers.AuthStateAuthenticated {
+		t.Fatalf("Expected to be signed in, got %+v", status)
+	}
+}
+
+func TestDeviceFlow_PollTooFast(t *testing.T) {
+	_, h, httpClient := newFakeIdPSetup(t, fakeidp.Options{Identities: []string{"alice"}, Interval: 1})
+	start := authStart(t, h)
+	if start.Interval != 1 {
+		t.Errorf("Expected an interval of 1 second, got %d", start.Interval)
+	}
+
+	poll := func() error {
+		_, err := httpClient.CallTool(context.Background(), "device_auth_poll", map[string]string{"device_code": start.DeviceCode})
+		return err
+	}
+	if err := poll(); !apperr.Is(err, apperr.AuthPending) {
+		t.Fatalf("Expected AUTHORIZATION_PENDING, got %v", err)
+	}
+
+	// Polling again right away slows the client down by 5 seconds
+	err := poll()
+	if !apperr.Is(err, apperr.SlowDown) {
+		t.Fatalf("Expected SLOW_DOWN, got %v", err)
+	}
+	if retryAfter := apperr.RetryAfterOf(err); retryAfter != 6*time.Second {
+		t.Errorf("Expected Retry-After of 6 seconds, got %v", retryAfter)
+	}
+}
+
+func TestDeviceFlow_Denied(t *testing.T) {
+	idp, h, httpClient := newFakeIdPSetup(t, fakeidp.Options{Identities: []string{"alice"}, Interval: 1})
+	start := authStart(t, h)
+
+	resp, err := http.PostForm(idp.URL+"/device", url.Values{
+		"user_code": {start.UserCode},
+		"action":    {"deny"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to deny the code: %v", err)
+	}
+	resp.Body.Close()
+
+	status := authStatus(t, h)
+	if status.State != handlers.AuthStateDenied || status.Authenticated {
+		t.Fatalf("Expected the sign-in to be denied, got %+v", status)
+	}
+
+	// The session stays denied
+	_, err = httpClient.CallTool(context.Background(), "device_auth_poll", map[string]string{"device_code": start.DeviceCode})
+	if !apperr.Is(err, apperr.AccessDenied) {
+		t.Errorf("Expected ACCESS_DENIED, got %v", err)
+	}
+
+	// auth_status forgot the denied code
+	if status := authStatus(t, h); status.State != handlers.AuthStateNotAuthenticated {
+		t.Errorf("Expected not to be signed in, got %+v", status)
+	}
+}
+
+func TestDeviceFlow_SingleUse(t *testing.T) {
+	_, h, httpClient := newFakeIdPSetup(t, fakeidp.Options{Identities: []string{"alice"}, AutoApprove: "alice"})
+	start := authStart(t, h)
+	if status := authStatus(t, h); !status.Authenticated {
+		t.Fatalf("Expected to be signed in, got %+v", status)
+	}
+
+	// The device code cannot be exchanged for tokens again
+	_, err := httpClient.CallTool(context.Background(), "device_auth_poll", map[string]string{"device_code": start.DeviceCode})
+	if !apperr.Is(err, apperr.NotFound) {
+		t.Errorf("Expected the consumed session to be gone, got %v", err)
+	}
+}
+
+func TestDeviceFlow_CleanupExpiredSessions(t *testing.T) {
+	ctx := context.Background()
+	mockStorage := handlers.NewMockStorage()
+	now := time.Now()
+	for code, expiresAt := range map[string]time.Time{
+		"long-expired":     now.Add(-2 * time.Hour),
+		"recently-expired": now.Add(-time.Minute),
+		"pending":          now.Add(10 * time.Minute),
+	} {
+		mockStorage.CreateDeviceAuthSession(ctx, &models.DeviceAuthSession{
+			DeviceCode: code,
+			ExpiresAt:  expiresAt,
+			Status:     models.DeviceAuthStatusPending,
+		})
+	}
+
+	deleted, err := auth.NewDeviceFlowService(mockStorage).CleanupExpiredSessions(ctx)
+	if err != nil {
+		t.Fatalf("CleanupExpiredSessions failed: %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("Expected 1 session to be deleted, got %d", deleted)
+	}
+	if _, err := mockStorage.GetDeviceAuthSession(ctx, "long-expired"); !apperr.Is(err, apperr.NotFound) {
+		t.Errorf("Expected the long expired session to be deleted, got %v", err)
+	}
+
+	// Recently expired sessions are kept to report that they expired
+	for _, code := range []string{"recently-expired", "pending"} {
+		if _, err := mockStorage.GetDeviceAuthSession(ctx, code); err != nil {
+			t.Errorf("Expected session %s to be kept, got %v", code, err)
+		}
+	}
+}
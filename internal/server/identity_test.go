@@ -0,0 +1,176 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/handlers"
+	"github.com/pankona/memoya/internal/models"
+)
+
+// fakeProvider approves every device code for the account in subject
+type fakeProvider struct {
+	name    string
+	subject string
+	codes   int
+	onPoll  func(deviceCode string) // called by PollDevice, if set
+}
+
+func (p *fakeProvider) Name() string {
+	return p.name
+}
+
+func (p *fakeProvider) StartDevice(ctx context.Context) (*auth.DeviceAuthorization, error) {
+	p.codes++
+	return &auth.DeviceAuthorization{
+		DeviceCode:      fmt.Sprintf("%s-device-%d", p.name, p.codes),
+		UserCode:        "ABCD-EFGH",
+		VerificationURI: "https://" + p.name + ".example.com/device",
+		ExpiresIn:       600,
+		Interval:        5,
+	}, nil
+}
+
+func (p *fakeProvider) PollDevice(ctx context.Context, deviceCode string) (*models.Identity, error) {
+	if p.onPoll != nil {
+		p.onPoll(deviceCode)
+	}
+	return &models.Identity{Provider: p.name, Subject: p.subject}, nil
+}
+
+func TestServer_LinkIdentities(t *testing.T) {
+	mockStorage := handlers.NewMockStorage()
+	mockStorage.SetupTestData()
+	google := &fakeProvider{name: "google", subject: "google-test-123"}
+	github := &fakeProvider{name: "github", subject: "583231"}
+	s := NewServerWithAuth(context.Background(), mockStorage, auth.NewDeviceFlowService(mockStorage, google, github))
+
+	call := func(handler http.HandlerFunc, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/auth/device_start", strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec
+	}
+
+	// signIn runs the device flow and returns the poll response
+	signIn := func(t *testing.T, token, body string) (string, string, int) {
+		t.Helper()
+		rec := call(s.StartDeviceAuth, token, body)
+		if rec.Code != http.StatusOK {
+			return "", "", rec.Code
+		}
+		var start struct {
+			Data struct {
+				DeviceCode string `json:"device_code"`
+			} `json:"data"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &start)
+
+		rec = call(s.PollDeviceAuth, "", `{"device_code":"`+start.Data.DeviceCode+`"}`)
+		var poll struct {
+			Data struct {
+				User        models.User `json:"user"`
+				AccessToken string      `json:"access_token"`
+			} `json:"data"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &poll)
+		return poll.Data.User.ID, poll.Data.AccessToken, rec.Code
+	}
+
+	// The default provider signs in the user created before identities
+	userID, token, code := signIn(t, "", `{}`)
+	if code != http.StatusOK || userID != "test-user-1" {
+		t.Fatalf("Expected to sign in as test-user-1, got %q (%d)", userID, code)
+	}
+
+	if _, _, code := signIn(t, "", `{"provider":"github","link":true}`); code != http.StatusUnauthorized {
+		t.Errorf("Expected linking to require authentication, got %d", code)
+	}
+	if _, _, code := signIn(t, "", `{"provider":"gitlab"}`); code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for an unknown provider, got %d", code)
+	}
+
+	// Link the GitHub account, then sign in with it
+	if userID, _, code := signIn(t, token, `{"provider":"github","link":true}`); code != http.StatusOK || userID != "test-user-1" {
+		t.Fatalf("Expected to link to test-user-1, got %q (%d)", userID, code)
+	}
+	if userID, _, _ := signIn(t, "", `{"provider":"github"}`); userID != "test-user-1" {
+		t.Errorf("Expected GitHub to sign in as test-user-1, got %q", userID)
+	}
+
+	rec := call(s.ListIdentities, token, ``)
+	var list struct {
+		Data []models.Identity `json:"data"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &list)
+	if len(list.Data) != 2 || list.Data[0].ID != "google:google-test-123" || list.Data[1].ID != "github:583231" {
+		t.Fatalf("Expected the Google and GitHub identities, got %s", rec.Body.String())
+	}
+
+	// A new Google account gets its own user, and cannot take the GitHub account
+	google.subject = "google-other"
+	otherID, otherToken, _ := signIn(t, "", `{}`)
+	if otherID == "" || otherID == "test-user-1" {
+		t.Fatalf("Expected a new user, got %q", otherID)
+	}
+	if _, _, code := signIn(t, otherToken, `{"provider":"github","link":true}`); code != http.StatusConflict {
+		t.Errorf("Expected 409 for an identity of another user, got %d", code)
+	}
+
+	unlink := func(token, id string) int {
+		req := httptest.NewRequest(http.MethodDelete, "/auth/identities/"+id, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		s.UnlinkIdentity(rec, req, id)
+		return rec.Code
+	}
+	if code := unlink(otherToken, "google:google-other"); code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for the only identity, got %d", code)
+	}
+	if code := unlink(otherToken, "github:583231"); code != http.StatusNotFound {
+		t.Errorf("Expected 404 for another user's identity, got %d", code)
+	}
+	if code := unlink(token, "github:583231"); code != http.StatusOK {
+		t.Errorf("Expected the GitHub identity to be unlinked, got %d", code)
+	}
+	if userID, _, _ := signIn(t, "", `{"provider":"github"}`); userID == "test-user-1" {
+		t.Error("Expected the unlinked GitHub account to no longer sign in as test-user-1")
+	}
+}
+
+func TestServer_PollDeviceAuthConsumedOnce(t *testing.T) {
+	mockStorage := handlers.NewMockStorage()
+	mockStorage.SetupTestData()
+	google := &fakeProvider{name: "google", subject: "google-test-123"}
+	s := NewServerWithAuth(context.Background(), mockStorage, auth.NewDeviceFlowService(mockStorage, google))
+
+	rec := httptest.NewRecorder()
+	s.StartDeviceAuth(rec, httptest.NewRequest(http.MethodPost, "/auth/device_start", strings.NewReader(`{}`)))
+	var start struct {
+		Data struct {
+			DeviceCode string `json:"device_code"`
+		} `json:"data"`
+	}
+	json.Unmarshal(rec.Body.Bytes(), &start)
+
+	// Another poll consumes the session while this one asks the provider
+	google.onPoll = func(deviceCode string) {
+		if err := mockStorage.DeleteDeviceAuthSession(context.Background(), deviceCode); err != nil {
+			t.Errorf("Failed to consume session: %v", err)
+		}
+	}
+	rec = httptest.NewRecorder()
+	s.PollDeviceAuth(rec, httptest.NewRequest(http.MethodPost, "/auth/device_poll", strings.NewReader(`{"device_code":"`+start.Data.DeviceCode+`"}`)))
+	if rec.Code != http.StatusGone || strings.Contains(rec.Body.String(), "access_token") {
+		t.Errorf("Expected 410 without tokens, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
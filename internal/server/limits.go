@@ -0,0 +1,56 @@
+package server
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/config"
+	"github.com/pankona/memoya/internal/handlers"
+	"github.com/pankona/memoya/internal/ratelimit"
+)
+
+// SetLimits replaces the rate limits and quotas of the server. Call it before
+// serving requests.
+func (s *Server) SetLimits(limits config.LimitsConfig) {
+	s.userLimiter = ratelimit.New(limits.RatePerMinute, limits.RateBurst)
+	s.authLimiter = ratelimit.New(limits.AuthRatePerMinute, limits.AuthRateBurst)
+	s.quota.SetLimits(limits.Quota)
+}
+
+// rateLimitStatus reports the request rate limit of a user
+func (s *Server) rateLimitStatus(userID string) handlers.RateLimitStatus {
+	return handlers.RateLimitStatus{
+		PerMinute: s.userLimiter.PerMinute(),
+		Burst:     s.userLimiter.Burst(),
+		Remaining: s.userLimiter.Remaining(userID),
+	}
+}
+
+// allowAuthRequest limits the unauthenticated auth endpoints per client IP
+// address, writing a RateLimited response if the client is over the limit
+func (s *Server) allowAuthRequest(w http.ResponseWriter, r *http.Request) bool {
+	if ok, wait := s.authLimiter.Allow(clientIP(r)); !ok {
+		writeAppError(w, apperr.Errorf(apperr.RateLimited, "too many authentication requests; limit is %d per minute", s.authLimiter.PerMinute()).WithRetryAfter(wait))
+		return false
+	}
+	return true
+}
+
+// clientIP returns the IP address of the client sending r. Behind Cloud Run
+// that is the last X-Forwarded-For entry, which the load balancer appends;
+// the earlier entries come from the client and cannot be trusted.
+func clientIP(r *http.Request) string {
+	if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+		entries := strings.Split(forwarded, ",")
+		if ip := strings.TrimSpace(entries[len(entries)-1]); ip != "" {
+			return ip
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
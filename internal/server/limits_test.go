@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pankona/memoya/internal/config"
+	"github.com/pankona/memoya/internal/generated/server"
+	"github.com/pankona/memoya/internal/handlers"
+)
+
+func TestServer_RateLimit(t *testing.T) {
+	mockStorage := handlers.NewMockStorage()
+	mockStorage.SetupTestData()
+	s := NewServerWithAuth(context.Background(), mockStorage, nil)
+	s.SetLimits(config.LimitsConfig{RatePerMinute: 1, RateBurst: 2})
+	token := generateTestToken(t, s, "test-user-1")
+
+	call := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/mcp/todo_list", strings.NewReader(`{}`))
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		s.CallTool(rec, req)
+		return rec
+	}
+
+	for i := 0; i < 2; i++ {
+		if rec := call(token); rec.Code != http.StatusOK {
+			t.Fatalf("Expected request %d to succeed, got %d: %s", i+1, rec.Code, rec.Body.String())
+		}
+	}
+
+	rec := call(token)
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected status 429, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("Retry-After") != "60" {
+		t.Errorf("Expected Retry-After 60, got %q", rec.Header().Get("Retry-After"))
+	}
+	var resp server.Error
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil || resp.Code == nil || *resp.Code != "RATE_LIMITED" {
+		t.Errorf("Expected code RATE_LIMITED, got %s", rec.Body.String())
+	}
+
+	// Other users have limits of their own
+	if rec := call(generateTestToken(t, s, "test-user-2")); rec.Code != http.StatusOK {
+		t.Errorf("Expected another user to be allowed, got %d", rec.Code)
+	}
+}
+
+func TestServer_AuthRateLimit(t *testing.T) {
+	s := NewServerWithAuth(context.Background(), handlers.NewMockStorage(), nil)
+	s.SetLimits(config.LimitsConfig{AuthRatePerMinute: 1, AuthRateBurst: 1})
+
+	refresh := func(forwardedFor string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/auth/refresh", strings.NewReader(`{"refresh_token":"unknown"}`))
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		rec := httptest.NewRecorder()
+		s.RefreshAuth(rec, req)
+		return rec
+	}
+
+	if rec := refresh("203.0.113.1"); rec.Code != http.StatusUnauthorized {
+		t.Fatalf("Expected status 401, got %d: %s", rec.Code, rec.Body.String())
+	}
+	// Only the entry appended by the load balancer identifies the client
+	if rec := refresh("198.51.100.7, 203.0.113.1"); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected status 429, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec := refresh("203.0.113.2"); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected another IP address to be allowed, got %d", rec.Code)
+	}
+}
+
+func TestServer_QuotaStatus(t *testing.T) {
+	mockStorage := handlers.NewMockStorage()
+	s := NewServerWithAuth(context.Background(), mockStorage, nil)
+	limits := config.DefaultLimits()
+	limits.Quota.MaxTodos = 1
+	s.SetLimits(limits)
+	token := generateTestToken(t, s, "test-user-1")
+
+	call := func(tool, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/mcp/"+tool, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		s.CallTool(rec, req)
+		return rec
+	}
+
+	if rec := call("todo_create", `{"title":"first"}`); rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	rec := call("todo_create", `{"title":"second"}`)
+	if rec.Code != http.StatusTooManyRequests || !strings.Contains(rec.Body.String(), "QUOTA_EXCEEDED") {
+		t.Fatalf("Expected QUOTA_EXCEEDED, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = call("quota_status", `{}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var status handlers.QuotaStatusResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &status); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if status.Todos != (handlers.QuotaUsage{Used: 1, Limit: 1}) {
+		t.Errorf("Unexpected todo usage: %+v", status.Todos)
+	}
+	if status.RateLimit.PerMinute != limits.RatePerMinute || status.RateLimit.Remaining != limits.RateBurst-3 {
+		t.Errorf("Unexpected rate limit: %+v", status.RateLimit)
+	}
+}
@@ -0,0 +1,200 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/audit"
+	"github.com/pankona/memoya/internal/handlers"
+)
+
+// NewMCPServer creates an MCP server backed directly by the server's handlers,
+// serving the same tools and prompts as the memoya bridge binary
+func (s *Server) NewMCPServer() *mcp.Server {
+	server := mcp.NewServer("memoya", "0.1.0", &mcp.ServerOptions{
+		CompletionHandler: s.completionHandler.Complete,
+	})
+
+	// Register every tool of the registry running on the server
+	for _, tool := range handlers.Tools() {
+		if !tool.Local {
+			server.AddTools(tool.Bind(s.tools))
+		}
+	}
+
+	// Register prompts
+	for _, prompt := range handlers.Prompts() {
+		server.AddPrompts(&mcp.ServerPrompt{Prompt: prompt, Handler: s.promptHandler.Get})
+	}
+
+	return server
+}
+
+// MCPHandler serves MCP over Streamable HTTP. Requests need the same JWT as
+// the REST API.
+func (s *Server) MCPHandler() http.Handler {
+	mcpServer := s.NewMCPServer()
+	handler := mcp.NewStreamableHTTPHandler(func(*http.Request) *mcp.Server { return mcpServer }, nil)
+	return s.mcpAuth(handler, streamableTransport)
+}
+
+// MCPSSEHandler serves MCP over the legacy HTTP+SSE transport (MCP 2024-11-05)
+func (s *Server) MCPSSEHandler() http.Handler {
+	mcpServer := s.NewMCPServer()
+	handler := mcp.NewSSEHandler(func(*http.Request) *mcp.Server { return mcpServer })
+	return s.mcpAuth(handler, sseTransport)
+}
+
+// mcpTransport tells mcpAuth where a transport carries the session ID
+type mcpTransport struct {
+	// requestSession returns the session a request belongs to, if any
+	requestSession func(r *http.Request) string
+	// responseSession finds the ID of a new session in the response
+	responseSession func(header http.Header, data []byte) string
+	// closesSession reports whether the session ends with the request
+	closesSession func(r *http.Request, created bool) bool
+}
+
+var streamableTransport = mcpTransport{
+	requestSession: func(r *http.Request) string {
+		return r.Header.Get("Mcp-Session-Id")
+	},
+	responseSession: func(header http.Header, data []byte) string {
+		return header.Get("Mcp-Session-Id")
+	},
+	closesSession: func(r *http.Request, created bool) bool {
+		return r.Method == http.MethodDelete
+	},
+}
+
+var sseTransport = mcpTransport{
+	requestSession: func(r *http.Request) string {
+		return r.URL.Query().Get("sessionid")
+	},
+	responseSession: func(header http.Header, data []byte) string {
+		// The first event announces the endpoint to post messages to
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			endpoint, ok := strings.CutPrefix(scanner.Text(), "data: ")
+			if !ok {
+				continue
+			}
+			if u, err := url.Parse(endpoint); err == nil {
+				return u.Query().Get("sessionid")
+			}
+		}
+		return ""
+	},
+	closesSession: func(r *http.Request, created bool) bool {
+		// The GET request creating a session streams it until disconnected
+		return created
+	},
+}
+
+// mcpAuth authenticates MCP requests and binds sessions to the credential
+// that opened them. Tool calls run with the context of the request that
+// created the session, its scopes and audit identity included, so later
+// requests of the session must use the same personal access token, or a JWT
+// of the same sign-in session.
+func (s *Server) mcpAuth(next http.Handler, transport mcpTransport) http.Handler {
+	var (
+		mu     sync.Mutex
+		owners = make(map[string]sessionOwner) // session ID -> owner
+	)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, userID, err := s.verifyAuthAndSetContext(r)
+		if err != nil {
+			writeAppError(w, err)
+			return
+		}
+		r = r.WithContext(ctx)
+		owner := sessionOwnerOf(ctx, userID)
+
+		sessionID := transport.requestSession(r)
+		created := sessionID == ""
+		if created {
+			// Record the owner before the client learns the session ID
+			rec := &sessionRecorder{ResponseWriter: w, find: transport.responseSession}
+			rec.found = func(id string) {
+				mu.Lock()
+				owners[id] = owner
+				mu.Unlock()
+				sessionID = id
+			}
+			w = rec
+		} else {
+			mu.Lock()
+			opener, ok := owners[sessionID]
+			mu.Unlock()
+			if ok && opener.userID != userID {
+				writeErrorResponse(w, http.StatusForbidden, "session belongs to a different user", "FORBIDDEN")
+				return
+			}
+			if ok && opener != owner {
+				writeErrorResponse(w, http.StatusForbidden, "session was opened with a different credential", "FORBIDDEN")
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+
+		if sessionID != "" && transport.closesSession(r, created) {
+			mu.Lock()
+			delete(owners, sessionID)
+			mu.Unlock()
+		}
+	})
+}
+
+// sessionOwner identifies the credential an MCP session was opened with
+type sessionOwner struct {
+	userID    string
+	tokenID   string // personal access token
+	sessionID string // sign-in session of a JWT
+}
+
+func sessionOwnerOf(ctx context.Context, userID string) sessionOwner {
+	req := audit.RequestFromContext(ctx)
+	return sessionOwner{userID: userID, tokenID: req.TokenID, sessionID: req.SessionID}
+}
+
+// sessionRecorder watches a response for the ID of a newly created session
+type sessionRecorder struct {
+	http.ResponseWriter
+	find  func(header http.Header, data []byte) string
+	found func(id string)
+	done  bool
+}
+
+func (rec *sessionRecorder) WriteHeader(statusCode int) {
+	rec.check(nil)
+	rec.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (rec *sessionRecorder) Write(data []byte) (int, error) {
+	rec.check(data)
+	return rec.ResponseWriter.Write(data)
+}
+
+func (rec *sessionRecorder) Flush() {
+	if f, ok := rec.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (rec *sessionRecorder) check(data []byte) {
+	if rec.done {
+		return
+	}
+	if id := rec.find(rec.Header(), data); id != "" {
+		rec.done = true
+		rec.found(id)
+	}
+}
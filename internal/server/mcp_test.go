@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/handlers"
+)
+
+// bearerTransport adds an Authorization header to every request
+type bearerTransport struct {
+	token string
+}
+
+func (t *bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newMCPTestServer(t *testing.T) (*httptest.Server, *Server) {
+	t.Helper()
+
+	mockStorage := handlers.NewMockStorage()
+	mockStorage.SetupTestData()
+	s := NewServerWithAuth(context.Background(), mockStorage, nil)
+
+	ts := httptest.NewServer(s.MCPHandler())
+	t.Cleanup(ts.Close)
+	return ts, s
+}
+
+// generateTestToken signs userID in to s and returns the access token
+func generateTestToken(t *testing.T, s *Server, userID string) string {
+	t.Helper()
+
+	tokens, err := s.sessions.Start(context.Background(), userID, "test")
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+	return tokens.AccessToken
+}
+
+func TestMCPHandler_CallTool(t *testing.T) {
+	ts, s := newMCPTestServer(t)
+	ctx := context.Background()
+
+	transport := mcp.NewStreamableClientTransport(ts.URL, &mcp.StreamableClientTransportOptions{
+		HTTPClient: &http.Client{Transport: &bearerTransport{token: generateTestToken(t, s, "test-user-1")}},
+	})
+	session, err := mcp.NewClient("test", "0.1.0", nil).Connect(ctx, transport)
+	if err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer session.Close()
+
+	result, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "todo_list",
+		Arguments: map[string]any{"status": "todo"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Expected success, got %#v", result.Content)
+	}
+
+	structured, ok := result.StructuredContent.(map[string]any)
+	if !ok {
+		t.Fatalf("Expected structured content, got %#v", result.StructuredContent)
+	}
+	todos, _ := structured["todos"].([]any)
+	if len(todos) != 1 {
+		t.Errorf("Expected 1 todo of test-user-1, got %d", len(todos))
+	}
+}
+
+func TestMCPHandler_RequiresAuth(t *testing.T) {
+	ts, _ := newMCPTestServer(t)
+
+	req, _ := http.NewRequest(http.MethodPost, ts.URL, strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"ping"}`))
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected status 401, got %d", resp.StatusCode)
+	}
+}
+
+func TestMCPHandler_SessionBoundToUser(t *testing.T) {
+	ts, s := newMCPTestServer(t)
+
+	post := func(token, sessionID, body string) *http.Response {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodPost, ts.URL, strings.NewReader(body))
+		req.Header.Set("Accept", "application/json, text/event-stream")
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		if sessionID != "" {
+			req.Header.Set("Mcp-Session-Id", sessionID)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	token := generateTestToken(t, s, "test-user-1")
+	resp := post(token, "",
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test","version":"0.1.0"}}}`)
+	sessionID := resp.Header.Get("Mcp-Session-Id")
+	if sessionID == "" {
+		t.Fatalf("Expected session ID, got status %d", resp.StatusCode)
+	}
+
+	// Another user must not be able to use the session
+	resp = post(generateTestToken(t, s, "test-user-2"), sessionID, `{"jsonrpc":"2.0","id":2,"method":"ping"}`)
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403, got %d", resp.StatusCode)
+	}
+
+	// Nor another credential of the same user, which would run with the
+	// scopes of the session's
+	readOnly, _, err := s.tokens.Create(context.Background(), "test-user-1", "reader", []string{"todos:read"}, 0)
+	if err != nil {
+		t.Fatalf("Failed to create token: %v", err)
+	}
+	resp = post(readOnly, sessionID, `{"jsonrpc":"2.0","id":3,"method":"ping"}`)
+	if resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Expected status 403 for another credential, got %d", resp.StatusCode)
+	}
+
+	resp = post(token, sessionID, `{"jsonrpc":"2.0","id":4,"method":"ping"}`)
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status 200 for the opening credential, got %d", resp.StatusCode)
+	}
+}
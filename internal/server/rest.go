@@ -0,0 +1,592 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/generated/server"
+	"github.com/pankona/memoya/internal/handlers"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/validation"
+)
+
+const (
+	defaultPerPage = 20
+	maxPerPage     = 100
+)
+
+// ListTodosV2 implements GET /v2/todos
+func (s *Server) ListTodosV2(w http.ResponseWriter, r *http.Request, params server.ListTodosV2Params) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	page, perPage, err := pageParams(params.Page, params.PerPage)
+	if err != nil {
+		writeAppError(w, err)
+		return
+	}
+
+	args := handlers.TodoListArgs{Tags: tagFilter(params.Tag)}
+	if params.Status != nil {
+		args.Status = string(*params.Status)
+	}
+	if params.Priority != nil {
+		args.Priority = string(*params.Priority)
+	}
+
+	var result handlers.TodoListResult
+	if err := s.callREST(ctx, "todo_list", args, &result); err != nil {
+		writeAppError(w, err)
+		return
+	}
+
+	todos := result.Todos
+	sort.SliceStable(todos, func(i, j int) bool {
+		return newerFirst(todos[i].CreatedAt.UnixNano(), todos[j].CreatedAt.UnixNano(), todos[i].ID, todos[j].ID)
+	})
+	writePage(w, r, todos, page, perPage, ifNoneMatch(params.IfNoneMatch))
+}
+
+// CreateTodoV2 implements POST /v2/todos
+func (s *Server) CreateTodoV2(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readJSONBody(w, r)
+	if !ok {
+		return
+	}
+
+	var result handlers.TodoResult
+	if err := s.callREST(ctx, "todo_create", body, &result); err != nil {
+		writeAppError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", "/v2/todos/"+url.PathEscape(result.Todo.ID))
+	writeREST(w, http.StatusCreated, result.Todo, "")
+}
+
+// GetTodoV2 implements GET /v2/todos/{id}
+func (s *Server) GetTodoV2(w http.ResponseWriter, r *http.Request, id server.ItemID, params server.GetTodoV2Params) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	todo, err := s.getTodo(ctx, id)
+	if err != nil {
+		writeAppError(w, err)
+		return
+	}
+	writeREST(w, http.StatusOK, todo, ifNoneMatch(params.IfNoneMatch))
+}
+
+// UpdateTodoV2 implements PATCH /v2/todos/{id}
+func (s *Server) UpdateTodoV2(w http.ResponseWriter, r *http.Request, id server.ItemID, params server.UpdateTodoV2Params) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readJSONBody(w, r)
+	if !ok {
+		return
+	}
+	var args handlers.TodoUpdateArgs
+	if err := json.Unmarshal(body, &args); err != nil {
+		writeAppError(w, apperr.Errorf(apperr.Validation, "%w: %w", handlers.ErrInvalidArguments, err))
+		return
+	}
+	args.ID = id
+
+	if params.IfMatch != nil {
+		ctx = ifMatchPrecondition(ctx, *params.IfMatch, "todo")
+	}
+
+	var result handlers.TodoResult
+	if err := s.callREST(ctx, "todo_update", args, &result); err != nil {
+		writeAppError(w, err)
+		return
+	}
+	writeREST(w, http.StatusOK, result.Todo, "")
+}
+
+// DeleteTodoV2 implements DELETE /v2/todos/{id}
+func (s *Server) DeleteTodoV2(w http.ResponseWriter, r *http.Request, id server.ItemID, params server.DeleteTodoV2Params) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	if params.IfMatch != nil {
+		ctx = ifMatchPrecondition(ctx, *params.IfMatch, "todo")
+	}
+
+	var result handlers.DeleteResult
+	if err := s.callREST(ctx, "todo_delete", handlers.TodoDeleteArgs{ID: id}, &result); err != nil {
+		writeAppError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListMemosV2 implements GET /v2/memos
+func (s *Server) ListMemosV2(w http.ResponseWriter, r *http.Request, params server.ListMemosV2Params) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	page, perPage, err := pageParams(params.Page, params.PerPage)
+	if err != nil {
+		writeAppError(w, err)
+		return
+	}
+
+	var result handlers.MemoListResult
+	if err := s.callREST(ctx, "memo_list", handlers.MemoListArgs{Tags: tagFilter(params.Tag)}, &result); err != nil {
+		writeAppError(w, err)
+		return
+	}
+
+	memos := result.Memos
+	sort.SliceStable(memos, func(i, j int) bool {
+		return newerFirst(memos[i].CreatedAt.UnixNano(), memos[j].CreatedAt.UnixNano(), memos[i].ID, memos[j].ID)
+	})
+	writePage(w, r, memos, page, perPage, ifNoneMatch(params.IfNoneMatch))
+}
+
+// CreateMemoV2 implements POST /v2/memos
+func (s *Server) CreateMemoV2(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readJSONBody(w, r)
+	if !ok {
+		return
+	}
+
+	var result handlers.MemoResult
+	if err := s.callREST(ctx, "memo_create", body, &result); err != nil {
+		writeAppError(w, err)
+		return
+	}
+
+	w.Header().Set("Location", "/v2/memos/"+url.PathEscape(result.Memo.ID))
+	writeREST(w, http.StatusCreated, result.Memo, "")
+}
+
+// GetMemoV2 implements GET /v2/memos/{id}
+func (s *Server) GetMemoV2(w http.ResponseWriter, r *http.Request, id server.ItemID, params server.GetMemoV2Params) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	memo, err := s.getMemo(ctx, id)
+	if err != nil {
+		writeAppError(w, err)
+		return
+	}
+	writeREST(w, http.StatusOK, memo, ifNoneMatch(params.IfNoneMatch))
+}
+
+// UpdateMemoV2 implements PATCH /v2/memos/{id}
+func (s *Server) UpdateMemoV2(w http.ResponseWriter, r *http.Request, id server.ItemID, params server.UpdateMemoV2Params) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readJSONBody(w, r)
+	if !ok {
+		return
+	}
+	var args handlers.MemoUpdateArgs
+	if err := json.Unmarshal(body, &args); err != nil {
+		writeAppError(w, apperr.Errorf(apperr.Validation, "%w: %w", handlers.ErrInvalidArguments, err))
+		return
+	}
+	args.ID = id
+
+	if params.IfMatch != nil {
+		ctx = ifMatchPrecondition(ctx, *params.IfMatch, "memo")
+	}
+
+	var result handlers.MemoResult
+	if err := s.callREST(ctx, "memo_update", args, &result); err != nil {
+		writeAppError(w, err)
+		return
+	}
+	writeREST(w, http.StatusOK, result.Memo, "")
+}
+
+// DeleteMemoV2 implements DELETE /v2/memos/{id}
+func (s *Server) DeleteMemoV2(w http.ResponseWriter, r *http.Request, id server.ItemID, params server.DeleteMemoV2Params) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	if params.IfMatch != nil {
+		ctx = ifMatchPrecondition(ctx, *params.IfMatch, "memo")
+	}
+
+	var result handlers.MemoDeleteResult
+	if err := s.callREST(ctx, "memo_delete", handlers.MemoDeleteArgs{ID: id}, &result); err != nil {
+		writeAppError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListTagsV2 implements GET /v2/tags
+func (s *Server) ListTagsV2(w http.ResponseWriter, r *http.Request, params server.ListTagsV2Params) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	var result handlers.TagListResult
+	if err := s.callREST(ctx, "tag_list", handlers.TagListArgs{}, &result); err != nil {
+		writeAppError(w, err)
+		return
+	}
+
+	tags := result.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	sort.Strings(tags)
+	writeREST(w, http.StatusOK, tags, ifNoneMatch(params.IfNoneMatch))
+}
+
+// SearchV2 implements GET /v2/search
+func (s *Server) SearchV2(w http.ResponseWriter, r *http.Request, params server.SearchV2Params) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	args := handlers.SearchArgs{Tags: tagFilter(params.Tag)}
+	if params.Q != nil {
+		args.Query = *params.Q
+	}
+	if params.Type != nil {
+		args.Type = string(*params.Type)
+	}
+
+	var result handlers.SearchResult
+	if err := s.callREST(ctx, "search", args, &result); err != nil {
+		writeAppError(w, err)
+		return
+	}
+
+	items := result.Results
+	if items.Todos == nil {
+		items.Todos = []*models.Todo{}
+	}
+	if items.Memos == nil {
+		items.Memos = []*models.Memo{}
+	}
+	writeREST(w, http.StatusOK, items, ifNoneMatch(params.IfNoneMatch))
+}
+
+// ListActivityV2 implements GET /v2/activity
+func (s *Server) ListActivityV2(w http.ResponseWriter, r *http.Request, params server.ListActivityV2Params) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	page, perPage, err := pageParams(params.Page, params.PerPage)
+	if err != nil {
+		writeAppError(w, err)
+		return
+	}
+
+	// The events are already newest first; page through the latest of them
+	args := handlers.ActivityLogArgs{Limit: handlers.MaxActivityLimit}
+	if params.Action != nil {
+		args.Action = string(*params.Action)
+	}
+	if params.TargetType != nil {
+		args.TargetType = string(*params.TargetType)
+	}
+	if params.TargetId != nil {
+		args.TargetID = *params.TargetId
+	}
+	if params.Tool != nil {
+		args.Tool = *params.Tool
+	}
+	if params.Since != nil {
+		args.Since = params.Since.Format(time.RFC3339Nano)
+	}
+	if params.Until != nil {
+		args.Until = params.Until.Format(time.RFC3339Nano)
+	}
+
+	var result handlers.ActivityLogResult
+	if err := s.callREST(ctx, "activity_log", args, &result); err != nil {
+		writeAppError(w, err)
+		return
+	}
+	writePage(w, r, result.Events, page, perPage, ifNoneMatch(params.IfNoneMatch))
+}
+
+// BatchV2 implements POST /v2/batch
+func (s *Server) BatchV2(w http.ResponseWriter, r *http.Request) {
+	ctx, ok := s.restAuth(w, r)
+	if !ok {
+		return
+	}
+
+	body, ok := readJSONBody(w, r)
+	if !ok {
+		return
+	}
+
+	var result handlers.BatchResult
+	if err := s.callREST(ctx, "batch", body, &result); err != nil {
+		writeAppError(w, err)
+		return
+	}
+	writeREST(w, http.StatusOK, result, "")
+}
+
+// restAuth verifies authentication for a REST endpoint, writing the error
+// response if it fails
+func (s *Server) restAuth(w http.ResponseWriter, r *http.Request) (context.Context, bool) {
+	ctx, _, err := s.verifyAuthAndSetContext(r)
+	if err != nil {
+		writeAppError(w, err)
+		return nil, false
+	}
+	return ctx, true
+}
+
+// callREST calls the named registry tool and decodes its JSON result into out,
+// so the REST endpoints share validation and ownership checks with the tools
+func (s *Server) callREST(ctx context.Context, name string, args any, out any) error {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return fmt.Errorf("failed to marshal arguments: %w", err)
+	}
+
+	result, err := handlers.LookupTool(name).Call(ctx, s.tools, data)
+	if err != nil {
+		return err
+	}
+
+	var text string
+	if len(result.Content) > 0 {
+		if textContent, ok := result.Content[0].(*mcp.TextContent); ok {
+			text = textContent.Text
+		}
+	}
+	if result.IsError {
+		return apperr.Errorf(apperr.Internal, "%s", text)
+	}
+
+	if err := json.Unmarshal([]byte(text), out); err != nil {
+		return fmt.Errorf("failed to decode %s result: %w", name, err)
+	}
+	return nil
+}
+
+func (s *Server) getTodo(ctx context.Context, id string) (*models.Todo, error) {
+	var result handlers.TodoResult
+	if err := s.callREST(ctx, "todo_get", handlers.TodoGetArgs{ID: id}, &result); err != nil {
+		return nil, err
+	}
+	return result.Todo, nil
+}
+
+func (s *Server) getMemo(ctx context.Context, id string) (*models.Memo, error) {
+	var result handlers.MemoResult
+	if err := s.callREST(ctx, "memo_get", handlers.MemoGetArgs{ID: id}, &result); err != nil {
+		return nil, err
+	}
+	return result.Memo, nil
+}
+
+// readJSONBody reads the request body, writing a BAD_REQUEST response if it
+// is not valid JSON
+func readJSONBody(w http.ResponseWriter, r *http.Request) (json.RawMessage, bool) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil || !json.Valid(body) {
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
+		return nil, false
+	}
+	return body, true
+}
+
+// pageParams returns the requested page and page size with defaults applied
+func pageParams(page, perPage *int) (int, int, error) {
+	p, n := 1, defaultPerPage
+	if page != nil {
+		p = *page
+	}
+	if perPage != nil {
+		n = *perPage
+	}
+
+	v := validation.New(validation.Options{})
+	if p < 1 {
+		v.Add("page", "must be at least 1")
+	}
+	if n < 1 || n > maxPerPage {
+		v.Add("per_page", "must be between 1 and %d", maxPerPage)
+	}
+	return p, n, v.Err()
+}
+
+// writePage writes one page of items with Link and X-Total-Count headers
+func writePage[T any](w http.ResponseWriter, r *http.Request, items []T, page, perPage int, ifNoneMatch string) {
+	total := len(items)
+	lastPage := max(1, (total+perPage-1)/perPage)
+
+	start := min((page-1)*perPage, total)
+	end := min(start+perPage, total)
+	pageItems := items[start:end]
+	if pageItems == nil {
+		pageItems = []T{}
+	}
+
+	w.Header().Set("X-Total-Count", strconv.Itoa(total))
+	w.Header().Set("Link", linkHeader(r.URL, page, perPage, lastPage))
+	writeREST(w, http.StatusOK, pageItems, ifNoneMatch)
+}
+
+// linkHeader builds an RFC 8288 Link header pointing at the first, previous,
+// next and last pages
+func linkHeader(u *url.URL, page, perPage, lastPage int) string {
+	link := func(p int, rel string) string {
+		query := u.Query()
+		query.Set("page", strconv.Itoa(p))
+		query.Set("per_page", strconv.Itoa(perPage))
+		return fmt.Sprintf(`<%s?%s>; rel="%s"`, u.Path, query.Encode(), rel)
+	}
+
+	links := []string{link(1, "first")}
+	if page > 1 {
+		links = append(links, link(min(page-1, lastPage), "prev"))
+	}
+	if page < lastPage {
+		links = append(links, link(page+1, "next"))
+	}
+	links = append(links, link(lastPage, "last"))
+	return strings.Join(links, ", ")
+}
+
+// writeREST writes v as JSON with its ETag, or 304 Not Modified if the ETag
+// matches ifNoneMatch
+func writeREST(w http.ResponseWriter, status int, v any, ifNoneMatch string) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, "Failed to encode response", "INTERNAL_ERROR")
+		return
+	}
+
+	tag := etag(data)
+	w.Header().Set("ETag", tag)
+	if ifNoneMatch != "" && etagMatches(ifNoneMatch, tag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+	w.Write([]byte("\n"))
+}
+
+// etag returns a strong entity tag for a JSON representation
+func etag(data []byte) string {
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:8]) + `"`
+}
+
+// etagOf returns the entity tag GET would return for v
+func etagOf(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode %T: %w", v, err)
+	}
+	return etag(data), nil
+}
+
+// etagMatches reports whether an If-Match or If-None-Match header lists tag.
+// Weak tags compare by their opaque value.
+func etagMatches(header, tag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// checkIfMatch fails with PreconditionFailed unless ifMatch lists the ETag of
+// the current representation of item
+func checkIfMatch(ifMatch string, item any, what string) error {
+	tag, err := etagOf(item)
+	if err != nil {
+		return err
+	}
+	if !etagMatches(ifMatch, tag) {
+		return apperr.Errorf(apperr.PreconditionFailed, "%s has been modified", what)
+	}
+	return nil
+}
+
+// ifMatchPrecondition makes the update or delete of ctx check ifMatch
+// against the item in the transaction changing it
+func ifMatchPrecondition(ctx context.Context, ifMatch, what string) context.Context {
+	return handlers.WithPrecondition(ctx, func(item any) error {
+		return checkIfMatch(ifMatch, item, what)
+	})
+}
+
+func ifNoneMatch(header *string) string {
+	if header == nil {
+		return ""
+	}
+	return *header
+}
+
+func tagFilter(tags *server.TagFilter) []string {
+	if tags == nil {
+		return nil
+	}
+	return *tags
+}
+
+// newerFirst orders items by creation time, newest first, then by ID so pages
+// are stable
+func newerFirst(createdI, createdJ int64, idI, idJ string) bool {
+	if createdI != createdJ {
+		return createdI > createdJ
+	}
+	return idI < idJ
+}
@@ -0,0 +1,356 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/pankona/memoya/internal/generated/server"
+	"github.com/pankona/memoya/internal/handlers"
+	"github.com/pankona/memoya/internal/models"
+)
+
+func newRESTTestRouter(t *testing.T) (http.Handler, string, *Server) {
+	t.Helper()
+
+	mockStorage := handlers.NewMockStorage()
+	mockStorage.SetupTestData()
+	s := NewServerWithAuth(context.Background(), mockStorage, nil)
+
+	r := chi.NewRouter()
+	server.HandlerFromMux(s, r)
+	return r, generateTestToken(t, s, "test-user-1"), s
+}
+
+func doREST(t *testing.T, h http.Handler, method, target, token, body string, header map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, reader)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestREST_ListTodosPagination(t *testing.T) {
+	h, token, _ := newRESTTestRouter(t)
+
+	rec := doREST(t, h, http.MethodGet, "/v2/todos?per_page=1", token, "", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var todos []models.Todo
+	if err := json.Unmarshal(rec.Body.Bytes(), &todos); err != nil {
+		t.Fatalf("Failed to decode todos: %v", err)
+	}
+	if len(todos) != 1 {
+		t.Errorf("Expected 1 todo on the page, got %d", len(todos))
+	}
+	if got := rec.Header().Get("X-Total-Count"); got != "2" {
+		t.Errorf("Expected X-Total-Count 2, got %q", got)
+	}
+
+	link := rec.Header().Get("Link")
+	if !strings.Contains(link, `</v2/todos?page=2&per_page=1>; rel="next"`) {
+		t.Errorf("Expected next link, got %q", link)
+	}
+	if strings.Contains(link, `rel="prev"`) {
+		t.Errorf("Expected no prev link on the first page, got %q", link)
+	}
+
+	// Filters are passed to the tool
+	rec = doREST(t, h, http.MethodGet, "/v2/todos?status=in_progress&tag=personal", token, "", nil)
+	if got := rec.Header().Get("X-Total-Count"); got != "1" {
+		t.Errorf("Expected 1 filtered todo, got %q: %s", got, rec.Body.String())
+	}
+
+	tests := []struct {
+		name   string
+		target string
+	}{
+		{"invalid status", "/v2/todos?status=someday"},
+		{"page too small", "/v2/todos?page=0"},
+		{"page size too large", "/v2/todos?per_page=1000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := doREST(t, h, http.MethodGet, tt.target, token, "", nil)
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("Expected 400, got %d: %s", rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestREST_TodoLifecycle(t *testing.T) {
+	h, token, _ := newRESTTestRouter(t)
+
+	rec := doREST(t, h, http.MethodPost, "/v2/todos", token, `{"title":"REST todo","tags":["api"]}`, nil)
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("Expected 201, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var created models.Todo
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("Failed to decode todo: %v", err)
+	}
+	location := rec.Header().Get("Location")
+	if location != "/v2/todos/"+created.ID {
+		t.Errorf("Expected Location of the new todo, got %q", location)
+	}
+
+	rec = doREST(t, h, http.MethodGet, location, token, "", nil)
+	etag := rec.Header().Get("ETag")
+	if rec.Code != http.StatusOK || etag == "" {
+		t.Fatalf("Expected 200 with an ETag, got %d %q", rec.Code, etag)
+	}
+
+	rec = doREST(t, h, http.MethodGet, location, token, "", map[string]string{"If-None-Match": etag})
+	if rec.Code != http.StatusNotModified {
+		t.Errorf("Expected 304, got %d", rec.Code)
+	}
+
+	rec = doREST(t, h, http.MethodPatch, location, token, `{"status":"done"}`, map[string]string{"If-Match": `"stale"`})
+	if rec.Code != http.StatusPreconditionFailed {
+		t.Errorf("Expected 412 for a stale ETag, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = doREST(t, h, http.MethodPatch, location, token, `{"status":"done"}`, map[string]string{"If-Match": etag})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var updated models.Todo
+	json.Unmarshal(rec.Body.Bytes(), &updated)
+	if updated.Status != models.StatusDone || updated.Title != "REST todo" {
+		t.Errorf("Expected only the status to change, got %+v", updated)
+	}
+	if rec.Header().Get("ETag") == etag {
+		t.Error("Expected the ETag to change after an update")
+	}
+
+	rec = doREST(t, h, http.MethodDelete, location, token, "", nil)
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Expected 204, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = doREST(t, h, http.MethodGet, location, token, "", nil)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 after delete, got %d", rec.Code)
+	}
+}
+
+func TestREST_Errors(t *testing.T) {
+	h, token, s := newRESTTestRouter(t)
+	otherToken := generateTestToken(t, s, "test-user-2")
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		token      string
+		body       string
+		wantStatus int
+	}{
+		{"no token", http.MethodGet, "/v2/memos", "", "", http.StatusUnauthorized},
+		{"not found", http.MethodGet, "/v2/memos/missing", token, "", http.StatusNotFound},
+		{"other user's memo", http.MethodGet, "/v2/memos/test-memo-1", otherToken, "", http.StatusForbidden},
+		{"other user's delete", http.MethodDelete, "/v2/todos/test-todo-1", otherToken, "", http.StatusForbidden},
+		{"invalid JSON", http.MethodPost, "/v2/memos", token, `{`, http.StatusBadRequest},
+		{"missing title", http.MethodPost, "/v2/memos", token, `{}`, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := doREST(t, h, tt.method, tt.target, tt.token, tt.body, nil)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestREST_FieldErrors(t *testing.T) {
+	h, token, _ := newRESTTestRouter(t)
+
+	rec := doREST(t, h, http.MethodPost, "/v2/todos", token, `{"title":" ","tags":["work"," "],"parent_id":"missing"}`, nil)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("Expected status 400, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var resp server.Error
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if resp.Code == nil || *resp.Code != "VALIDATION_ERROR" {
+		t.Errorf("Expected code VALIDATION_ERROR, got %s", rec.Body.String())
+	}
+	if resp.Fields == nil {
+		t.Fatalf("Expected field errors, got %s", rec.Body.String())
+	}
+	var fields []string
+	for _, fe := range *resp.Fields {
+		fields = append(fields, fe.Field)
+	}
+	if strings.Join(fields, ",") != "title,tags[1],parent_id" {
+		t.Errorf("Unexpected field errors: %v", fields)
+	}
+
+	rec = doREST(t, h, http.MethodGet, "/v2/todos?page=0&per_page=1000", token, "", nil)
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), `"field":"per_page"`) {
+		t.Errorf("Expected field errors for the page parameters, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestREST_TagsAndSearch(t *testing.T) {
+	h, token, _ := newRESTTestRouter(t)
+
+	rec := doREST(t, h, http.MethodGet, "/v2/tags", token, "", nil)
+	var tags []string
+	if err := json.Unmarshal(rec.Body.Bytes(), &tags); err != nil || len(tags) == 0 {
+		t.Fatalf("Expected tags, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if tags[0] != "ideas" {
+		t.Errorf("Expected tags in alphabetical order, got %v", tags)
+	}
+
+	rec = doREST(t, h, http.MethodGet, "/v2/search?type=memo&tag=work", token, "", nil)
+	var results struct {
+		Todos []models.Todo `json:"todos"`
+		Memos []models.Memo `json:"memos"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &results); err != nil {
+		t.Fatalf("Failed to decode search results: %v", err)
+	}
+	if len(results.Memos) != 1 || len(results.Todos) != 0 {
+		t.Errorf("Expected one work memo, got %+v", results)
+	}
+}
+
+func TestREST_Activity(t *testing.T) {
+	mockStorage := handlers.NewMockStorage()
+	mockStorage.SetupTestData()
+	s := NewServerWithAuth(context.Background(), mockStorage, nil)
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	server.HandlerFromMux(s, r)
+
+	raw, accessToken, err := s.tokens.Create(context.Background(), "test-user-1", "ci", []string{"admin"}, 0)
+	if err != nil {
+		t.Fatalf("Failed to create access token: %v", err)
+	}
+
+	header := map[string]string{"User-Agent": "ci-script/2.0", "X-Request-Id": "req-42"}
+	rec := doREST(t, r, http.MethodPatch, "/v2/todos/test-todo-1", raw, `{"status":"in_progress"}`, header)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = doREST(t, r, http.MethodGet, "/v2/activity?action=todo.update&target_type=todo", raw, "", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var events []models.AuditEvent
+	if err := json.Unmarshal(rec.Body.Bytes(), &events); err != nil {
+		t.Fatalf("Failed to decode events: %v", err)
+	}
+	if len(events) != 1 || rec.Header().Get("X-Total-Count") != "1" {
+		t.Fatalf("Expected one update event, got %s", rec.Body.String())
+	}
+	event := events[0]
+	if event.TargetID != "test-todo-1" || event.Tool != "todo_update" {
+		t.Errorf("Unexpected target of the event: %+v", event)
+	}
+	if event.TokenID != accessToken.ID || event.Client != "ci-script/2.0" || event.RequestID != "req-42" {
+		t.Errorf("Unexpected sender of the event: %+v", event)
+	}
+	if len(event.Changes) != 1 || event.Changes[0] != (models.FieldChange{Field: "status", From: "todo", To: "in_progress"}) {
+		t.Errorf("Unexpected changes: %+v", event.Changes)
+	}
+
+	// Reading the log needs the admin scope
+	readOnly, _, err := s.tokens.Create(context.Background(), "test-user-1", "reader", []string{"todos:read", "memos:read"}, 0)
+	if err != nil {
+		t.Fatalf("Failed to create access token: %v", err)
+	}
+	if rec := doREST(t, r, http.MethodGet, "/v2/activity", readOnly, "", nil); rec.Code != http.StatusForbidden {
+		t.Errorf("Expected 403 without the admin scope, got %d", rec.Code)
+	}
+
+	rec = doREST(t, r, http.MethodGet, "/v2/activity?since=yesterday", raw, "", nil)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for a malformed time, got %d", rec.Code)
+	}
+}
+
+func TestREST_Batch(t *testing.T) {
+	h, token, s := newRESTTestRouter(t)
+
+	body := `{"operations":[
+		{"op":"create","kind":"todo","ref":"parent","title":"Parent"},
+		{"op":"create","kind":"todo","title":"Child","parent_id":"$parent"},
+		{"op":"update","kind":"memo","id":"test-memo-1","linked_todos":["$parent"]}
+	]}`
+	rec := doREST(t, h, http.MethodPost, "/v2/batch", token, body, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var result handlers.BatchResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to decode result: %v", err)
+	}
+	if !result.Success || result.Applied != 3 {
+		t.Fatalf("Expected every operation to be applied, got %s", rec.Body.String())
+	}
+	if child := result.Results[1].Todo; child == nil || child.ParentID != result.Results[0].ID {
+		t.Errorf("Expected the child of the created todo, got %+v", child)
+	}
+
+	// Batches change todos and memos, so they need both write scopes
+	todosOnly, _, err := s.tokens.Create(context.Background(), "test-user-1", "todos", []string{"todos:write"}, 0)
+	if err != nil {
+		t.Fatalf("Failed to create access token: %v", err)
+	}
+	if rec := doREST(t, h, http.MethodPost, "/v2/batch", todosOnly, body, nil); rec.Code != http.StatusForbidden {
+		t.Errorf("Expected 403 without the memos:write scope, got %d", rec.Code)
+	}
+
+	rec = doREST(t, h, http.MethodPost, "/v2/batch", token, `{"operations":[{"op":"move","kind":"todo"}]}`, nil)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for an unknown op, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestETagMatches(t *testing.T) {
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{`"abc"`, true},
+		{`W/"abc"`, true},
+		{`"xyz", "abc"`, true},
+		{`*`, true},
+		{`"xyz"`, false},
+	}
+
+	for _, tt := range tests {
+		if got := etagMatches(tt.header, `"abc"`); got != tt.want {
+			t.Errorf("etagMatches(%q) = %v, want %v", tt.header, got, tt.want)
+		}
+	}
+}
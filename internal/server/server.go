@@ -3,28 +3,45 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"math"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
+	"github.com/go-chi/chi/v5/middleware"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/pankona/memoya/internal/apperr"
+	"github.com/pankona/memoya/internal/audit"
 	"github.com/pankona/memoya/internal/auth"
 	"github.com/pankona/memoya/internal/config"
 	"github.com/pankona/memoya/internal/generated/server"
 	"github.com/pankona/memoya/internal/handlers"
+	"github.com/pankona/memoya/internal/models"
+	"github.com/pankona/memoya/internal/ratelimit"
 	"github.com/pankona/memoya/internal/storage"
+	"github.com/pankona/memoya/internal/validation"
 )
 
 // Server implements the generated ServerInterface
 type Server struct {
 	storage           storage.Storage
-	memoHandler       *handlers.MemoHandler
-	todoHandler       *handlers.TodoHandler
-	searchHandler     *handlers.SearchHandler
-	tagHandler        *handlers.TagHandler
+	tools             *handlers.Handlers
+	tokens            *auth.TokenService
+	sessions          *auth.SessionService
+	identities        *auth.IdentityService
+	promptHandler     *handlers.PromptHandler
+	completionHandler *handlers.CompletionHandler
+	changes           *changeHub
 	deviceFlowService *auth.DeviceFlowService
+	quota             *handlers.Quota
+	userLimiter       *ratelimit.Limiter // Requests per user
+	authLimiter       *ratelimit.Limiter // Unauthenticated auth requests per IP address
+	audit             *audit.Recorder
 }
 
 // NewServer creates a new server instance
@@ -35,39 +52,68 @@ func NewServer(ctx context.Context, storage storage.Storage) *Server {
 		projectID = os.Getenv("FIREBASE_PROJECT_ID")
 	}
 
-	// Get OAuth credentials from environment variables or Secret Manager
-	credentials, err := config.GetOAuthCredentials(ctx, projectID)
+	// Get identity provider settings from environment variables or Secret Manager
+	providerConfigs, err := config.GetIdentityProviders(ctx, projectID)
 	if err != nil {
-		log.Fatalf("OAuth configuration required: %v", err)
+		log.Fatalf("Identity provider configuration required: %v", err)
+	}
+	providers, err := auth.NewIdentityProviders(ctx, providerConfigs)
+	if err != nil {
+		log.Fatalf("Failed to set up identity providers: %v", err)
 	}
 
 	// Create device flow service
-	deviceFlowService := auth.NewDeviceFlowService(storage, credentials.ClientID, credentials.ClientSecret)
+	deviceFlowService := auth.NewDeviceFlowService(storage, providers...)
 
-	return &Server{
-		storage:           storage,
-		memoHandler:       handlers.NewMemoHandlerWithStorage(storage),
-		todoHandler:       handlers.NewTodoHandlerWithStorage(storage),
-		searchHandler:     handlers.NewSearchHandler(storage),
-		tagHandler:        handlers.NewTagHandler(storage),
-		deviceFlowService: deviceFlowService,
-	}
+	return NewServerWithAuth(ctx, storage, deviceFlowService)
 }
 
 // NewServerWithAuth creates a new server instance with provided auth service
 func NewServerWithAuth(ctx context.Context, storage storage.Storage, deviceFlowService *auth.DeviceFlowService) *Server {
-	return &Server{
+	limits := config.DefaultLimits()
+	tokens := auth.NewTokenService(storage)
+	s := &Server{
 		storage:           storage,
-		memoHandler:       handlers.NewMemoHandlerWithStorage(storage),
-		todoHandler:       handlers.NewTodoHandlerWithStorage(storage),
-		searchHandler:     handlers.NewSearchHandler(storage),
-		tagHandler:        handlers.NewTagHandler(storage),
+		tokens:            tokens,
+		sessions:          auth.NewSessionService(storage),
+		identities:        auth.NewIdentityService(storage),
+		promptHandler:     handlers.NewPromptHandler(storage),
+		completionHandler: handlers.NewCompletionHandler(storage),
+		changes:           newChangeHub(storageWatcher(storage), storage),
 		deviceFlowService: deviceFlowService,
+		quota:             handlers.NewQuota(storage, limits.Quota),
+		userLimiter:       ratelimit.New(limits.RatePerMinute, limits.RateBurst),
+		authLimiter:       ratelimit.New(limits.AuthRatePerMinute, limits.AuthRateBurst),
+		audit:             audit.NewRecorder(storage),
 	}
+	s.tools = newToolHandlers(storage, tokens, s.quota, s.rateLimitStatus, s.audit)
+	return s
+}
+
+// SetValidation sets how todo and memo arguments are normalized. Call it
+// before serving requests.
+func (s *Server) SetValidation(opts validation.Options) {
+	s.tools.Todo.SetValidation(opts)
+	s.tools.Memo.SetValidation(opts)
+	s.tools.Batch.SetValidation(opts)
+	s.tools.Search.SetValidation(opts)
 }
 
-// verifyAuthAndSetContext verifies JWT token and returns context with user ID
+// verifyAuthAndSetContext verifies JWT token and returns context with user ID.
+// Users sending requests faster than their rate limit get a RateLimited error.
 func (s *Server) verifyAuthAndSetContext(r *http.Request) (context.Context, string, error) {
+	ctx, userID, err := s.authenticate(r)
+	if err != nil {
+		return nil, "", apperr.Errorf(apperr.Unauthorized, "%w", err)
+	}
+	if ok, wait := s.userLimiter.Allow(userID); !ok {
+		return nil, "", apperr.Errorf(apperr.RateLimited, "rate limit of %d requests per minute exceeded", s.userLimiter.PerMinute()).WithRetryAfter(wait)
+	}
+	return ctx, userID, nil
+}
+
+// authenticate verifies the JWT or personal access token of r
+func (s *Server) authenticate(r *http.Request) (context.Context, string, error) {
 	authHeader := r.Header.Get("Authorization")
 	if authHeader == "" {
 		return nil, "", fmt.Errorf("authentication required")
@@ -79,15 +125,37 @@ func (s *Server) verifyAuthAndSetContext(r *http.Request) (context.Context, stri
 		token = authHeader[7:]
 	}
 
-	// Verify JWT token
-	userID, err := auth.ValidateJWT(token)
+	// Personal access tokens are limited to their scopes
+	if auth.IsAccessToken(token) {
+		accessToken, err := s.tokens.Authenticate(r.Context(), token)
+		if err != nil {
+			return nil, "", fmt.Errorf("authentication required: %w", err)
+		}
+		ctx := context.WithValue(r.Context(), auth.UserContextKey("user_id"), accessToken.UserID)
+		ctx = audit.WithRequest(ctx, auditRequest(r, accessToken.ID, ""))
+		return auth.WithScopes(ctx, auth.ScopesOf(accessToken)), accessToken.UserID, nil
+	}
+
+	// Verify JWT token and that its session has not been signed out
+	claims, err := s.sessions.Authenticate(r.Context(), token)
 	if err != nil {
-		return nil, "", fmt.Errorf("authentication required")
+		return nil, "", fmt.Errorf("authentication required: %w", err)
 	}
 
 	// Set user context for the handler
-	ctx := context.WithValue(r.Context(), auth.UserContextKey("user_id"), userID)
-	return ctx, userID, nil
+	ctx := context.WithValue(r.Context(), auth.UserContextKey("user_id"), claims.UserID)
+	ctx = audit.WithRequest(ctx, auditRequest(r, "", claims.SessionID))
+	return auth.WithSession(ctx, claims.SessionID), claims.UserID, nil
+}
+
+// auditRequest identifies the sender of r in the audit log
+func auditRequest(r *http.Request, tokenID, sessionID string) audit.Request {
+	return audit.Request{
+		TokenID:   tokenID,
+		SessionID: sessionID,
+		Client:    r.UserAgent(),
+		RequestID: middleware.GetReqID(r.Context()),
+	}
 }
 
 // verifyAuth verifies the JWT token from Authorization header and returns user ID
@@ -98,82 +166,62 @@ func (s *Server) verifyAuth(r *http.Request) (string, error) {
 
 // writeErrorResponse writes an error response
 func writeErrorResponse(w http.ResponseWriter, statusCode int, message, code string) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
+	writeError(w, statusCode, errorResponse(message, code))
+}
 
+func errorResponse(message, code string) server.Error {
 	successFlag := false
-	errorResp := server.Error{
+	return server.Error{
 		Success: &successFlag,
 		Error:   &message,
 		Code:    &code,
 	}
+}
 
+func writeError(w http.ResponseWriter, statusCode int, errorResp server.Error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(errorResp)
 }
 
+// writeAppError writes err with the HTTP status and code of its apperr kind,
+// listing the invalid arguments of validation errors
+func writeAppError(w http.ResponseWriter, err error) {
+	kind := apperr.KindOf(err)
+	if retryAfter := apperr.RetryAfterOf(err); retryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+
+	errorResp := errorResponse(err.Error(), string(kind))
+	var fieldErrors validation.Errors
+	if errors.As(err, &fieldErrors) {
+		fields := make([]server.FieldError, len(fieldErrors))
+		for i, fe := range fieldErrors {
+			fields[i] = server.FieldError{Field: fe.Field, Message: fe.Message}
+		}
+		errorResp.Fields = &fields
+	}
+	writeError(w, apperr.HTTPStatus(kind), errorResp)
+}
+
 // writeSuccessResponse writes the MCP handler result as JSON
 func writeSuccessResponse(w http.ResponseWriter, result interface{}) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 
-	// Extract JSON from TextContent using type switch
 	switch r := result.(type) {
-	case *mcp.CallToolResultFor[handlers.MemoResult]:
-		if len(r.Content) > 0 {
-			if textContent, ok := r.Content[0].(*mcp.TextContent); ok {
-				w.Write([]byte(textContent.Text))
-				return nil
-			}
-		}
-	case *mcp.CallToolResultFor[handlers.MemoListResult]:
-		if len(r.Content) > 0 {
-			if textContent, ok := r.Content[0].(*mcp.TextContent); ok {
-				w.Write([]byte(textContent.Text))
-				return nil
-			}
-		}
-	case *mcp.CallToolResultFor[handlers.MemoDeleteResult]:
-		if len(r.Content) > 0 {
-			if textContent, ok := r.Content[0].(*mcp.TextContent); ok {
-				w.Write([]byte(textContent.Text))
-				return nil
-			}
-		}
-	case *mcp.CallToolResultFor[handlers.TodoResult]:
-		if len(r.Content) > 0 {
-			if textContent, ok := r.Content[0].(*mcp.TextContent); ok {
-				w.Write([]byte(textContent.Text))
-				return nil
-			}
-		}
-	case *mcp.CallToolResultFor[handlers.TodoListResult]:
-		if len(r.Content) > 0 {
-			if textContent, ok := r.Content[0].(*mcp.TextContent); ok {
-				w.Write([]byte(textContent.Text))
-				return nil
-			}
-		}
-	case *mcp.CallToolResultFor[handlers.DeleteResult]:
-		if len(r.Content) > 0 {
-			if textContent, ok := r.Content[0].(*mcp.TextContent); ok {
-				w.Write([]byte(textContent.Text))
-				return nil
-			}
-		}
-	case *mcp.CallToolResultFor[handlers.SearchResult]:
-		if len(r.Content) > 0 {
-			if textContent, ok := r.Content[0].(*mcp.TextContent); ok {
-				w.Write([]byte(textContent.Text))
-				return nil
-			}
-		}
-	case *mcp.CallToolResultFor[handlers.TagListResult]:
+	case *mcp.CallToolResult:
+		// Tool handlers return their result as JSON text
 		if len(r.Content) > 0 {
 			if textContent, ok := r.Content[0].(*mcp.TextContent); ok {
 				w.Write([]byte(textContent.Text))
 				return nil
 			}
 		}
+	case *mcp.GetPromptResult:
+		return json.NewEncoder(w).Encode(r)
+	case *mcp.CompleteResult:
+		return json.NewEncoder(w).Encode(r)
 	}
 
 	return fmt.Errorf("invalid response format")
@@ -191,99 +239,100 @@ func (s *Server) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// GetJWKS implements GET /.well-known/jwks.json
+func (s *Server) GetJWKS(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	// Short enough that verifiers pick up a scheduled key before it signs
+	w.Header().Set("Cache-Control", "public, max-age=900")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(auth.SigningKeys().JWKS())
+}
+
 // CreateMemo implements POST /mcp/memo_create
 func (s *Server) CreateMemo(w http.ResponseWriter, r *http.Request) {
-	// Verify authentication and get context
-	ctx, _, err := s.verifyAuthAndSetContext(r)
-	if err != nil {
-		writeErrorResponse(w, http.StatusUnauthorized, err.Error(), "UNAUTHORIZED")
-		return
-	}
+	s.serveTool(w, r, "memo_create")
+}
 
-	var req server.MemoCreateRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
-		return
-	}
+// ListMemos implements POST /mcp/memo_list
+func (s *Server) ListMemos(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, "memo_list")
+}
 
-	// Convert to MCP handler arguments
-	args := handlers.MemoCreateArgs{
-		Title:       req.Title,
-		Description: getStringValue(req.Description),
-		Tags:        getStringSliceValue(req.Tags),
-		LinkedTodos: getStringSliceValue(req.LinkedTodos),
-	}
+// GetMemo implements POST /mcp/memo_get
+func (s *Server) GetMemo(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, "memo_get")
+}
 
-	params := &mcp.CallToolParamsFor[handlers.MemoCreateArgs]{Arguments: args}
-	result, err := s.memoHandler.Create(ctx, nil, params)
-	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
-		return
-	}
+// UpdateMemo implements POST /mcp/memo_update
+func (s *Server) UpdateMemo(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, "memo_update")
+}
 
-	if err := writeSuccessResponse(w, result); err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, "Failed to encode response", "INTERNAL_ERROR")
-	}
+// DeleteMemo implements POST /mcp/memo_delete
+func (s *Server) DeleteMemo(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, "memo_delete")
 }
 
-// ListMemos implements POST /mcp/memo_list
-func (s *Server) ListMemos(w http.ResponseWriter, r *http.Request) {
-	// Verify authentication and get context
-	ctx, _, err := s.verifyAuthAndSetContext(r)
-	if err != nil {
-		writeErrorResponse(w, http.StatusUnauthorized, err.Error(), "UNAUTHORIZED")
-		return
-	}
+// CreateTodo implements POST /mcp/todo_create
+func (s *Server) CreateTodo(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, "todo_create")
+}
 
-	var req server.MemoListRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
-		return
-	}
+// ListTodos implements POST /mcp/todo_list
+func (s *Server) ListTodos(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, "todo_list")
+}
 
-	args := handlers.MemoListArgs{
-		Tags: getStringSliceValue(req.Tags),
-	}
+// GetTodo implements POST /mcp/todo_get
+func (s *Server) GetTodo(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, "todo_get")
+}
 
-	params := &mcp.CallToolParamsFor[handlers.MemoListArgs]{Arguments: args}
-	result, err := s.memoHandler.List(ctx, nil, params)
-	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
-		return
-	}
+// UpdateTodo implements POST /mcp/todo_update
+func (s *Server) UpdateTodo(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, "todo_update")
+}
 
-	if err := writeSuccessResponse(w, result); err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, "Failed to encode response", "INTERNAL_ERROR")
-	}
+// DeleteTodo implements POST /mcp/todo_delete
+func (s *Server) DeleteTodo(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, "todo_delete")
 }
 
-// UpdateMemo implements POST /mcp/memo_update
-func (s *Server) UpdateMemo(w http.ResponseWriter, r *http.Request) {
+// Search implements POST /mcp/search
+func (s *Server) Search(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, "search")
+}
+
+// ListTags implements POST /mcp/tag_list
+func (s *Server) ListTags(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, "tag_list")
+}
+
+// GetPrompt implements POST /mcp/prompt_get
+func (s *Server) GetPrompt(w http.ResponseWriter, r *http.Request) {
 	// Verify authentication and get context
 	ctx, _, err := s.verifyAuthAndSetContext(r)
 	if err != nil {
-		writeErrorResponse(w, http.StatusUnauthorized, err.Error(), "UNAUTHORIZED")
+		writeAppError(w, err)
 		return
 	}
 
-	var req server.MemoUpdateRequest
+	var req server.PromptGetRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
 		return
 	}
 
-	args := handlers.MemoUpdateArgs{
-		ID:          req.Id,
-		Title:       getStringValue(req.Title),
-		Description: getStringValue(req.Description),
-		Tags:        getStringSliceValue(req.Tags),
-		LinkedTodos: getStringSliceValue(req.LinkedTodos),
+	params := &mcp.GetPromptParams{
+		Name: string(req.Name),
+	}
+	if req.Arguments != nil {
+		params.Arguments = *req.Arguments
 	}
 
-	params := &mcp.CallToolParamsFor[handlers.MemoUpdateArgs]{Arguments: args}
-	result, err := s.memoHandler.Update(ctx, nil, params)
+	result, err := s.promptHandler.Get(ctx, nil, params)
 	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
+		writeAppError(w, err)
 		return
 	}
 
@@ -292,29 +341,39 @@ func (s *Server) UpdateMemo(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteMemo implements POST /mcp/memo_delete
-func (s *Server) DeleteMemo(w http.ResponseWriter, r *http.Request) {
+// CompleteArgument implements POST /mcp/complete
+func (s *Server) CompleteArgument(w http.ResponseWriter, r *http.Request) {
 	// Verify authentication and get context
 	ctx, _, err := s.verifyAuthAndSetContext(r)
 	if err != nil {
-		writeErrorResponse(w, http.StatusUnauthorized, err.Error(), "UNAUTHORIZED")
+		writeAppError(w, err)
 		return
 	}
 
-	var req server.MemoDeleteRequest
+	var req server.CompleteRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
 		return
 	}
 
-	args := handlers.MemoDeleteArgs{
-		ID: req.Id,
+	params := &mcp.CompleteParams{
+		Ref: &mcp.CompleteReference{
+			Type: string(req.Ref.Type),
+			Name: getStringValue(req.Ref.Name),
+			URI:  getStringValue(req.Ref.Uri),
+		},
+		Argument: mcp.CompleteParamsArgument{
+			Name:  req.Argument.Name,
+			Value: req.Argument.Value,
+		},
+	}
+	if req.Context != nil && req.Context.Arguments != nil {
+		params.Context = &mcp.CompleteContext{Arguments: *req.Context.Arguments}
 	}
 
-	params := &mcp.CallToolParamsFor[handlers.MemoDeleteArgs]{Arguments: args}
-	result, err := s.memoHandler.Delete(ctx, nil, params)
+	result, err := s.completionHandler.Complete(ctx, nil, params)
 	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
+		writeAppError(w, err)
 		return
 	}
 
@@ -323,354 +382,396 @@ func (s *Server) DeleteMemo(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateTodo implements POST /mcp/todo_create
-func (s *Server) CreateTodo(w http.ResponseWriter, r *http.Request) {
+// WaitChanges implements POST /mcp/changes
+func (s *Server) WaitChanges(w http.ResponseWriter, r *http.Request) {
 	// Verify authentication and get context
-	ctx, _, err := s.verifyAuthAndSetContext(r)
+	ctx, userID, err := s.verifyAuthAndSetContext(r)
 	if err != nil {
-		writeErrorResponse(w, http.StatusUnauthorized, err.Error(), "UNAUTHORIZED")
+		writeAppError(w, err)
+		return
+	}
+
+	if err := auth.RequireScope(ctx, auth.ScopeTodosRead, auth.ScopeMemosRead); err != nil {
+		writeAppError(w, err)
 		return
 	}
 
-	var req server.TodoCreateRequest
+	var req server.ChangesRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
 		return
 	}
 
-	args := handlers.TodoCreateArgs{
-		Title:       req.Title,
-		Description: getStringValue(req.Description),
-		Status:      getStatusValue(req.Status),
-		Priority:    getPriorityValue(req.Priority),
-		Tags:        getStringSliceValue(req.Tags),
-		ParentID:    getStringValue(req.ParentId),
+	timeout := 25
+	if req.TimeoutSeconds != nil {
+		timeout = *req.TimeoutSeconds
 	}
-
-	params := &mcp.CallToolParamsFor[handlers.TodoCreateArgs]{Arguments: args}
-	result, err := s.todoHandler.Create(ctx, nil, params)
-	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
+	if timeout < 1 || timeout > 50 {
+		writeErrorResponse(w, http.StatusBadRequest, "timeout_seconds must be between 1 and 50", "BAD_REQUEST")
 		return
 	}
 
-	if err := writeSuccessResponse(w, result); err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, "Failed to encode response", "INTERNAL_ERROR")
-	}
-}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
 
-// ListTodos implements POST /mcp/todo_list
-func (s *Server) ListTodos(w http.ResponseWriter, r *http.Request) {
-	// Verify authentication and get context
-	ctx, _, err := s.verifyAuthAndSetContext(r)
+	changes, cursor, reset, err := s.changes.Wait(ctx, userID, getStringValue(req.Cursor))
 	if err != nil {
-		writeErrorResponse(w, http.StatusUnauthorized, err.Error(), "UNAUTHORIZED")
+		writeAppError(w, err)
 		return
 	}
-
-	var req server.TodoListRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
-		return
+	if changes == nil {
+		changes = []*models.Change{}
 	}
 
-	args := handlers.TodoListArgs{
-		Status:   getListStatusValue(req.Status),
-		Priority: getListPriorityValue(req.Priority),
-		Tags:     getStringSliceValue(req.Tags),
-	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"changes": changes,
+		"cursor":  cursor,
+		"reset":   reset,
+	})
+}
 
-	params := &mcp.CallToolParamsFor[handlers.TodoListArgs]{Arguments: args}
-	result, err := s.todoHandler.List(ctx, nil, params)
-	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
-		return
+// Helper functions to handle optional values
+func getStringValue(ptr *string) string {
+	if ptr == nil {
+		return ""
 	}
+	return *ptr
+}
 
-	if err := writeSuccessResponse(w, result); err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, "Failed to encode response", "INTERNAL_ERROR")
+func getBoolValue(ptr *bool) bool {
+	if ptr == nil {
+		return false
 	}
+	return *ptr
 }
 
-// UpdateTodo implements POST /mcp/todo_update
-func (s *Server) UpdateTodo(w http.ResponseWriter, r *http.Request) {
-	// Verify authentication and get context
-	ctx, _, err := s.verifyAuthAndSetContext(r)
-	if err != nil {
-		writeErrorResponse(w, http.StatusUnauthorized, err.Error(), "UNAUTHORIZED")
+// Authentication endpoints - using deviceFlowService directly
+func (s *Server) StartDeviceAuth(w http.ResponseWriter, r *http.Request) {
+	if !s.allowAuthRequest(w, r) {
 		return
 	}
 
-	var req server.TodoUpdateRequest
+	var req server.DeviceAuthStartRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
 		return
 	}
 
-	args := handlers.TodoUpdateArgs{
-		ID:          req.Id,
-		Title:       getStringValue(req.Title),
-		Description: getStringValue(req.Description),
-		Status:      getUpdateStatusValue(req.Status),
-		Priority:    getUpdatePriorityValue(req.Priority),
-		Tags:        getStringSliceValue(req.Tags),
+	// Linking adds an identity to the calling user
+	linkUserID := ""
+	if getBoolValue(req.Link) {
+		_, userID, ok := s.sessionAuth(w, r)
+		if !ok {
+			return
+		}
+		linkUserID = userID
 	}
 
-	params := &mcp.CallToolParamsFor[handlers.TodoUpdateArgs]{Arguments: args}
-	result, err := s.todoHandler.Update(ctx, nil, params)
+	result, err := s.deviceFlowService.StartDeviceFlow(r.Context(), getStringValue(req.Provider), linkUserID)
 	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
+		writeAppError(w, err)
 		return
 	}
 
-	if err := writeSuccessResponse(w, result); err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, "Failed to encode response", "INTERNAL_ERROR")
-	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"device_code":      result.DeviceCode,
+			"user_code":        result.UserCode,
+			"verification_uri": result.VerificationURI,
+			"provider":         result.Provider,
+			"expires_in":       int(result.ExpiresAt.Sub(result.CreatedAt).Seconds()),
+			"interval":         result.Interval,
+		},
+		"message": "Device flow started successfully",
+	})
 }
 
-// DeleteTodo implements POST /mcp/todo_delete
-func (s *Server) DeleteTodo(w http.ResponseWriter, r *http.Request) {
-	// Verify authentication and get context
-	ctx, _, err := s.verifyAuthAndSetContext(r)
-	if err != nil {
-		writeErrorResponse(w, http.StatusUnauthorized, err.Error(), "UNAUTHORIZED")
+func (s *Server) PollDeviceAuth(w http.ResponseWriter, r *http.Request) {
+	if !s.allowAuthRequest(w, r) {
 		return
 	}
 
-	var req server.TodoDeleteRequest
+	var req server.DeviceAuthPollRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
 		return
 	}
 
-	args := handlers.TodoDeleteArgs{
-		ID: req.Id,
+	user, err := s.deviceFlowService.PollToken(r.Context(), req.DeviceCode)
+	if err != nil {
+		writeAppError(w, err)
+		return
 	}
 
-	params := &mcp.CallToolParamsFor[handlers.TodoDeleteArgs]{Arguments: args}
-	result, err := s.todoHandler.Delete(ctx, nil, params)
+	tokens, err := s.sessions.Start(r.Context(), user.ID, r.UserAgent())
 	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
+		writeAppError(w, err)
 		return
 	}
 
-	if err := writeSuccessResponse(w, result); err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, "Failed to encode response", "INTERNAL_ERROR")
-	}
+	s.audit.Record(audit.WithRequest(r.Context(), auditRequest(r, "", tokens.SessionID)), &models.AuditEvent{
+		UserID:     user.ID,
+		Action:     models.AuditSignIn,
+		TargetType: "session",
+		TargetID:   tokens.SessionID,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"user":          user,
+			"access_token":  tokens.AccessToken,
+			"refresh_token": tokens.RefreshToken,
+			"expires_in":    tokens.ExpiresIn,
+			"token_type":    "Bearer",
+		},
+		"message": "Poll completed successfully",
+	})
 }
 
-// Search implements POST /mcp/search
-func (s *Server) Search(w http.ResponseWriter, r *http.Request) {
-	// Verify authentication and get context
-	ctx, _, err := s.verifyAuthAndSetContext(r)
-	if err != nil {
-		writeErrorResponse(w, http.StatusUnauthorized, err.Error(), "UNAUTHORIZED")
+// RefreshAuth implements POST /auth/refresh
+func (s *Server) RefreshAuth(w http.ResponseWriter, r *http.Request) {
+	if !s.allowAuthRequest(w, r) {
 		return
 	}
 
-	var req server.SearchRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
+	var req server.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RefreshToken == "" {
+		writeErrorResponse(w, http.StatusBadRequest, "refresh_token is required", "BAD_REQUEST")
 		return
 	}
 
-	args := handlers.SearchArgs{
-		Query: getStringValue(req.Query),
-		Tags:  getStringSliceValue(req.Tags),
-		Type:  getSearchTypeValue(req.Type),
-	}
-
-	params := &mcp.CallToolParamsFor[handlers.SearchArgs]{Arguments: args}
-	result, err := s.searchHandler.Search(ctx, nil, params)
+	tokens, err := s.sessions.Refresh(r.Context(), req.RefreshToken)
 	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
+		writeAppError(w, err)
 		return
 	}
 
-	if err := writeSuccessResponse(w, result); err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, "Failed to encode response", "INTERNAL_ERROR")
-	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data": map[string]interface{}{
+			"access_token":  tokens.AccessToken,
+			"refresh_token": tokens.RefreshToken,
+			"expires_in":    tokens.ExpiresIn,
+			"token_type":    "Bearer",
+		},
+		"message": "Tokens refreshed successfully",
+	})
 }
 
-// ListTags implements POST /mcp/tag_list
-func (s *Server) ListTags(w http.ResponseWriter, r *http.Request) {
-	// Verify authentication and get context
-	ctx, _, err := s.verifyAuthAndSetContext(r)
+// sessionAuth authenticates a session management request. Personal access
+// tokens need the admin scope.
+func (s *Server) sessionAuth(w http.ResponseWriter, r *http.Request) (context.Context, string, bool) {
+	ctx, userID, err := s.verifyAuthAndSetContext(r)
 	if err != nil {
-		writeErrorResponse(w, http.StatusUnauthorized, err.Error(), "UNAUTHORIZED")
-		return
+		writeAppError(w, err)
+		return nil, "", false
 	}
+	if err := auth.RequireScope(ctx, auth.ScopeAdmin); err != nil {
+		writeAppError(w, err)
+		return nil, "", false
+	}
+	return ctx, userID, true
+}
 
-	var req server.TagListRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
+// ListSessions implements GET /auth/sessions
+func (s *Server) ListSessions(w http.ResponseWriter, r *http.Request) {
+	ctx, userID, ok := s.sessionAuth(w, r)
+	if !ok {
 		return
 	}
 
-	args := handlers.TagListArgs{}
-
-	params := &mcp.CallToolParamsFor[handlers.TagListArgs]{Arguments: args}
-	result, err := s.tagHandler.List(ctx, nil, params)
+	sessions, err := s.sessions.List(ctx, userID)
 	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
+		writeAppError(w, err)
 		return
 	}
 
-	if err := writeSuccessResponse(w, result); err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, "Failed to encode response", "INTERNAL_ERROR")
+	current := auth.SessionFromContext(ctx)
+	data := make([]handlers.AuthSession, 0, len(sessions))
+	for _, session := range sessions {
+		data = append(data, handlers.AuthSession{Session: *session, Current: session.ID == current})
 	}
-}
 
-// Helper functions to handle optional values
-func getStringValue(ptr *string) string {
-	if ptr == nil {
-		return ""
-	}
-	return *ptr
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"data":    data,
+		"message": fmt.Sprintf("Found %d sessions", len(data)),
+	})
 }
 
-func getStringSliceValue(ptr *[]string) []string {
-	if ptr == nil {
-		return []string{}
+// Logout implements POST /auth/logout
+func (s *Server) Logout(w http.ResponseWriter, r *http.Request) {
+	ctx, userID, ok := s.sessionAuth(w, r)
+	if !ok {
+		return
 	}
-	return *ptr
-}
 
-// Helper functions for generated enum types
-func getStatusValue(ptr *server.TodoCreateRequestStatus) string {
-	if ptr == nil {
-		return ""
+	// The body is optional
+	var req server.SessionRevokeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
+		return
 	}
-	return string(*ptr)
-}
 
-func getPriorityValue(ptr *server.TodoCreateRequestPriority) string {
-	if ptr == nil {
-		return ""
+	current := auth.SessionFromContext(ctx)
+	sessionID := current
+	if req.SessionId != nil && *req.SessionId != "" {
+		sessionID = *req.SessionId
 	}
-	return string(*ptr)
-}
-
-func getListStatusValue(ptr *server.TodoListRequestStatus) string {
-	if ptr == nil {
-		return ""
+	if sessionID == "" {
+		writeErrorResponse(w, http.StatusBadRequest, "session_id is required when signing out with a personal access token", "BAD_REQUEST")
+		return
 	}
-	return string(*ptr)
-}
 
-func getListPriorityValue(ptr *server.TodoListRequestPriority) string {
-	if ptr == nil {
-		return ""
+	if err := s.sessions.Revoke(ctx, userID, sessionID); err != nil {
+		writeAppError(w, err)
+		return
 	}
-	return string(*ptr)
-}
 
-func getUpdateStatusValue(ptr *server.TodoUpdateRequestStatus) string {
-	if ptr == nil {
-		return ""
-	}
-	return string(*ptr)
-}
+	s.audit.Record(ctx, &models.AuditEvent{
+		UserID:     userID,
+		Action:     models.AuditSignOut,
+		TargetType: "session",
+		TargetID:   sessionID,
+	})
 
-func getUpdatePriorityValue(ptr *server.TodoUpdateRequestPriority) string {
-	if ptr == nil {
-		return ""
-	}
-	return string(*ptr)
+	writeSessionRevokeResponse(w, 1, sessionID == current, "Signed out")
 }
 
-func getSearchTypeValue(ptr *server.SearchRequestType) string {
-	if ptr == nil {
-		return ""
+// LogoutAll implements POST /auth/logout_all
+func (s *Server) LogoutAll(w http.ResponseWriter, r *http.Request) {
+	ctx, userID, ok := s.sessionAuth(w, r)
+	if !ok {
+		return
 	}
-	return string(*ptr)
-}
 
-func getBoolValue(ptr *bool) bool {
-	if ptr == nil {
-		return false
+	revoked, err := s.sessions.RevokeAll(ctx, userID)
+	if err != nil {
+		writeAppError(w, err)
+		return
 	}
-	return *ptr
+
+	// Signing out everywhere is about the user rather than one session
+	s.audit.Record(ctx, &models.AuditEvent{
+		UserID:     userID,
+		Action:     models.AuditSignOut,
+		TargetType: "user",
+		TargetID:   userID,
+	})
+
+	writeSessionRevokeResponse(w, revoked, auth.SessionFromContext(ctx) != "", fmt.Sprintf("Signed out %d sessions", revoked))
 }
 
-// Authentication endpoints - using deviceFlowService directly
-func (s *Server) StartDeviceAuth(w http.ResponseWriter, r *http.Request) {
-	var req server.DeviceAuthStartRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
+// ListIdentities implements GET /auth/identities
+func (s *Server) ListIdentities(w http.ResponseWriter, r *http.Request) {
+	ctx, userID, ok := s.sessionAuth(w, r)
+	if !ok {
 		return
 	}
 
-	result, err := s.deviceFlowService.StartDeviceFlow(r.Context())
+	identities, err := s.identities.List(ctx, userID)
 	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
+		writeAppError(w, err)
 		return
 	}
+	if identities == nil {
+		identities = []*models.Identity{}
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"success": true,
-		"data": map[string]interface{}{
-			"device_code":      result.DeviceCode,
-			"user_code":        result.UserCode,
-			"verification_uri": result.VerificationURI,
-			"expires_in":       int(result.ExpiresAt.Sub(result.CreatedAt).Seconds()),
-		},
-		"message": "Device flow started successfully",
+		"data":    identities,
+		"message": fmt.Sprintf("Found %d identities", len(identities)),
 	})
 }
 
-func (s *Server) PollDeviceAuth(w http.ResponseWriter, r *http.Request) {
-	var req server.DeviceAuthPollRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
+// UnlinkIdentity implements DELETE /auth/identities/{id}
+func (s *Server) UnlinkIdentity(w http.ResponseWriter, r *http.Request, id string) {
+	ctx, userID, ok := s.sessionAuth(w, r)
+	if !ok {
 		return
 	}
 
-	user, token, err := s.deviceFlowService.PollToken(r.Context(), req.DeviceCode)
-	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
+	if err := s.identities.Unlink(ctx, userID, id); err != nil {
+		writeAppError(w, err)
 		return
 	}
 
+	s.audit.Record(ctx, &models.AuditEvent{
+		UserID:     userID,
+		Action:     models.AuditIdentityUnlink,
+		TargetType: "identity",
+		TargetID:   id,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"success": true,
+		"message": "Identity unlinked",
+	})
+}
+
+func writeSessionRevokeResponse(w http.ResponseWriter, revoked int, current bool, message string) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"success": true,
 		"data": map[string]interface{}{
-			"user":         user,
-			"access_token": token,
+			"revoked": revoked,
+			"current": current,
 		},
-		"message": "Poll completed successfully",
+		"message": message,
 	})
 }
 
-func (s *Server) GetUserInfo(w http.ResponseWriter, r *http.Request) {
-	// Get JWT token from Authorization header
-	authHeader := r.Header.Get("Authorization")
-	if authHeader == "" {
-		writeErrorResponse(w, http.StatusUnauthorized, "Authorization header required", "UNAUTHORIZED")
-		return
-	}
+// ListAccessTokens implements GET /auth/tokens
+func (s *Server) ListAccessTokens(w http.ResponseWriter, r *http.Request) {
+	s.serveToolArgs(w, r, "token_list", []byte("{}"))
+}
 
-	// Extract token from "Bearer <token>" format
-	jwtToken := authHeader
-	if len(authHeader) > 7 && authHeader[:7] == "Bearer " {
-		jwtToken = authHeader[7:]
+// CreateAccessToken implements POST /auth/tokens
+func (s *Server) CreateAccessToken(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, "token_create")
+}
+
+// RevokeAccessToken implements DELETE /auth/tokens/{id}
+func (s *Server) RevokeAccessToken(w http.ResponseWriter, r *http.Request, id string) {
+	args, err := json.Marshal(handlers.TokenRevokeArgs{ID: id})
+	if err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, "Failed to encode arguments", "INTERNAL_ERROR")
+		return
 	}
+	s.serveToolArgs(w, r, "token_revoke", args)
+}
 
-	// Verify JWT and get user ID
-	userID, err := auth.ValidateJWT(jwtToken)
+func (s *Server) GetUserInfo(w http.ResponseWriter, r *http.Request) {
+	// Verify authentication (JWT or personal access token)
+	_, userID, err := s.verifyAuthAndSetContext(r)
 	if err != nil {
-		writeErrorResponse(w, http.StatusUnauthorized, "Invalid token", "UNAUTHORIZED")
+		writeAppError(w, err)
 		return
 	}
 
 	// Get user from storage
 	user, err := s.storage.GetUser(r.Context(), userID)
 	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
+		writeAppError(w, err)
 		return
 	}
 
@@ -695,32 +796,32 @@ func (s *Server) DeleteAccount(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Get JWT token from Authorization header
-	authHeader := r.Header.Get("Authorization")
-	if authHeader == "" {
-		writeErrorResponse(w, http.StatusUnauthorized, "Authorization header required", "UNAUTHORIZED")
+	// Verify authentication (JWT or personal access token)
+	ctx, userID, err := s.verifyAuthAndSetContext(r)
+	if err != nil {
+		writeAppError(w, err)
 		return
 	}
-
-	jwtToken := authHeader
-	if len(authHeader) > 7 && authHeader[:7] == "Bearer " {
-		jwtToken = authHeader[7:]
-	}
-
-	// Verify JWT and get user ID
-	userID, err := auth.ValidateJWT(jwtToken)
-	if err != nil {
-		writeErrorResponse(w, http.StatusUnauthorized, "Invalid token", "UNAUTHORIZED")
+	if err := auth.RequireScope(ctx, auth.ScopeAdmin); err != nil {
+		writeAppError(w, err)
 		return
 	}
 
 	// Delete user account
-	err = s.storage.DeleteUser(r.Context(), userID)
+	err = s.storage.DeleteUser(ctx, userID)
 	if err != nil {
-		writeErrorResponse(w, http.StatusInternalServerError, err.Error(), "INTERNAL_ERROR")
+		writeAppError(w, err)
 		return
 	}
 
+	// The audit log is kept after the account is deleted
+	s.audit.Record(ctx, &models.AuditEvent{
+		UserID:     userID,
+		Action:     models.AuditAccountDelete,
+		TargetType: "user",
+		TargetID:   userID,
+	})
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	json.NewEncoder(w).Encode(map[string]interface{}{
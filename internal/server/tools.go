@@ -0,0 +1,90 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pankona/memoya/internal/audit"
+	"github.com/pankona/memoya/internal/auth"
+	"github.com/pankona/memoya/internal/handlers"
+	"github.com/pankona/memoya/internal/storage"
+)
+
+// newToolHandlers creates the handlers of the tools served by memoya-server
+func newToolHandlers(storage storage.Storage, tokens *auth.TokenService, quota *handlers.Quota, rateLimit func(userID string) handlers.RateLimitStatus, recorder *audit.Recorder) *handlers.Handlers {
+	journal := handlers.NewJournal(storage, recorder)
+	memo := handlers.NewMemoHandlerWithStorage(storage)
+	memo.SetQuota(quota)
+	memo.SetAudit(recorder)
+	memo.SetJournal(journal)
+	todo := handlers.NewTodoHandlerWithStorage(storage)
+	todo.SetQuota(quota)
+	todo.SetAudit(recorder)
+	todo.SetJournal(journal)
+	batch := handlers.NewBatchHandler(storage)
+	batch.SetQuota(quota)
+	batch.SetAudit(recorder)
+	batch.SetJournal(journal)
+	token := handlers.NewTokenHandler(tokens)
+	token.SetAudit(recorder)
+
+	return &handlers.Handlers{
+		Memo:     memo,
+		Todo:     todo,
+		Search:   handlers.NewSearchHandler(storage),
+		Tag:      handlers.NewTagHandler(storage),
+		Token:    token,
+		Quota:    handlers.NewQuotaHandler(quota, rateLimit),
+		Activity: handlers.NewActivityHandler(storage),
+		Journal:  handlers.NewJournalHandler(journal),
+		Batch:    batch,
+	}
+}
+
+// CallTool implements POST /mcp/{tool} for registry tools without a
+// dedicated endpoint in the OpenAPI spec
+func (s *Server) CallTool(w http.ResponseWriter, r *http.Request) {
+	s.serveTool(w, r, strings.TrimPrefix(r.URL.Path, "/mcp/"))
+}
+
+// serveTool calls the named registry tool with the JSON request body as arguments
+func (s *Server) serveTool(w http.ResponseWriter, r *http.Request, name string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil || !json.Valid(body) {
+		writeErrorResponse(w, http.StatusBadRequest, "Invalid JSON format", "BAD_REQUEST")
+		return
+	}
+	s.serveToolArgs(w, r, name, body)
+}
+
+// serveToolArgs calls the named registry tool with JSON arguments
+func (s *Server) serveToolArgs(w http.ResponseWriter, r *http.Request, name string, args []byte) {
+	tool := handlers.LookupTool(name)
+	if tool == nil || tool.Local {
+		writeErrorResponse(w, http.StatusNotFound, "Unknown tool: "+name, "NOT_FOUND")
+		return
+	}
+
+	ctx := r.Context()
+	if tool.RequiresAuth {
+		// Verify authentication and get context
+		authCtx, _, err := s.verifyAuthAndSetContext(r)
+		if err != nil {
+			writeAppError(w, err)
+			return
+		}
+		ctx = authCtx
+	}
+
+	result, err := tool.Call(ctx, s.tools, args)
+	if err != nil {
+		writeAppError(w, err)
+		return
+	}
+
+	if err := writeSuccessResponse(w, result); err != nil {
+		writeErrorResponse(w, http.StatusInternalServerError, "Failed to encode response", "INTERNAL_ERROR")
+	}
+}
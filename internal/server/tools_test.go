@@ -0,0 +1,313 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pankona/memoya/internal/handlers"
+)
+
+func TestServer_CallTool(t *testing.T) {
+	mockStorage := handlers.NewMockStorage()
+	mockStorage.SetupTestData()
+	s := NewServerWithAuth(context.Background(), mockStorage, nil)
+	token := generateTestToken(t, s, "test-user-1")
+
+	tests := []struct {
+		name       string
+		tool       string
+		token      string
+		body       string
+		wantStatus int
+	}{
+		{"success", "todo_list", token, `{"status":"todo"}`, http.StatusOK},
+		{"no token", "todo_list", "", `{}`, http.StatusUnauthorized},
+		{"invalid JSON", "todo_list", token, `{`, http.StatusBadRequest},
+		{"invalid arguments", "todo_list", token, `{"priority":"urgent"}`, http.StatusBadRequest},
+		{"unknown tool", "unknown", token, `{}`, http.StatusNotFound},
+		{"local tool", "auth_start", token, `{}`, http.StatusNotFound},
+		{"item not found", "todo_get", token, `{"id":"missing"}`, http.StatusNotFound},
+		{"other user's item", "todo_get", generateTestToken(t, s, "test-user-2"), `{"id":"test-todo-1"}`, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/mcp/"+tt.tool, strings.NewReader(tt.body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+
+			s.CallTool(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestServer_AccessTokens(t *testing.T) {
+	mockStorage := handlers.NewMockStorage()
+	mockStorage.SetupTestData()
+	s := NewServerWithAuth(context.Background(), mockStorage, nil)
+	jwt := generateTestToken(t, s, "test-user-1")
+
+	call := func(handler http.HandlerFunc, method, target, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec
+	}
+
+	rec := call(s.CreateAccessToken, http.MethodPost, "/auth/tokens", jwt, `{"name":"ci","scopes":["todos:read"]}`)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var created handlers.TokenCreateResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	pat := created.Token
+
+	tests := []struct {
+		name       string
+		handler    http.HandlerFunc
+		method     string
+		target     string
+		token      string
+		body       string
+		wantStatus int
+	}{
+		{"granted scope", s.CallTool, http.MethodPost, "/mcp/todo_list", pat, `{}`, http.StatusOK},
+		{"missing scope", s.CallTool, http.MethodPost, "/mcp/todo_create", pat, `{"title":"x"}`, http.StatusForbidden},
+		{"memos not granted", s.CallTool, http.MethodPost, "/mcp/memo_list", pat, `{}`, http.StatusForbidden},
+		{"token management needs admin", s.CreateAccessToken, http.MethodPost, "/auth/tokens", pat, `{"name":"x","scopes":["admin"]}`, http.StatusForbidden},
+		{"account deletion needs admin", s.DeleteAccount, http.MethodPost, "/auth/delete_account", pat, `{"confirm":true}`, http.StatusForbidden},
+		{"user info", s.GetUserInfo, http.MethodGet, "/auth/user", pat, ``, http.StatusOK},
+		{"unknown token", s.CallTool, http.MethodPost, "/mcp/todo_list", "mpat_unknown", `{}`, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := call(tt.handler, tt.method, tt.target, tt.token, tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+
+	rec = call(s.ListAccessTokens, http.MethodGet, "/auth/tokens", jwt, ``)
+	if !strings.Contains(rec.Body.String(), `"name":"ci"`) || strings.Contains(rec.Body.String(), pat) {
+		t.Errorf("Expected token listed without its value, got %s", rec.Body.String())
+	}
+
+	rec = call(func(w http.ResponseWriter, r *http.Request) {
+		s.RevokeAccessToken(w, r, created.AccessToken.ID)
+	}, http.MethodDelete, "/auth/tokens/"+created.AccessToken.ID, jwt, ``)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	rec = call(s.CallTool, http.MethodPost, "/mcp/todo_list", pat, `{}`)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected revoked token to be rejected, got %d", rec.Code)
+	}
+}
+
+func TestServer_RefreshAuth(t *testing.T) {
+	mockStorage := handlers.NewMockStorage()
+	mockStorage.SetupTestData()
+	s := NewServerWithAuth(context.Background(), mockStorage, nil)
+
+	tokens, err := s.sessions.Start(context.Background(), "test-user-1", "test")
+	if err != nil {
+		t.Fatalf("Failed to start session: %v", err)
+	}
+	first := tokens.RefreshToken
+
+	refresh := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/auth/refresh", strings.NewReader(`{"refresh_token":"`+token+`"}`))
+		rec := httptest.NewRecorder()
+		s.RefreshAuth(rec, req)
+		return rec
+	}
+
+	rec := refresh(first)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	var resp struct {
+		Data struct {
+			AccessToken  string `json:"access_token"`
+			RefreshToken string `json:"refresh_token"`
+			ExpiresIn    int    `json:"expires_in"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if resp.Data.RefreshToken == "" || resp.Data.RefreshToken == first || resp.Data.ExpiresIn != 3600 {
+		t.Fatalf("Expected a rotated refresh token, got %+v", resp.Data)
+	}
+
+	// The new access token works
+	req := httptest.NewRequest(http.MethodPost, "/mcp/todo_list", strings.NewReader(`{}`))
+	req.Header.Set("Authorization", "Bearer "+resp.Data.AccessToken)
+	rec = httptest.NewRecorder()
+	s.CallTool(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected refreshed access token to work, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	// Replaying the first token revokes the family, including its successor
+	if rec := refresh(first); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected reused token to be rejected, got %d", rec.Code)
+	}
+	if rec := refresh(resp.Data.RefreshToken); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected successor of a reused token to be revoked, got %d", rec.Code)
+	}
+
+	if rec := refresh("mrt_unknown"); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected unknown token to be rejected, got %d", rec.Code)
+	}
+	if rec := refresh(""); rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected missing token to be rejected, got %d", rec.Code)
+	}
+}
+
+func TestServer_Sessions(t *testing.T) {
+	mockStorage := handlers.NewMockStorage()
+	mockStorage.SetupTestData()
+	s := NewServerWithAuth(context.Background(), mockStorage, nil)
+
+	laptop := generateTestToken(t, s, "test-user-1")
+	phone := generateTestToken(t, s, "test-user-1")
+	tablet := generateTestToken(t, s, "test-user-1")
+	other := generateTestToken(t, s, "test-user-2")
+
+	call := func(handler http.HandlerFunc, token, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/mcp/todo_list", strings.NewReader(body))
+		req.Header.Set("Authorization", "Bearer "+token)
+		rec := httptest.NewRecorder()
+		handler(rec, req)
+		return rec
+	}
+
+	rec := call(s.ListSessions, laptop, ``)
+	var list struct {
+		Data []handlers.AuthSession `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if len(list.Data) != 3 {
+		t.Fatalf("Expected 3 sessions, got %d: %s", len(list.Data), rec.Body.String())
+	}
+	var current, phoneSession string
+	for _, session := range list.Data {
+		if session.Current {
+			current = session.ID
+		}
+	}
+	if current == "" {
+		t.Error("Expected the calling session to be marked current")
+	}
+
+	// Find the phone's session from its own listing
+	rec = call(s.ListSessions, phone, ``)
+	json.Unmarshal(rec.Body.Bytes(), &list)
+	for _, session := range list.Data {
+		if session.Current {
+			phoneSession = session.ID
+		}
+	}
+
+	if rec := call(s.Logout, other, `{"session_id":"`+phoneSession+`"}`); rec.Code != http.StatusForbidden {
+		t.Errorf("Expected 403 for another user's session, got %d", rec.Code)
+	}
+
+	// Sign the phone out from the laptop
+	rec = call(s.Logout, laptop, `{"session_id":"`+phoneSession+`"}`)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"current":false`) {
+		t.Fatalf("Expected the phone to be signed out, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec := call(s.CallTool, phone, `{}`); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected the phone's token to be revoked, got %d", rec.Code)
+	}
+
+	// Sign the laptop out
+	rec = call(s.Logout, laptop, ``)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"current":true`) {
+		t.Fatalf("Expected the laptop to be signed out, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec := call(s.ListSessions, laptop, ``); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected the laptop's token to be revoked, got %d", rec.Code)
+	}
+
+	rec = call(s.LogoutAll, tablet, ``)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"revoked":1`) {
+		t.Fatalf("Expected the last session to be signed out, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if rec := call(s.ListSessions, tablet, ``); rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected the tablet's token to be revoked, got %d", rec.Code)
+	}
+	if rec := call(s.ListSessions, other, ``); rec.Code != http.StatusOK {
+		t.Errorf("Expected other users to stay signed in, got %d", rec.Code)
+	}
+}
+
+func TestServer_DeleteAccountRevokesTokens(t *testing.T) {
+	mockStorage := handlers.NewMockStorage()
+	mockStorage.SetupTestData()
+	s := NewServerWithAuth(context.Background(), mockStorage, nil)
+	token := generateTestToken(t, s, "test-user-1")
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/delete_account", strings.NewReader(`{"confirm":true}`))
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	s.DeleteAccount(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/mcp/todo_list", strings.NewReader(`{}`))
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec = httptest.NewRecorder()
+	s.CallTool(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected the token of a deleted account to be rejected, got %d", rec.Code)
+	}
+}
+
+func TestServer_GetJWKS(t *testing.T) {
+	s := NewServerWithAuth(context.Background(), handlers.NewMockStorage(), nil)
+
+	rec := httptest.NewRecorder()
+	s.GetJWKS(rec, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+
+	var jwks struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Alg string `json:"alg"`
+			D   string `json:"d"`
+		} `json:"keys"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &jwks); err != nil {
+		t.Fatalf("Failed to parse JWKS: %v", err)
+	}
+	if len(jwks.Keys) != 1 || jwks.Keys[0].Kid == "" || jwks.Keys[0].Alg != "EdDSA" {
+		t.Errorf("Expected the signing key, got %s", rec.Body.String())
+	}
+	if jwks.Keys[0].D != "" {
+		t.Error("Expected no private key material")
+	}
+}
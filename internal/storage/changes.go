@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pankona/memoya/internal/models"
+)
+
+// ChangeWatcher is implemented by storages that can push changes as they
+// happen (e.g. Firestore snapshot listeners)
+type ChangeWatcher interface {
+	// WatchChanges sends every change to the user's todos and memos made after
+	// the call to changes, and blocks until ctx is done or watching fails.
+	WatchChanges(ctx context.Context, userID string, changes chan<- *models.Change) error
+}
+
+// WatchChanges streams the user's changes to changes. Storages implementing
+// ChangeWatcher are used directly; any other storage is polled every interval.
+func WatchChanges(ctx context.Context, s Storage, userID string, interval time.Duration, changes chan<- *models.Change) error {
+	if w, ok := s.(ChangeWatcher); ok {
+		return w.WatchChanges(ctx, userID, changes)
+	}
+	return pollChanges(ctx, s, userID, interval, changes)
+}
+
+type changeKey struct {
+	kind models.ChangeKind
+	id   string
+}
+
+// pollChanges diffs snapshots of the user's todos and memos
+func pollChanges(ctx context.Context, s Storage, userID string, interval time.Duration, changes chan<- *models.Change) error {
+	prev, err := snapshot(ctx, s, userID)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		curr, err := snapshot(ctx, s, userID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		var diff []*models.Change
+		for key, modified := range curr {
+			before, ok := prev[key]
+			switch {
+			case !ok:
+				diff = append(diff, &models.Change{Kind: key.kind, ID: key.id, Op: models.ChangeOpCreated, At: modified})
+			case !before.Equal(modified):
+				diff = append(diff, &models.Change{Kind: key.kind, ID: key.id, Op: models.ChangeOpUpdated, At: modified})
+			}
+		}
+		for key := range prev {
+			if _, ok := curr[key]; !ok {
+				diff = append(diff, &models.Change{Kind: key.kind, ID: key.id, Op: models.ChangeOpDeleted, At: now})
+			}
+		}
+		prev = curr
+
+		for _, change := range diff {
+			select {
+			case changes <- change:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// snapshot returns the last modification time of every todo and memo of the user
+func snapshot(ctx context.Context, s Storage, userID string) (map[changeKey]time.Time, error) {
+	todos, err := s.ListTodos(ctx, TodoFilters{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	memos, err := s.ListMemos(ctx, MemoFilters{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[changeKey]time.Time, len(todos)+len(memos))
+	for _, todo := range todos {
+		result[changeKey{models.ChangeKindTodo, todo.ID}] = todo.LastModified
+	}
+	for _, memo := range memos {
+		result[changeKey{models.ChangeKindMemo, memo.ID}] = memo.LastModified
+	}
+	return result, nil
+}
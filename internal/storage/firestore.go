@@ -2,12 +2,18 @@ package storage
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"cloud.google.com/go/firestore"
+	"cloud.google.com/go/firestore/apiv1/firestorepb"
 	firebase "firebase.google.com/go/v4"
+	"github.com/pankona/memoya/internal/apperr"
 	"github.com/pankona/memoya/internal/models"
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // FirestoreStorage implements the Storage interface using Firestore
@@ -34,6 +40,14 @@ func NewFirestoreStorage(ctx context.Context, projectID string) (*FirestoreStora
 	}, nil
 }
 
+// notFound reports missing documents (and empty query results) as apperr.NotFound
+func notFound(err error, what string) error {
+	if err == iterator.Done || status.Code(err) == codes.NotFound {
+		return apperr.Errorf(apperr.NotFound, "%s not found", what)
+	}
+	return err
+}
+
 // Close closes the Firestore client
 func (fs *FirestoreStorage) Close() error {
 	return fs.client.Close()
@@ -48,7 +62,7 @@ func (fs *FirestoreStorage) CreateUser(ctx context.Context, user *models.User) e
 func (fs *FirestoreStorage) GetUser(ctx context.Context, id string) (*models.User, error) {
 	doc, err := fs.client.Collection("users").Doc(id).Get(ctx)
 	if err != nil {
-		return nil, err
+		return nil, notFound(err, "user")
 	}
 
 	var user models.User
@@ -65,7 +79,7 @@ func (fs *FirestoreStorage) GetUserByGoogleID(ctx context.Context, googleID stri
 
 	doc, err := iter.Next()
 	if err != nil {
-		return nil, err
+		return nil, notFound(err, "user")
 	}
 
 	var user models.User
@@ -82,44 +96,106 @@ func (fs *FirestoreStorage) UpdateUser(ctx context.Context, user *models.User) e
 }
 
 func (fs *FirestoreStorage) DeleteUser(ctx context.Context, id string) error {
-	// Delete all user data including memos and todos
-	batch := fs.client.Batch()
-
-	// Delete user document
+	// Delete all user data including memos and todos. A user can have more
+	// documents than a batch can write, so they are deleted in batches, the
+	// user document last.
 	userDoc := fs.client.Collection("users").Doc(id)
-	batch.Delete(userDoc)
+	queries := []firestore.Query{
+		userDoc.Collection("memos").Query,
+		userDoc.Collection("todos").Query,
+		fs.client.Collection("access_tokens").Where("user_id", "==", id),
+		fs.client.Collection("refresh_tokens").Where("user_id", "==", id),
+		fs.client.Collection("sessions").Where("user_id", "==", id),
+		fs.client.Collection("identities").Where("user_id", "==", id),
+	}
+
+	deleter := fs.newBatchDeleter()
+	for _, query := range queries {
+		if err := deleter.deleteAll(ctx, query); err != nil {
+			return err
+		}
+	}
 
-	// Delete user's memos
-	memoIter := fs.client.Collection("users").Doc(id).Collection("memos").Documents(ctx)
+	// Journal entries keep their steps in a collection of their own
+	journalIter := userDoc.Collection("journal").Documents(ctx)
+	defer journalIter.Stop()
 	for {
-		doc, err := memoIter.Next()
+		doc, err := journalIter.Next()
 		if err == iterator.Done {
 			break
 		}
 		if err != nil {
 			return err
 		}
-		batch.Delete(doc.Ref)
+		if err := deleter.deleteAll(ctx, doc.Ref.Collection("journal_steps").Query); err != nil {
+			return err
+		}
+		if err := deleter.delete(ctx, doc.Ref); err != nil {
+			return err
+		}
 	}
-	memoIter.Stop()
 
-	// Delete user's todos
-	todoIter := fs.client.Collection("users").Doc(id).Collection("todos").Documents(ctx)
+	if err := deleter.delete(ctx, userDoc); err != nil {
+		return err
+	}
+	return deleter.commit(ctx)
+}
+
+// firestoreBatchLimit is the most writes a Firestore batch can hold
+const firestoreBatchLimit = 500
+
+// batchDeleter deletes documents in batches, committing each once full
+type batchDeleter struct {
+	fs      *FirestoreStorage
+	batch   *firestore.WriteBatch
+	pending int
+	deleted int
+}
+
+func (fs *FirestoreStorage) newBatchDeleter() *batchDeleter {
+	return &batchDeleter{fs: fs, batch: fs.client.Batch()}
+}
+
+// deleteAll deletes the documents of query
+func (d *batchDeleter) deleteAll(ctx context.Context, query firestore.Query) error {
+	iter := query.Documents(ctx)
+	defer iter.Stop()
+
 	for {
-		doc, err := todoIter.Next()
+		doc, err := iter.Next()
 		if err == iterator.Done {
-			break
+			return nil
 		}
 		if err != nil {
 			return err
 		}
-		batch.Delete(doc.Ref)
+		if err := d.delete(ctx, doc.Ref); err != nil {
+			return err
+		}
 	}
-	todoIter.Stop()
+}
 
-	// Commit batch
-	_, err := batch.Commit(ctx)
-	return err
+func (d *batchDeleter) delete(ctx context.Context, ref *firestore.DocumentRef) error {
+	d.batch.Delete(ref)
+	d.pending++
+	if d.pending >= firestoreBatchLimit {
+		return d.commit(ctx)
+	}
+	return nil
+}
+
+// commit commits the pending deletions
+func (d *batchDeleter) commit(ctx context.Context) error {
+	if d.pending == 0 {
+		return nil
+	}
+	if _, err := d.batch.Commit(ctx); err != nil {
+		return err
+	}
+	d.deleted += d.pending
+	d.batch = d.fs.client.Batch()
+	d.pending = 0
+	return nil
 }
 
 // Device auth operations
@@ -131,7 +207,7 @@ func (fs *FirestoreStorage) CreateDeviceAuthSession(ctx context.Context, session
 func (fs *FirestoreStorage) GetDeviceAuthSession(ctx context.Context, deviceCode string) (*models.DeviceAuthSession, error) {
 	doc, err := fs.client.Collection("device_auth_sessions").Doc(deviceCode).Get(ctx)
 	if err != nil {
-		return nil, err
+		return nil, notFound(err, "device auth session")
 	}
 
 	var session models.DeviceAuthSession
@@ -142,16 +218,562 @@ func (fs *FirestoreStorage) GetDeviceAuthSession(ctx context.Context, deviceCode
 	return &session, nil
 }
 
+// UpdateDeviceAuthSession replaces an existing session. A consumed session
+// is not recreated.
 func (fs *FirestoreStorage) UpdateDeviceAuthSession(ctx context.Context, session *models.DeviceAuthSession) error {
-	_, err := fs.client.Collection("device_auth_sessions").Doc(session.DeviceCode).Set(ctx, session)
-	return err
+	doc := fs.client.Collection("device_auth_sessions").Doc(session.DeviceCode)
+	return fs.client.RunTransaction(ctx, func(ctx context.Context, t *firestore.Transaction) error {
+		if _, err := t.Get(doc); err != nil {
+			return notFound(err, "device auth session")
+		}
+		return t.Set(doc, session)
+	})
 }
 
+// DeleteDeviceAuthSession deletes a session, failing with NotFound if it was
+// already deleted
 func (fs *FirestoreStorage) DeleteDeviceAuthSession(ctx context.Context, deviceCode string) error {
-	_, err := fs.client.Collection("device_auth_sessions").Doc(deviceCode).Delete(ctx)
+	_, err := fs.client.Collection("device_auth_sessions").Doc(deviceCode).Delete(ctx, firestore.Exists)
+	return notFound(err, "device auth session")
+}
+
+// DeleteExpiredDeviceAuthSessions deletes expired sessions in batches. A TTL
+// policy on device_auth_sessions.delete_at deletes them too, but Firestore
+// only guarantees to do so within a day or so.
+func (fs *FirestoreStorage) DeleteExpiredDeviceAuthSessions(ctx context.Context, before time.Time) (int, error) {
+	deleter := fs.newBatchDeleter()
+	if err := deleter.deleteAll(ctx, fs.client.Collection("device_auth_sessions").Where("expires_at", "<", before)); err != nil {
+		return deleter.deleted, err
+	}
+	err := deleter.commit(ctx)
+	return deleter.deleted, err
+}
+
+// Personal access token operations
+func (fs *FirestoreStorage) CreateAccessToken(ctx context.Context, token *models.AccessToken) error {
+	_, err := fs.client.Collection("access_tokens").Doc(token.ID).Set(ctx, token)
+	return err
+}
+
+func (fs *FirestoreStorage) GetAccessToken(ctx context.Context, id string) (*models.AccessToken, error) {
+	doc, err := fs.client.Collection("access_tokens").Doc(id).Get(ctx)
+	if err != nil {
+		return nil, notFound(err, "access token")
+	}
+
+	var token models.AccessToken
+	if err := doc.DataTo(&token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (fs *FirestoreStorage) GetAccessTokenByHash(ctx context.Context, tokenHash string) (*models.AccessToken, error) {
+	iter := fs.client.Collection("access_tokens").Where("token_hash", "==", tokenHash).Documents(ctx)
+	defer iter.Stop()
+
+	doc, err := iter.Next()
+	if err != nil {
+		return nil, notFound(err, "access token")
+	}
+
+	var token models.AccessToken
+	if err := doc.DataTo(&token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (fs *FirestoreStorage) UpdateAccessToken(ctx context.Context, token *models.AccessToken) error {
+	_, err := fs.client.Collection("access_tokens").Doc(token.ID).Set(ctx, token)
+	return err
+}
+
+func (fs *FirestoreStorage) DeleteAccessToken(ctx context.Context, id string) error {
+	_, err := fs.client.Collection("access_tokens").Doc(id).Delete(ctx)
+	return err
+}
+
+func (fs *FirestoreStorage) ListAccessTokens(ctx context.Context, userID string) ([]*models.AccessToken, error) {
+	iter := fs.client.Collection("access_tokens").Where("user_id", "==", userID).Documents(ctx)
+	defer iter.Stop()
+
+	var tokens []*models.AccessToken
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var token models.AccessToken
+		if err := doc.DataTo(&token); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, &token)
+	}
+
+	return tokens, nil
+}
+
+// Refresh token operations
+func (fs *FirestoreStorage) CreateRefreshToken(ctx context.Context, token *models.RefreshToken) error {
+	_, err := fs.client.Collection("refresh_tokens").Doc(token.ID).Set(ctx, token)
+	return err
+}
+
+func (fs *FirestoreStorage) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	iter := fs.client.Collection("refresh_tokens").Where("token_hash", "==", tokenHash).Documents(ctx)
+	defer iter.Stop()
+
+	doc, err := iter.Next()
+	if err != nil {
+		return nil, notFound(err, "refresh token")
+	}
+
+	var token models.RefreshToken
+	if err := doc.DataTo(&token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// RotateRefreshToken marks token rotated and creates next in a transaction,
+// failing with Conflict if the stored token was already rotated
+func (fs *FirestoreStorage) RotateRefreshToken(ctx context.Context, token, next *models.RefreshToken) error {
+	tokens := fs.client.Collection("refresh_tokens")
+	doc := tokens.Doc(token.ID)
+	return fs.client.RunTransaction(ctx, func(ctx context.Context, t *firestore.Transaction) error {
+		snap, err := t.Get(doc)
+		if err != nil {
+			return notFound(err, "refresh token")
+		}
+		var stored models.RefreshToken
+		if err := snap.DataTo(&stored); err != nil {
+			return err
+		}
+		if stored.RotatedAt != nil {
+			return apperr.Errorf(apperr.Conflict, "refresh token was already rotated")
+		}
+		if err := t.Set(doc, token); err != nil {
+			return err
+		}
+		return t.Create(tokens.Doc(next.ID), next)
+	})
+}
+
+func (fs *FirestoreStorage) DeleteRefreshTokenFamily(ctx context.Context, familyID string) error {
+	return fs.deleteWhere(ctx, "refresh_tokens", "family_id", familyID)
+}
+
+// Session operations
+func (fs *FirestoreStorage) CreateSession(ctx context.Context, session *models.Session) error {
+	_, err := fs.client.Collection("sessions").Doc(session.ID).Set(ctx, session)
+	return err
+}
+
+func (fs *FirestoreStorage) GetSession(ctx context.Context, id string) (*models.Session, error) {
+	doc, err := fs.client.Collection("sessions").Doc(id).Get(ctx)
+	if err != nil {
+		return nil, notFound(err, "session")
+	}
+
+	var session models.Session
+	if err := doc.DataTo(&session); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (fs *FirestoreStorage) UpdateSession(ctx context.Context, session *models.Session) error {
+	_, err := fs.client.Collection("sessions").Doc(session.ID).Set(ctx, session)
+	return err
+}
+
+func (fs *FirestoreStorage) DeleteSession(ctx context.Context, id string) error {
+	_, err := fs.client.Collection("sessions").Doc(id).Delete(ctx)
+	return err
+}
+
+func (fs *FirestoreStorage) ListSessions(ctx context.Context, userID string) ([]*models.Session, error) {
+	iter := fs.client.Collection("sessions").Where("user_id", "==", userID).Documents(ctx)
+	defer iter.Stop()
+
+	var sessions []*models.Session
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var session models.Session
+		if err := doc.DataTo(&session); err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, &session)
+	}
+
+	return sessions, nil
+}
+
+// Identity operations
+func (fs *FirestoreStorage) CreateIdentity(ctx context.Context, identity *models.Identity) error {
+	_, err := fs.client.Collection("identities").Doc(identity.ID).Create(ctx, identity)
+	if status.Code(err) == codes.AlreadyExists {
+		return apperr.Errorf(apperr.Conflict, "identity already linked")
+	}
+	return err
+}
+
+func (fs *FirestoreStorage) GetIdentity(ctx context.Context, id string) (*models.Identity, error) {
+	doc, err := fs.client.Collection("identities").Doc(id).Get(ctx)
+	if err != nil {
+		return nil, notFound(err, "identity")
+	}
+
+	var identity models.Identity
+	if err := doc.DataTo(&identity); err != nil {
+		return nil, err
+	}
+
+	return &identity, nil
+}
+
+func (fs *FirestoreStorage) DeleteIdentity(ctx context.Context, id string) error {
+	_, err := fs.client.Collection("identities").Doc(id).Delete(ctx)
+	return err
+}
+
+func (fs *FirestoreStorage) ListIdentities(ctx context.Context, userID string) ([]*models.Identity, error) {
+	iter := fs.client.Collection("identities").Where("user_id", "==", userID).Documents(ctx)
+	defer iter.Stop()
+
+	var identities []*models.Identity
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var identity models.Identity
+		if err := doc.DataTo(&identity); err != nil {
+			return nil, err
+		}
+		identities = append(identities, &identity)
+	}
+
+	return identities, nil
+}
+
+// Audit log operations
+func (fs *FirestoreStorage) AppendAuditEvent(ctx context.Context, event *models.AuditEvent) error {
+	// Create rather than Set, so an event is never overwritten
+	_, err := fs.client.Collection("audit_events").Doc(event.ID).Create(ctx, event)
 	return err
 }
 
+func (fs *FirestoreStorage) ListAuditEvents(ctx context.Context, filters AuditFilters) ([]*models.AuditEvent, error) {
+	query := fs.client.Collection("audit_events").Where("user_id", "==", filters.UserID)
+	if filters.Action != "" {
+		query = query.Where("action", "==", string(filters.Action))
+	}
+	if filters.TargetType != "" {
+		query = query.Where("target_type", "==", filters.TargetType)
+	}
+	if filters.TargetID != "" {
+		query = query.Where("target_id", "==", filters.TargetID)
+	}
+	if filters.Tool != "" {
+		query = query.Where("tool", "==", filters.Tool)
+	}
+	if filters.Since != nil {
+		query = query.Where("created_at", ">=", *filters.Since)
+	}
+	if filters.Until != nil {
+		query = query.Where("created_at", "<", *filters.Until)
+	}
+	query = query.OrderBy("created_at", firestore.Desc)
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+
+	iter := query.Documents(ctx)
+	defer iter.Stop()
+
+	var events []*models.AuditEvent
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var event models.AuditEvent
+		if err := doc.DataTo(&event); err != nil {
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+
+	return events, nil
+}
+
+// Journal operations
+
+// journalStepDoc is one side of a journal step. Steps are stored as
+// documents below their entry, one per item state, as an entry of an
+// operation changing many items, or a large item, does not fit in one
+// document.
+type journalStepDoc struct {
+	Index      int          `firestore:"index"`
+	After      bool         `firestore:"after"`
+	TargetType string       `firestore:"target_type"`
+	TargetID   string       `firestore:"target_id"`
+	Todo       *models.Todo `firestore:"todo,omitempty"`
+	Memo       *models.Memo `firestore:"memo,omitempty"`
+	ExpiresAt  time.Time    `firestore:"expires_at"` // Deleted by a TTL policy with the entry
+}
+
+func (fs *FirestoreStorage) journalRef(userID, id string) *firestore.DocumentRef {
+	return fs.client.Collection("users").Doc(userID).Collection("journal").Doc(id)
+}
+
+// CreateJournalEntry stores the steps of the entry before the entry, so
+// listed entries are complete
+func (fs *FirestoreStorage) CreateJournalEntry(ctx context.Context, entry *models.JournalEntry) error {
+	ref := fs.journalRef(entry.UserID, entry.ID)
+
+	writer := fs.client.BulkWriter(ctx)
+	var jobs []*firestore.BulkWriterJob
+	for i, step := range entry.Steps {
+		sides := []journalStepDoc{
+			{Index: i, TargetType: step.TargetType, TargetID: step.TargetID, Todo: step.TodoBefore, Memo: step.MemoBefore},
+			{Index: i, After: true, TargetType: step.TargetType, TargetID: step.TargetID, Todo: step.TodoAfter, Memo: step.MemoAfter},
+		}
+		for _, side := range sides {
+			if side.Todo == nil && side.Memo == nil {
+				continue
+			}
+			side.ExpiresAt = entry.ExpiresAt
+			name := fmt.Sprintf("%d-before", i)
+			if side.After {
+				name = fmt.Sprintf("%d-after", i)
+			}
+			job, err := writer.Create(ref.Collection("journal_steps").Doc(name), side)
+			if err != nil {
+				writer.End()
+				return err
+			}
+			jobs = append(jobs, job)
+		}
+	}
+	writer.End()
+	for _, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			return fmt.Errorf("failed to store journal step: %w", err)
+		}
+	}
+
+	stored := *entry
+	stored.Steps, stored.StepCount = nil, len(entry.Steps)
+	_, err := ref.Create(ctx, &stored)
+	return err
+}
+
+// journalSteps reads the steps of an entry stored as documents of their own
+func journalSteps(entry *models.JournalEntry, docs []*firestore.DocumentSnapshot) error {
+	if entry.StepCount == 0 {
+		return nil
+	}
+	entry.Steps = make([]models.JournalStep, entry.StepCount)
+	for _, doc := range docs {
+		var side journalStepDoc
+		if err := doc.DataTo(&side); err != nil {
+			return err
+		}
+		if side.Index < 0 || side.Index >= entry.StepCount {
+			return fmt.Errorf("journal entry %s has no step %d", entry.ID, side.Index)
+		}
+		step := &entry.Steps[side.Index]
+		step.TargetType, step.TargetID = side.TargetType, side.TargetID
+		if side.After {
+			step.TodoAfter, step.MemoAfter = side.Todo, side.Memo
+		} else {
+			step.TodoBefore, step.MemoBefore = side.Todo, side.Memo
+		}
+	}
+	return nil
+}
+
+func (fs *FirestoreStorage) ListJournalEntries(ctx context.Context, filters JournalFilters) ([]*models.JournalEntry, error) {
+	query := fs.client.Collection("users").Doc(filters.UserID).Collection("journal").Where("state", "==", string(filters.State))
+	if filters.SessionID != "" {
+		query = query.Where("session_id", "==", filters.SessionID)
+	}
+	if filters.TokenID != "" {
+		query = query.Where("token_id", "==", filters.TokenID)
+	}
+	if filters.State == models.JournalUndone {
+		query = query.OrderBy("undone_at", firestore.Desc)
+	} else {
+		query = query.OrderBy("created_at", firestore.Desc)
+	}
+	if filters.Limit > 0 {
+		query = query.Limit(filters.Limit)
+	}
+
+	iter := query.Documents(ctx)
+	defer iter.Stop()
+
+	var entries []*models.JournalEntry
+	for {
+		doc, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var entry models.JournalEntry
+		if err := doc.DataTo(&entry); err != nil {
+			return nil, err
+		}
+		if entry.StepCount > 0 {
+			steps, err := doc.Ref.Collection("journal_steps").Documents(ctx).GetAll()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get steps of journal entry %s: %w", entry.ID, err)
+			}
+			if err := journalSteps(&entry, steps); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+// Transactions
+func (fs *FirestoreStorage) RunTransaction(ctx context.Context, userID string, fn func(tx Tx) error) error {
+	user := fs.client.Collection("users").Doc(userID)
+	return fs.client.RunTransaction(ctx, func(ctx context.Context, t *firestore.Transaction) error {
+		return fn(&firestoreTx{tx: t, user: user})
+	})
+}
+
+// firestoreTx is a Tx on the documents below a user's document
+type firestoreTx struct {
+	tx   *firestore.Transaction
+	user *firestore.DocumentRef
+}
+
+func (t *firestoreTx) GetTodo(id string) (*models.Todo, error) {
+	var todo models.Todo
+	if err := t.get(t.user.Collection("todos").Doc(id), "todo", &todo); err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+func (t *firestoreTx) SetTodo(todo *models.Todo) error {
+	return t.tx.Set(t.user.Collection("todos").Doc(todo.ID), todo)
+}
+
+func (t *firestoreTx) DeleteTodo(id string) error {
+	return t.tx.Delete(t.user.Collection("todos").Doc(id))
+}
+
+func (t *firestoreTx) GetMemo(id string) (*models.Memo, error) {
+	var memo models.Memo
+	if err := t.get(t.user.Collection("memos").Doc(id), "memo", &memo); err != nil {
+		return nil, err
+	}
+	return &memo, nil
+}
+
+func (t *firestoreTx) SetMemo(memo *models.Memo) error {
+	return t.tx.Set(t.user.Collection("memos").Doc(memo.ID), memo)
+}
+
+func (t *firestoreTx) DeleteMemo(id string) error {
+	return t.tx.Delete(t.user.Collection("memos").Doc(id))
+}
+
+func (t *firestoreTx) GetJournalEntry(id string) (*models.JournalEntry, error) {
+	ref := t.user.Collection("journal").Doc(id)
+	var entry models.JournalEntry
+	if err := t.get(ref, "journal entry", &entry); err != nil {
+		return nil, err
+	}
+	if entry.StepCount > 0 {
+		steps, err := t.tx.Documents(ref.Collection("journal_steps")).GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get steps of journal entry %s: %w", id, err)
+		}
+		if err := journalSteps(&entry, steps); err != nil {
+			return nil, err
+		}
+	}
+	return &entry, nil
+}
+
+// SetJournalEntry changes the state of an entry. The steps of an entry never
+// change, so those stored as documents of their own are kept as they are.
+func (t *firestoreTx) SetJournalEntry(entry *models.JournalEntry) error {
+	stored := *entry
+	if stored.StepCount > 0 {
+		stored.Steps = nil
+	}
+	return t.tx.Set(t.user.Collection("journal").Doc(entry.ID), &stored)
+}
+
+func (t *firestoreTx) get(ref *firestore.DocumentRef, what string, v interface{}) error {
+	doc, err := t.tx.Get(ref)
+	if err != nil {
+		return notFound(err, what)
+	}
+	return doc.DataTo(v)
+}
+
+// count returns the number of documents query matches, counted by Firestore
+// without reading them
+func (fs *FirestoreStorage) count(ctx context.Context, query firestore.Query) (int, error) {
+	result, err := query.NewAggregationQuery().WithCount("count").Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	value, ok := result["count"].(*firestorepb.Value)
+	if !ok {
+		return 0, fmt.Errorf("unexpected count result %v", result["count"])
+	}
+	return int(value.GetIntegerValue()), nil
+}
+
+// deleteWhere deletes the documents of a top-level collection whose field
+// equals value
+func (fs *FirestoreStorage) deleteWhere(ctx context.Context, collection, field string, value interface{}) error {
+	deleter := fs.newBatchDeleter()
+	if err := deleter.deleteAll(ctx, fs.client.Collection(collection).Where(field, "==", value)); err != nil {
+		return err
+	}
+	return deleter.commit(ctx)
+}
+
 // Todo operations (updated for user isolation)
 func (fs *FirestoreStorage) CreateTodo(ctx context.Context, todo *models.Todo) error {
 	_, err := fs.client.Collection("users").Doc(todo.UserID).Collection("todos").Doc(todo.ID).Set(ctx, todo)
@@ -166,7 +788,7 @@ func (fs *FirestoreStorage) GetTodo(ctx context.Context, id string) (*models.Tod
 
 	doc, err := iter.Next()
 	if err != nil {
-		return nil, err
+		return nil, notFound(err, "todo")
 	}
 
 	var todo models.Todo
@@ -255,6 +877,10 @@ func (fs *FirestoreStorage) ListTodos(ctx context.Context, filters TodoFilters)
 	return todos, nil
 }
 
+func (fs *FirestoreStorage) CountTodos(ctx context.Context, userID string) (int, error) {
+	return fs.count(ctx, fs.client.Collection("users").Doc(userID).Collection("todos").Query)
+}
+
 // Memo operations (updated for user isolation)
 func (fs *FirestoreStorage) CreateMemo(ctx context.Context, memo *models.Memo) error {
 	_, err := fs.client.Collection("users").Doc(memo.UserID).Collection("memos").Doc(memo.ID).Set(ctx, memo)
@@ -269,7 +895,7 @@ func (fs *FirestoreStorage) GetMemo(ctx context.Context, id string) (*models.Mem
 
 	doc, err := iter.Next()
 	if err != nil {
-		return nil, err
+		return nil, notFound(err, "memo")
 	}
 
 	var memo models.Memo
@@ -299,6 +925,9 @@ func (fs *FirestoreStorage) DeleteMemo(ctx context.Context, id string) error {
 func (fs *FirestoreStorage) ListMemos(ctx context.Context, filters MemoFilters) ([]*models.Memo, error) {
 	// User isolation: query within user's memos collection
 	query := fs.client.Collection("users").Doc(filters.UserID).Collection("memos").Query
+	if filters.LinkedTodo != "" {
+		query = query.Where("linked_todos", "array-contains", filters.LinkedTodo)
+	}
 
 	iter := query.Documents(ctx)
 	defer iter.Stop()
@@ -343,6 +972,10 @@ func (fs *FirestoreStorage) ListMemos(ctx context.Context, filters MemoFilters)
 	return memos, nil
 }
 
+func (fs *FirestoreStorage) CountMemos(ctx context.Context, userID string) (int, error) {
+	return fs.count(ctx, fs.client.Collection("users").Doc(userID).Collection("memos").Query)
+}
+
 // Search operations (updated for user isolation)
 func (fs *FirestoreStorage) Search(ctx context.Context, query string, filters SearchFilters) (*SearchResults, error) {
 	results := &SearchResults{
@@ -539,3 +1172,63 @@ func (fs *FirestoreStorage) GetAllTags(ctx context.Context, userID string) ([]st
 
 	return tags, nil
 }
+
+// WatchChanges implements ChangeWatcher using snapshot listeners on the user's
+// todos and memos collections
+func (fs *FirestoreStorage) WatchChanges(ctx context.Context, userID string, changes chan<- *models.Change) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	userDoc := fs.client.Collection("users").Doc(userID)
+	errs := make(chan error, 2)
+	go func() {
+		errs <- watchCollection(ctx, userDoc.Collection("todos").Query, models.ChangeKindTodo, changes)
+	}()
+	go func() {
+		errs <- watchCollection(ctx, userDoc.Collection("memos").Query, models.ChangeKindMemo, changes)
+	}()
+
+	// Stop both listeners as soon as one fails
+	err := <-errs
+	cancel()
+	<-errs
+	return err
+}
+
+func watchCollection(ctx context.Context, query firestore.Query, kind models.ChangeKind, changes chan<- *models.Change) error {
+	iter := query.Snapshots(ctx)
+	defer iter.Stop()
+
+	// The first snapshot holds the current documents, not changes
+	if _, err := iter.Next(); err != nil {
+		return err
+	}
+
+	for {
+		snap, err := iter.Next()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		for _, dc := range snap.Changes {
+			change := &models.Change{Kind: kind, ID: dc.Doc.Ref.ID, At: snap.ReadTime}
+			switch dc.Kind {
+			case firestore.DocumentAdded:
+				change.Op = models.ChangeOpCreated
+			case firestore.DocumentModified:
+				change.Op = models.ChangeOpUpdated
+			case firestore.DocumentRemoved:
+				change.Op = models.ChangeOpDeleted
+			}
+
+			select {
+			case changes <- change:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
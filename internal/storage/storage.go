@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/pankona/memoya/internal/models"
 )
@@ -18,8 +19,61 @@ type Storage interface {
 	// Device auth operations
 	CreateDeviceAuthSession(ctx context.Context, session *models.DeviceAuthSession) error
 	GetDeviceAuthSession(ctx context.Context, deviceCode string) (*models.DeviceAuthSession, error)
+	// UpdateDeviceAuthSession and DeleteDeviceAuthSession fail with NotFound
+	// once the session was deleted, so that of concurrent polls consuming a
+	// session only one succeeds
 	UpdateDeviceAuthSession(ctx context.Context, session *models.DeviceAuthSession) error
 	DeleteDeviceAuthSession(ctx context.Context, deviceCode string) error
+	// DeleteExpiredDeviceAuthSessions deletes the sessions that expired
+	// before the given time and returns how many it deleted
+	DeleteExpiredDeviceAuthSessions(ctx context.Context, before time.Time) (int, error)
+
+	// Personal access token operations
+	CreateAccessToken(ctx context.Context, token *models.AccessToken) error
+	GetAccessToken(ctx context.Context, id string) (*models.AccessToken, error)
+	GetAccessTokenByHash(ctx context.Context, tokenHash string) (*models.AccessToken, error)
+	UpdateAccessToken(ctx context.Context, token *models.AccessToken) error
+	DeleteAccessToken(ctx context.Context, id string) error
+	ListAccessTokens(ctx context.Context, userID string) ([]*models.AccessToken, error)
+
+	// Refresh token operations
+	CreateRefreshToken(ctx context.Context, token *models.RefreshToken) error
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error)
+	// RotateRefreshToken stores token, now rotated, and its successor next
+	// together. It fails with Conflict if token was already rotated, so a
+	// token is only ever rotated once.
+	RotateRefreshToken(ctx context.Context, token, next *models.RefreshToken) error
+	DeleteRefreshTokenFamily(ctx context.Context, familyID string) error
+
+	// Session operations
+	CreateSession(ctx context.Context, session *models.Session) error
+	GetSession(ctx context.Context, id string) (*models.Session, error)
+	UpdateSession(ctx context.Context, session *models.Session) error
+	DeleteSession(ctx context.Context, id string) error
+	ListSessions(ctx context.Context, userID string) ([]*models.Session, error)
+
+	// Identity operations
+	CreateIdentity(ctx context.Context, identity *models.Identity) error
+	GetIdentity(ctx context.Context, id string) (*models.Identity, error)
+	DeleteIdentity(ctx context.Context, id string) error
+	ListIdentities(ctx context.Context, userID string) ([]*models.Identity, error)
+
+	// Audit log operations
+	AppendAuditEvent(ctx context.Context, event *models.AuditEvent) error
+	// ListAuditEvents returns the events matching filters, newest first
+	ListAuditEvents(ctx context.Context, filters AuditFilters) ([]*models.AuditEvent, error)
+
+	// Journal operations
+	CreateJournalEntry(ctx context.Context, entry *models.JournalEntry) error
+	// ListJournalEntries returns the entries matching filters. Applied
+	// entries are returned newest first, undone ones most recently undone
+	// first.
+	ListJournalEntries(ctx context.Context, filters JournalFilters) ([]*models.JournalEntry, error)
+
+	// RunTransaction calls fn with a Tx on the todos, memos and journal of
+	// userID and applies the writes made through it atomically if fn returns
+	// nil. fn may be called again if the transaction conflicts with another.
+	RunTransaction(ctx context.Context, userID string, fn func(tx Tx) error) error
 
 	// Todo operations
 	CreateTodo(ctx context.Context, todo *models.Todo) error
@@ -27,6 +81,7 @@ type Storage interface {
 	UpdateTodo(ctx context.Context, todo *models.Todo) error
 	DeleteTodo(ctx context.Context, id string) error
 	ListTodos(ctx context.Context, filters TodoFilters) ([]*models.Todo, error)
+	CountTodos(ctx context.Context, userID string) (int, error)
 
 	// Memo operations
 	CreateMemo(ctx context.Context, memo *models.Memo) error
@@ -34,6 +89,7 @@ type Storage interface {
 	UpdateMemo(ctx context.Context, memo *models.Memo) error
 	DeleteMemo(ctx context.Context, id string) error
 	ListMemos(ctx context.Context, filters MemoFilters) ([]*models.Memo, error)
+	CountMemos(ctx context.Context, userID string) (int, error)
 
 	// Search operations
 	Search(ctx context.Context, query string, filters SearchFilters) (*SearchResults, error)
@@ -51,8 +107,41 @@ type TodoFilters struct {
 }
 
 type MemoFilters struct {
-	UserID string // Required for user isolation
-	Tags   []string
+	UserID     string // Required for user isolation
+	Tags       []string
+	LinkedTodo string // Only memos linking this todo
+}
+
+type AuditFilters struct {
+	UserID     string // Required for user isolation
+	Action     models.AuditAction
+	TargetType string
+	TargetID   string
+	Tool       string
+	Since      *time.Time // Inclusive
+	Until      *time.Time // Exclusive
+	Limit      int        // 0 for no limit
+}
+
+type JournalFilters struct {
+	UserID    string // Required for user isolation
+	State     models.JournalState
+	SessionID string
+	TokenID   string
+	Limit     int // 0 for no limit
+}
+
+// Tx reads and writes the data of one user within a transaction. As in
+// Firestore, every read must come before the first write.
+type Tx interface {
+	GetTodo(id string) (*models.Todo, error)
+	SetTodo(todo *models.Todo) error
+	DeleteTodo(id string) error
+	GetMemo(id string) (*models.Memo, error)
+	SetMemo(memo *models.Memo) error
+	DeleteMemo(id string) error
+	GetJournalEntry(id string) (*models.JournalEntry, error)
+	SetJournalEntry(entry *models.JournalEntry) error
 }
 
 type SearchFilters struct {
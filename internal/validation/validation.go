@@ -0,0 +1,158 @@
+// Package validation checks and normalizes the arguments of todos and memos
+// before they are stored. Problems are collected per field, so a client sees
+// everything wrong with a request at once.
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pankona/memoya/internal/apperr"
+)
+
+// Limits on the fields of todos and memos. They hold regardless of the
+// configurable quotas, which may be disabled.
+const (
+	MaxTitleLength      = 200        // Characters
+	MaxDescriptionBytes = 512 * 1024 // Well below Firestore's 1 MiB document limit
+	MaxTagLength        = 50         // Characters
+	MaxLinkedTodos      = 100        // Each is looked up when a memo is saved
+)
+
+// Options configure how arguments are normalized
+type Options struct {
+	LowercaseTags bool // Store tags in lower case, so "Work" and "work" are one tag
+}
+
+// FieldError is a problem with one argument
+type FieldError struct {
+	Field   string `json:"field"` // JSON name of the argument, e.g. "tags[2]"
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// Errors are the problems found in the arguments of one request
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, fe := range e {
+		messages[i] = fe.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validator collects field errors while normalizing arguments
+type Validator struct {
+	opts Options
+	errs Errors
+}
+
+func New(opts Options) *Validator {
+	return &Validator{opts: opts}
+}
+
+// Add records a problem with field
+func (v *Validator) Add(field, format string, args ...any) {
+	v.errs = append(v.errs, FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// Err returns the collected problems as a Validation error wrapping Errors,
+// or nil if there are none
+func (v *Validator) Err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return apperr.Errorf(apperr.Validation, "invalid arguments: %w", v.errs)
+}
+
+// Title returns title without surrounding whitespace. An empty title is an
+// error if required, and otherwise means the title is not changed.
+func (v *Validator) Title(field, title string, required bool) string {
+	if title == "" {
+		if required {
+			v.Add(field, "must not be empty")
+		}
+		return ""
+	}
+
+	title = strings.TrimSpace(title)
+	switch {
+	case title == "":
+		v.Add(field, "must not be blank")
+	case utf8.RuneCountInString(title) > MaxTitleLength:
+		v.Add(field, "must be at most %d characters", MaxTitleLength)
+	}
+	return title
+}
+
+// Description checks the size and encoding of description, which is kept
+// as is
+func (v *Validator) Description(field, description string) string {
+	switch {
+	case len(description) > MaxDescriptionBytes:
+		v.Add(field, "must be at most %d bytes", MaxDescriptionBytes)
+	case !utf8.ValidString(description):
+		v.Add(field, "must be valid UTF-8")
+	}
+	return description
+}
+
+// Tags returns tags trimmed, without duplicates and, if configured, in lower
+// case. The order of first occurrence is kept.
+func (v *Validator) Tags(field string, tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	normalized := make([]string, 0, len(tags))
+	for i, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if v.opts.LowercaseTags {
+			tag = strings.ToLower(tag)
+		}
+		switch {
+		case tag == "":
+			v.Add(fmt.Sprintf("%s[%d]", field, i), "must not be blank")
+		case utf8.RuneCountInString(tag) > MaxTagLength:
+			v.Add(fmt.Sprintf("%s[%d]", field, i), "must be at most %d characters", MaxTagLength)
+		case !slices.Contains(normalized, tag):
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
+
+// IDs returns ids trimmed and without duplicates, allowing at most max of them
+func (v *Validator) IDs(field string, ids []string, max int) []string {
+	if ids == nil {
+		return nil
+	}
+
+	normalized := make([]string, 0, len(ids))
+	for i, id := range ids {
+		id = strings.TrimSpace(id)
+		switch {
+		case id == "":
+			v.Add(fmt.Sprintf("%s[%d]", field, i), "must not be blank")
+		case !slices.Contains(normalized, id):
+			normalized = append(normalized, id)
+		}
+	}
+	if len(normalized) > max {
+		v.Add(field, "must have at most %d entries", max)
+	}
+	return normalized
+}
+
+// OneOf checks that value, unless empty, is one of allowed
+func (v *Validator) OneOf(field, value string, allowed ...string) {
+	if value != "" && !slices.Contains(allowed, value) {
+		v.Add(field, "must be one of %s", strings.Join(allowed, ", "))
+	}
+}
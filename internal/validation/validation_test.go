@@ -0,0 +1,81 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pankona/memoya/internal/apperr"
+)
+
+func TestValidator_Tags(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+		tags []string
+		want []string
+	}{
+		{"nil", Options{}, nil, nil},
+		{"trimmed and deduplicated", Options{}, []string{" work ", "home", "work"}, []string{"work", "home"}},
+		{"case kept", Options{}, []string{"Work", "work"}, []string{"Work", "work"}},
+		{"lowercased", Options{LowercaseTags: true}, []string{"Work", " work", "HOME"}, []string{"work", "home"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := New(tt.opts)
+			got := v.Tags("tags", tt.tags)
+			if err := v.Err(); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidator_Errors(t *testing.T) {
+	v := New(Options{})
+	if title := v.Title("title", "  Write docs ", true); title != "Write docs" {
+		t.Errorf("Expected the title to be trimmed, got %q", title)
+	}
+	if v.Err() != nil {
+		t.Fatalf("Expected no error, got %v", v.Err())
+	}
+
+	v.Title("title", "   ", true)
+	v.Description("description", strings.Repeat("a", MaxDescriptionBytes+1))
+	v.Tags("tags", []string{"ok", " ", strings.Repeat("x", MaxTagLength+1)})
+	v.IDs("linked_todos", []string{"a", "b", "c"}, 2)
+	v.OneOf("priority", "urgent", "high", "normal")
+
+	err := v.Err()
+	if !apperr.Is(err, apperr.Validation) {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+
+	var fieldErrors Errors
+	if !errors.As(err, &fieldErrors) {
+		t.Fatalf("Expected field errors, got %v", err)
+	}
+	var fields []string
+	for _, fe := range fieldErrors {
+		fields = append(fields, fe.Field)
+	}
+	want := []string{"title", "description", "tags[1]", "tags[2]", "linked_todos", "priority"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("Expected errors on %v, got %v", want, fields)
+	}
+}
+
+func TestValidator_OptionalTitle(t *testing.T) {
+	v := New(Options{})
+	if title := v.Title("title", "", false); title != "" {
+		t.Errorf("Expected an empty title, got %q", title)
+	}
+	if err := v.Err(); err != nil {
+		t.Errorf("Expected an omitted optional title to be valid, got %v", err)
+	}
+}
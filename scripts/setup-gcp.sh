@@ -107,6 +107,71 @@ setup_firestore() {
     fi
 }
 
+# Function to enable Firestore TTL policies
+setup_firestore_ttl() {
+    echo_step "Setting up Firestore TTL policies..."
+
+    # Expired device auth sessions are deleted at delete_at (memoya-server
+    # also deletes them periodically, since TTL deletion can lag)
+    if gcloud firestore fields ttls update delete_at \
+        --collection-group=device_auth_sessions --enable-ttl --async --quiet; then
+        echo_info "TTL policy on device_auth_sessions.delete_at enabled."
+    else
+        echo_warn "Failed to enable the TTL policy on device_auth_sessions.delete_at."
+    fi
+
+    # Undo journal entries are deleted 30 days after the operation
+    if gcloud firestore fields ttls update expires_at \
+        --collection-group=journal --enable-ttl --async --quiet; then
+        echo_info "TTL policy on journal.expires_at enabled."
+    else
+        echo_warn "Failed to enable the TTL policy on journal.expires_at."
+    fi
+
+    # ...with their steps, stored below them
+    if gcloud firestore fields ttls update expires_at \
+        --collection-group=journal_steps --enable-ttl --async --quiet; then
+        echo_info "TTL policy on journal_steps.expires_at enabled."
+    else
+        echo_warn "Failed to enable the TTL policy on journal_steps.expires_at."
+    fi
+}
+
+# Function to create Firestore composite indexes
+setup_firestore_indexes() {
+    echo_step "Setting up Firestore indexes..."
+
+    # The activity log lists a user's audit events newest first, filtered by
+    # any of these fields; Firestore merges the indexes of the filters used
+    for field in user_id action target_type target_id tool; do
+        if gcloud firestore indexes composite create \
+            --collection-group=audit_events \
+            --field-config=field-path=$field,order=ascending \
+            --field-config=field-path=created_at,order=descending \
+            --async --quiet 2>/dev/null; then
+            echo_info "Index on audit_events ($field, created_at) requested."
+        else
+            echo_warn "Index on audit_events ($field, created_at) already exists or could not be created."
+        fi
+    done
+
+    # undo lists applied journal entries newest first and redo lists undone
+    # ones most recently undone first, optionally of one session or token
+    for field in state session_id token_id; do
+        for order in created_at undone_at; do
+            if gcloud firestore indexes composite create \
+                --collection-group=journal \
+                --field-config=field-path=$field,order=ascending \
+                --field-config=field-path=$order,order=descending \
+                --async --quiet 2>/dev/null; then
+                echo_info "Index on journal ($field, $order) requested."
+            else
+                echo_warn "Index on journal ($field, $order) already exists or could not be created."
+            fi
+        done
+    done
+}
+
 # Function to create service account
 setup_service_account() {
     echo_step "Setting up service account..."
@@ -274,6 +339,8 @@ main() {
     check_prerequisites
     setup_project
     setup_firestore
+    setup_firestore_ttl
+    setup_firestore_indexes
     setup_service_account
     setup_secrets
     
@@ -300,7 +367,7 @@ usage() {
     echo ""
     echo "This script will:"
     echo "  1. Enable required APIs"
-    echo "  2. Create Firestore database"
+    echo "  2. Create Firestore database with its TTL policies and indexes"
     echo "  3. Create service account with necessary permissions"
     echo "  4. Setup Secret Manager secrets"
     echo "  5. Show manual steps for OAuth setup"
@@ -74,7 +74,7 @@ create_secret() {
 # Function to grant access to service account
 grant_secret_access() {
     local service_account="memoya-server@$PROJECT_ID.iam.gserviceaccount.com"
-    local secrets=("oauth-client-id" "oauth-client-secret")
+    local secrets=("oauth-client-id" "oauth-client-secret" "jwt-signing-keys")
     
     echo_info "Granting secret access to service account: $service_account"
     
@@ -87,6 +87,28 @@ grant_secret_access() {
     done
 }
 
+# Function to create the JWT signing key secret with a new Ed25519 key
+create_jwt_signing_keys() {
+    echo_info "Creating secret: jwt-signing-keys"
+
+    if gcloud secrets describe jwt-signing-keys --project=$PROJECT_ID &> /dev/null; then
+        echo_warn "Secret jwt-signing-keys already exists. Skipping creation."
+        return 0
+    fi
+
+    if ! command -v openssl &> /dev/null; then
+        echo_error "openssl is required to generate the JWT signing key."
+        exit 1
+    fi
+
+    openssl genpkey -algorithm ed25519 | gcloud secrets create jwt-signing-keys \
+        --replication-policy="automatic" \
+        --project=$PROJECT_ID \
+        --data-file=-
+
+    echo_info "Secret jwt-signing-keys created with a new Ed25519 key."
+}
+
 # Function to set secret values
 set_secret_values() {
     echo_info "Setting up OAuth secrets..."
@@ -132,7 +154,7 @@ list_secrets() {
 test_secret_access() {
     echo_info "Testing secret access..."
     
-    local secrets=("oauth-client-id" "oauth-client-secret")
+    local secrets=("oauth-client-id" "oauth-client-secret" "jwt-signing-keys")
     
     for secret in "${secrets[@]}"; do
         echo_info "Testing access to secret: $secret"
@@ -160,6 +182,7 @@ main() {
     # Create secrets
     create_secret "oauth-client-id" "Google OAuth 2.0 Client ID for Memoya"
     create_secret "oauth-client-secret" "Google OAuth 2.0 Client Secret for Memoya"
+    create_jwt_signing_keys
     
     # Grant access to service account
     grant_secret_access
@@ -197,7 +220,7 @@ usage() {
     echo ""
     echo "This script will:"
     echo "  1. Enable Secret Manager API"
-    echo "  2. Create oauth-client-id and oauth-client-secret secrets"
+    echo "  2. Create oauth-client-id, oauth-client-secret and jwt-signing-keys secrets"
     echo "  3. Grant access to the memoya-server service account"
     echo "  4. Optionally set the secret values"
     echo ""